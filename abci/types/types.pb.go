@@ -2232,6 +2232,18 @@ type ResponseCheckTx struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// Optional sender of the transaction (e.g. the signer's account). If set,
+	// the mempool reaps the transactions of each sender in increasing order of
+	// sequence. The application should reject the sequences already committed,
+	// as the mempool only knows of the ones of the transactions it had.
+	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Priority of the transaction, used by the priority mempool to order and
+	// evict transactions. Both mempools also use it to decide whether a
+	// transaction replaces one with the same sender and sequence.
+	Priority int64 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Sequence (e.g. account nonce) of the transaction for the given sender.
+	// Ignored if sender is empty.
+	Sequence uint64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2323,6 +2335,27 @@ func (m *ResponseCheckTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ResponseCheckTx) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ResponseCheckTx) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 type ResponseCommit struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
 }
@@ -3664,205 +3697,207 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3167 bytes of a gzipped FileDescriptorProto
+	// 3192 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x23, 0xd5,
-	0xd5, 0x57, 0xeb, 0xad, 0xa3, 0x87, 0xdb, 0xd7, 0x9e, 0x41, 0x23, 0x06, 0xdb, 0x34, 0x05, 0x0c,
-	0x03, 0xd8, 0x7c, 0x9e, 0x6f, 0x78, 0xd4, 0xc0, 0x57, 0x25, 0x6b, 0x34, 0x9f, 0xec, 0x31, 0xb6,
-	0x69, 0xcb, 0x43, 0x91, 0x07, 0x4d, 0x5b, 0xba, 0xb2, 0x9a, 0x91, 0xd4, 0x4d, 0xf7, 0x95, 0x91,
-	0x59, 0xa5, 0x42, 0x52, 0x95, 0x62, 0x45, 0x55, 0xb2, 0x60, 0x11, 0x16, 0x59, 0x64, 0x93, 0xbf,
-	0x20, 0xab, 0x64, 0x93, 0x05, 0x8b, 0x2c, 0x58, 0x66, 0x45, 0x52, 0xb0, 0x63, 0x9b, 0x45, 0xb6,
-	0xa9, 0xfb, 0xe8, 0x97, 0xa4, 0xb6, 0xa4, 0x81, 0x2c, 0x52, 0xc9, 0xae, 0xef, 0xe9, 0x73, 0xce,
-	0xed, 0x7b, 0xee, 0xb9, 0xe7, 0xf1, 0xeb, 0x0b, 0x8f, 0x13, 0x3c, 0x68, 0x63, 0xbb, 0x6f, 0x0c,
-	0xc8, 0x96, 0x7e, 0xda, 0x32, 0xb6, 0xc8, 0x85, 0x85, 0x9d, 0x4d, 0xcb, 0x36, 0x89, 0x89, 0x96,
-	0xfc, 0x97, 0x9b, 0xf4, 0x65, 0xe5, 0x89, 0x00, 0x77, 0xcb, 0xbe, 0xb0, 0x88, 0xb9, 0x65, 0xd9,
-	0xa6, 0xd9, 0xe1, 0xfc, 0x95, 0xeb, 0x93, 0xaf, 0x1f, 0xe2, 0x0b, 0xa1, 0x2d, 0x24, 0xcc, 0x66,
-	0xd9, 0xb2, 0x74, 0x5b, 0xef, 0xbb, 0xaf, 0x37, 0x26, 0x5e, 0x9f, 0xeb, 0x3d, 0xa3, 0xad, 0x13,
-	0xd3, 0x16, 0x1c, 0xeb, 0x67, 0xa6, 0x79, 0xd6, 0xc3, 0x5b, 0x6c, 0x74, 0x3a, 0xec, 0x6c, 0x11,
-	0xa3, 0x8f, 0x1d, 0xa2, 0xf7, 0x2d, 0xc1, 0xb0, 0x7a, 0x66, 0x9e, 0x99, 0xec, 0x71, 0x8b, 0x3e,
-	0x71, 0xaa, 0xf2, 0xc7, 0x1c, 0x64, 0x54, 0xfc, 0xc1, 0x10, 0x3b, 0x04, 0x6d, 0x43, 0x12, 0xb7,
-	0xba, 0x66, 0x59, 0xda, 0x90, 0x6e, 0xe4, 0xb7, 0xaf, 0x6f, 0x8e, 0x2d, 0x70, 0x53, 0xf0, 0xd5,
-	0x5b, 0x5d, 0xb3, 0x11, 0x53, 0x19, 0x2f, 0xba, 0x0d, 0xa9, 0x4e, 0x6f, 0xe8, 0x74, 0xcb, 0x71,
-	0x26, 0xf4, 0x44, 0x94, 0xd0, 0x3d, 0xca, 0xd4, 0x88, 0xa9, 0x9c, 0x9b, 0x4e, 0x65, 0x0c, 0x3a,
-	0x66, 0x39, 0x71, 0xf9, 0x54, 0xbb, 0x83, 0x0e, 0x9b, 0x8a, 0xf2, 0xa2, 0x1d, 0x00, 0x63, 0x60,
-	0x10, 0xad, 0xd5, 0xd5, 0x8d, 0x41, 0x39, 0xc5, 0x24, 0x9f, 0x8c, 0x96, 0x34, 0x48, 0x8d, 0x32,
-	0x36, 0x62, 0x6a, 0xce, 0x70, 0x07, 0xf4, 0x73, 0x3f, 0x18, 0x62, 0xfb, 0xa2, 0x9c, 0xbe, 0xfc,
-	0x73, 0xdf, 0xa2, 0x4c, 0xf4, 0x73, 0x19, 0x37, 0x7a, 0x1d, 0xb2, 0xad, 0x2e, 0x6e, 0x3d, 0xd4,
-	0xc8, 0xa8, 0x9c, 0x65, 0x92, 0xeb, 0x51, 0x92, 0x35, 0xca, 0xd7, 0x1c, 0x35, 0x62, 0x6a, 0xa6,
-	0xc5, 0x1f, 0xd1, 0xab, 0x90, 0x6e, 0x99, 0xfd, 0xbe, 0x41, 0xca, 0x79, 0x26, 0xbb, 0x16, 0x29,
-	0xcb, 0xb8, 0x1a, 0x31, 0x55, 0xf0, 0xa3, 0x03, 0x28, 0xf5, 0x0c, 0x87, 0x68, 0xce, 0x40, 0xb7,
-	0x9c, 0xae, 0x49, 0x9c, 0x72, 0x81, 0x69, 0x78, 0x3a, 0x4a, 0xc3, 0xbe, 0xe1, 0x90, 0x63, 0x97,
-	0xb9, 0x11, 0x53, 0x8b, 0xbd, 0x20, 0x81, 0xea, 0x33, 0x3b, 0x1d, 0x6c, 0x7b, 0x0a, 0xcb, 0xc5,
-	0xcb, 0xf5, 0x1d, 0x52, 0x6e, 0x57, 0x9e, 0xea, 0x33, 0x83, 0x04, 0xf4, 0x43, 0x58, 0xe9, 0x99,
-	0x7a, 0xdb, 0x53, 0xa7, 0xb5, 0xba, 0xc3, 0xc1, 0xc3, 0x72, 0x89, 0x29, 0x7d, 0x2e, 0xf2, 0x23,
-	0x4d, 0xbd, 0xed, 0xaa, 0xa8, 0x51, 0x81, 0x46, 0x4c, 0x5d, 0xee, 0x8d, 0x13, 0xd1, 0xbb, 0xb0,
-	0xaa, 0x5b, 0x56, 0xef, 0x62, 0x5c, 0xfb, 0x12, 0xd3, 0x7e, 0x33, 0x4a, 0x7b, 0x95, 0xca, 0x8c,
-	0xab, 0x47, 0xfa, 0x04, 0x15, 0x35, 0x41, 0xb6, 0x6c, 0x6c, 0xe9, 0x36, 0xd6, 0x2c, 0xdb, 0xb4,
-	0x4c, 0x47, 0xef, 0x95, 0x65, 0xa6, 0xfb, 0xd9, 0x28, 0xdd, 0x47, 0x9c, 0xff, 0x48, 0xb0, 0x37,
-	0x62, 0xea, 0x92, 0x15, 0x26, 0x71, 0xad, 0x66, 0x0b, 0x3b, 0x8e, 0xaf, 0x75, 0x79, 0x96, 0x56,
-	0xc6, 0x1f, 0xd6, 0x1a, 0x22, 0xa1, 0x3a, 0xe4, 0xf1, 0x88, 0x8a, 0x6b, 0xe7, 0x26, 0xc1, 0x65,
-	0xc4, 0x14, 0x2a, 0x91, 0x27, 0x94, 0xb1, 0x3e, 0x30, 0x09, 0x6e, 0xc4, 0x54, 0xc0, 0xde, 0x08,
-	0xe9, 0x70, 0xe5, 0x1c, 0xdb, 0x46, 0xe7, 0x82, 0xa9, 0xd1, 0xd8, 0x1b, 0xc7, 0x30, 0x07, 0xe5,
-	0x15, 0xa6, 0xf0, 0xf9, 0x28, 0x85, 0x0f, 0x98, 0x10, 0x55, 0x51, 0x77, 0x45, 0x1a, 0x31, 0x75,
-	0xe5, 0x7c, 0x92, 0x4c, 0x5d, 0xac, 0x63, 0x0c, 0xf4, 0x9e, 0xf1, 0x11, 0xd6, 0x4e, 0x7b, 0x66,
-	0xeb, 0x61, 0x79, 0xf5, 0x72, 0x17, 0xbb, 0x27, 0xb8, 0x77, 0x28, 0x33, 0x75, 0xb1, 0x4e, 0x90,
-	0xb0, 0x93, 0x81, 0xd4, 0xb9, 0xde, 0x1b, 0xe2, 0xbd, 0x64, 0x36, 0x29, 0xa7, 0xf6, 0x92, 0xd9,
-	0x8c, 0x9c, 0xdd, 0x4b, 0x66, 0x73, 0x32, 0xec, 0x25, 0xb3, 0x20, 0xe7, 0x95, 0x67, 0x21, 0x1f,
-	0x08, 0x4c, 0xa8, 0x0c, 0x99, 0x3e, 0x76, 0x1c, 0xfd, 0x0c, 0xb3, 0x38, 0x96, 0x53, 0xdd, 0xa1,
-	0x52, 0x82, 0x42, 0x30, 0x18, 0x29, 0x9f, 0x4a, 0x9e, 0x24, 0x8d, 0x33, 0x54, 0xf2, 0x1c, 0xdb,
-	0xcc, 0x1c, 0x42, 0x52, 0x0c, 0xd1, 0x53, 0x50, 0x64, 0x4b, 0xd1, 0xdc, 0xf7, 0x34, 0xd8, 0x25,
-	0xd5, 0x02, 0x23, 0x3e, 0x10, 0x4c, 0xeb, 0x90, 0xb7, 0xb6, 0x2d, 0x8f, 0x25, 0xc1, 0x58, 0xc0,
-	0xda, 0xb6, 0x5c, 0x86, 0x27, 0xa1, 0x40, 0xd7, 0xed, 0x71, 0x24, 0xd9, 0x24, 0x79, 0x4a, 0x13,
-	0x2c, 0xca, 0x9f, 0xe3, 0x20, 0x8f, 0x07, 0x30, 0xf4, 0x2a, 0x24, 0x69, 0x2c, 0x17, 0x61, 0xb9,
-	0xb2, 0xc9, 0x03, 0xfd, 0xa6, 0x1b, 0xe8, 0x37, 0x9b, 0x6e, 0xa0, 0xdf, 0xc9, 0x7e, 0xf1, 0xd5,
-	0x7a, 0xec, 0xd3, 0xbf, 0xae, 0x4b, 0x2a, 0x93, 0x40, 0xd7, 0x68, 0xd8, 0xd2, 0x8d, 0x81, 0x66,
-	0xb4, 0xd9, 0x27, 0xe7, 0x68, 0x4c, 0xd2, 0x8d, 0xc1, 0x6e, 0x1b, 0xed, 0x83, 0xdc, 0x32, 0x07,
-	0x0e, 0x1e, 0x38, 0x43, 0x47, 0xe3, 0xa9, 0x46, 0x04, 0xe3, 0x50, 0x48, 0xe5, 0x09, 0xaf, 0xe6,
-	0x72, 0x1e, 0x31, 0x46, 0x75, 0xa9, 0x15, 0x26, 0xa0, 0x7b, 0x00, 0x5e, 0x3e, 0x72, 0xca, 0xc9,
-	0x8d, 0xc4, 0x8d, 0xfc, 0xf6, 0xc6, 0xc4, 0x86, 0x3f, 0x70, 0x59, 0x4e, 0xac, 0xb6, 0x4e, 0xf0,
-	0x4e, 0x92, 0x7e, 0xae, 0x1a, 0x90, 0x44, 0xcf, 0xc0, 0x92, 0x6e, 0x59, 0x9a, 0x43, 0x74, 0x82,
-	0xb5, 0xd3, 0x0b, 0x82, 0x1d, 0x16, 0xe7, 0x0b, 0x6a, 0x51, 0xb7, 0xac, 0x63, 0x4a, 0xdd, 0xa1,
-	0x44, 0xf4, 0x34, 0x94, 0x68, 0x4c, 0x37, 0xf4, 0x9e, 0xd6, 0xc5, 0xc6, 0x59, 0x97, 0xb0, 0x78,
-	0x9e, 0x50, 0x8b, 0x82, 0xda, 0x60, 0x44, 0xa5, 0xed, 0xed, 0x38, 0x8b, 0xe7, 0x08, 0x41, 0xb2,
-	0xad, 0x13, 0x9d, 0x59, 0xb2, 0xa0, 0xb2, 0x67, 0x4a, 0xb3, 0x74, 0xd2, 0x15, 0xf6, 0x61, 0xcf,
-	0xe8, 0x2a, 0xa4, 0x85, 0xda, 0x04, 0x53, 0x2b, 0x46, 0x68, 0x15, 0x52, 0x96, 0x6d, 0x9e, 0x63,
-	0xb6, 0x75, 0x59, 0x95, 0x0f, 0x14, 0x15, 0x4a, 0xe1, 0xd8, 0x8f, 0x4a, 0x10, 0x27, 0x23, 0x31,
-	0x4b, 0x9c, 0x8c, 0xd0, 0x4b, 0x90, 0xa4, 0x86, 0x64, 0x73, 0x94, 0xa6, 0x64, 0x3b, 0x21, 0xd7,
-	0xbc, 0xb0, 0xb0, 0xca, 0x38, 0x95, 0x25, 0x28, 0x86, 0x72, 0x82, 0x72, 0x15, 0x56, 0xa7, 0x85,
-	0x78, 0xa5, 0xeb, 0xd1, 0x43, 0xa1, 0x1a, 0xdd, 0x86, 0xac, 0x17, 0xe3, 0xb9, 0xe3, 0x5c, 0x9b,
-	0x98, 0xd6, 0x65, 0x56, 0x3d, 0x56, 0xea, 0x31, 0x74, 0x03, 0xba, 0xba, 0xc8, 0xe8, 0x05, 0x35,
-	0xa3, 0x5b, 0x56, 0x43, 0x77, 0xba, 0xca, 0x7b, 0x50, 0x8e, 0x8a, 0xdf, 0x01, 0x83, 0x49, 0xcc,
-	0xed, 0x5d, 0x83, 0x5d, 0x85, 0x74, 0xc7, 0xb4, 0xfb, 0x3a, 0x61, 0xca, 0x8a, 0xaa, 0x18, 0x51,
-	0x43, 0xf2, 0x58, 0x9e, 0x60, 0x64, 0x3e, 0x50, 0x34, 0xb8, 0x16, 0x19, 0xc3, 0xa9, 0x88, 0x31,
-	0x68, 0x63, 0x6e, 0xd6, 0xa2, 0xca, 0x07, 0xbe, 0x22, 0xfe, 0xb1, 0x7c, 0x40, 0xa7, 0x75, 0xd8,
-	0x5a, 0x99, 0xfe, 0x9c, 0x2a, 0x46, 0xca, 0x67, 0x09, 0xb8, 0x3a, 0x3d, 0x92, 0xa3, 0x0d, 0x28,
-	0xf4, 0xf5, 0x91, 0x46, 0x46, 0xc2, 0xed, 0x24, 0xb6, 0xf1, 0xd0, 0xd7, 0x47, 0xcd, 0x11, 0xf7,
-	0x39, 0x19, 0x12, 0x64, 0xe4, 0x94, 0xe3, 0x1b, 0x89, 0x1b, 0x05, 0x95, 0x3e, 0xa2, 0x13, 0x58,
-	0xee, 0x99, 0x2d, 0xbd, 0xa7, 0xf5, 0x74, 0x87, 0x68, 0x22, 0xc5, 0xf3, 0x43, 0xf4, 0xd4, 0x84,
-	0xb1, 0x79, 0x4c, 0xc6, 0x6d, 0xbe, 0x9f, 0x34, 0xe0, 0x08, 0xff, 0x5f, 0x62, 0x3a, 0xf6, 0x75,
-	0x77, 0xab, 0xd1, 0x5d, 0xc8, 0xf7, 0x0d, 0xe7, 0x14, 0x77, 0xf5, 0x73, 0xc3, 0xb4, 0xc5, 0x69,
-	0x9a, 0x74, 0x9a, 0x37, 0x7d, 0x1e, 0xa1, 0x29, 0x28, 0x16, 0xd8, 0x92, 0x54, 0xc8, 0x87, 0xdd,
-	0x68, 0x92, 0x5e, 0x38, 0x9a, 0xbc, 0x04, 0xab, 0x03, 0x3c, 0x22, 0x9a, 0x7f, 0x5e, 0xb9, 0x9f,
-	0x64, 0x98, 0xe9, 0x11, 0x7d, 0xe7, 0x9d, 0x70, 0x87, 0xba, 0x0c, 0x7a, 0x8e, 0xe5, 0x42, 0xcb,
-	0x74, 0xb0, 0xad, 0xe9, 0xed, 0xb6, 0x8d, 0x1d, 0x87, 0x95, 0x4f, 0x05, 0x96, 0xe0, 0x18, 0xbd,
-	0xca, 0xc9, 0xca, 0x2f, 0x82, 0x5b, 0x13, 0xce, 0x7d, 0xc2, 0xf0, 0x92, 0x6f, 0xf8, 0x63, 0x58,
-	0x15, 0xf2, 0xed, 0x90, 0xed, 0x79, 0x0d, 0xfa, 0xf8, 0xe4, 0xf9, 0x1a, 0xb7, 0x39, 0x72, 0xc5,
-	0xa3, 0xcd, 0x9e, 0x78, 0x34, 0xb3, 0x23, 0x48, 0x32, 0xa3, 0x24, 0x79, 0x88, 0xa1, 0xcf, 0xff,
-	0x6e, 0x5b, 0xf1, 0x71, 0x02, 0x96, 0x27, 0x0a, 0x09, 0x6f, 0x61, 0xd2, 0xd4, 0x85, 0xc5, 0xa7,
-	0x2e, 0x2c, 0xb1, 0xf0, 0xc2, 0xc4, 0x5e, 0x27, 0x67, 0xef, 0x75, 0xea, 0x7b, 0xdc, 0xeb, 0xf4,
-	0xa3, 0xed, 0xf5, 0xbf, 0x74, 0x17, 0x7e, 0x2d, 0x41, 0x25, 0xba, 0xfa, 0x9a, 0xba, 0x1d, 0xcf,
-	0xc3, 0xb2, 0xf7, 0x29, 0x9e, 0x7a, 0x1e, 0x18, 0x65, 0xef, 0x85, 0xd0, 0x1f, 0x99, 0xe3, 0x9e,
-	0x86, 0xd2, 0x58, 0x6d, 0xc8, 0x5d, 0xb9, 0x78, 0x1e, 0x9c, 0x5f, 0xf9, 0x59, 0xc2, 0x4b, 0x3c,
-	0xa1, 0x02, 0x6e, 0xca, 0x69, 0x7d, 0x0b, 0x56, 0xda, 0xb8, 0x65, 0xb4, 0x1f, 0xf5, 0xb0, 0x2e,
-	0x0b, 0xe9, 0xff, 0x9e, 0xd5, 0x49, 0x2f, 0xf9, 0x15, 0x40, 0x56, 0xc5, 0x8e, 0x45, 0xeb, 0x31,
-	0xb4, 0x03, 0x39, 0x3c, 0x6a, 0x61, 0x8b, 0xb8, 0x25, 0xec, 0xf4, 0x16, 0x81, 0x73, 0xd7, 0x5d,
-	0x4e, 0xda, 0x20, 0x7b, 0x62, 0xe8, 0x96, 0xc0, 0x00, 0xa2, 0xdb, 0x79, 0x21, 0x1e, 0x04, 0x01,
-	0x5e, 0x76, 0x41, 0x80, 0x44, 0x64, 0x7f, 0xcb, 0xa5, 0xc6, 0x50, 0x80, 0x5b, 0x02, 0x05, 0x48,
-	0xce, 0x98, 0x2c, 0x04, 0x03, 0xd4, 0x42, 0x30, 0x40, 0x7a, 0xc6, 0x32, 0x23, 0x70, 0x80, 0x97,
-	0x5d, 0x1c, 0x20, 0x33, 0xe3, 0x8b, 0xc7, 0x80, 0x80, 0x37, 0x02, 0x40, 0x40, 0x8e, 0x89, 0x6e,
-	0x44, 0x8a, 0x4e, 0x41, 0x02, 0x5e, 0xf3, 0x90, 0x80, 0x42, 0x24, 0x8a, 0x20, 0x84, 0xc7, 0xa1,
-	0x80, 0xc3, 0x09, 0x28, 0x80, 0xb7, 0xee, 0xcf, 0x44, 0xaa, 0x98, 0x81, 0x05, 0x1c, 0x4e, 0x60,
-	0x01, 0xa5, 0x19, 0x0a, 0x67, 0x80, 0x01, 0x3f, 0x9a, 0x0e, 0x06, 0x44, 0xb7, 0xeb, 0xe2, 0x33,
-	0xe7, 0x43, 0x03, 0xb4, 0x08, 0x34, 0x40, 0x8e, 0xec, 0x5c, 0xb9, 0xfa, 0xb9, 0xe1, 0x80, 0x93,
-	0x29, 0x70, 0x00, 0x6f, 0xdc, 0x6f, 0x44, 0x2a, 0x9f, 0x03, 0x0f, 0x38, 0x99, 0x82, 0x07, 0xa0,
-	0x99, 0x6a, 0x67, 0x02, 0x02, 0xf7, 0xc2, 0x80, 0xc0, 0x4a, 0x44, 0xd5, 0xe9, 0x9f, 0xf6, 0x08,
-	0x44, 0xe0, 0x34, 0x0a, 0x11, 0xe0, 0x5d, 0xfb, 0x0b, 0x91, 0x1a, 0x17, 0x80, 0x04, 0x0e, 0x27,
-	0x20, 0x81, 0x2b, 0x33, 0x3c, 0x6d, 0x7e, 0x4c, 0x20, 0x25, 0xa7, 0xf7, 0x92, 0xd9, 0xac, 0x9c,
-	0xe3, 0x68, 0xc0, 0x5e, 0x32, 0x9b, 0x97, 0x0b, 0xca, 0x73, 0xb4, 0x82, 0x19, 0x8b, 0x73, 0xb4,
-	0x57, 0xc0, 0xb6, 0x6d, 0xda, 0xa2, 0xbb, 0xe7, 0x03, 0xe5, 0x06, 0xed, 0x11, 0xfd, 0x98, 0x76,
-	0x09, 0x7e, 0xc0, 0x7a, 0xb2, 0x40, 0x1c, 0x53, 0x7e, 0x2f, 0xf9, 0xb2, 0x0c, 0x41, 0x08, 0xf6,
-	0x97, 0x39, 0xd1, 0x5f, 0x06, 0x50, 0x85, 0x78, 0x18, 0x55, 0x58, 0x87, 0x3c, 0xed, 0xb5, 0xc6,
-	0x00, 0x03, 0xdd, 0xf2, 0x00, 0x83, 0x9b, 0xb0, 0xcc, 0x12, 0x26, 0xc7, 0x1e, 0x44, 0x5a, 0x4a,
-	0xb2, 0xb4, 0xb4, 0x44, 0x5f, 0x70, 0xeb, 0xf0, 0xfc, 0xf4, 0x22, 0xac, 0x04, 0x78, 0xbd, 0x1e,
-	0x8e, 0x77, 0xcf, 0xb2, 0xc7, 0x5d, 0x15, 0xcd, 0xdc, 0x9f, 0x24, 0xdf, 0x42, 0x3e, 0xd2, 0x30,
-	0x0d, 0x14, 0x90, 0xbe, 0x27, 0x50, 0x20, 0xfe, 0xc8, 0xa0, 0x40, 0xb0, 0x27, 0x4d, 0x84, 0x7b,
-	0xd2, 0x7f, 0x48, 0xfe, 0x9e, 0x78, 0x2d, 0x7e, 0xcb, 0x6c, 0x63, 0xd1, 0x25, 0xb2, 0x67, 0x5a,
-	0x92, 0xf4, 0xcc, 0x33, 0xd1, 0x0b, 0xd2, 0x47, 0xca, 0xe5, 0x25, 0x9e, 0x9c, 0xc8, 0x2b, 0x5e,
-	0x83, 0xc9, 0x13, 0xbf, 0x68, 0x30, 0x65, 0x48, 0x3c, 0xc4, 0x1c, 0x2e, 0x2e, 0xa8, 0xf4, 0x91,
-	0xf2, 0x31, 0xe7, 0x13, 0x09, 0x9c, 0x0f, 0xd0, 0xab, 0x90, 0x63, 0x60, 0xbf, 0x66, 0x5a, 0x8e,
-	0x80, 0x88, 0x43, 0xa5, 0x0d, 0x47, 0xfc, 0x37, 0x8f, 0x28, 0xcf, 0xa1, 0xe5, 0xa8, 0x59, 0x4b,
-	0x3c, 0x05, 0x2a, 0x8e, 0x5c, 0xa8, 0xe2, 0xb8, 0x0e, 0x39, 0xfa, 0xf5, 0x8e, 0xa5, 0xb7, 0x70,
-	0x19, 0xd8, 0x87, 0xfa, 0x04, 0xe5, 0x77, 0x71, 0x58, 0x1a, 0x4b, 0x34, 0x53, 0xd7, 0xee, 0xba,
-	0x64, 0x3c, 0x00, 0x79, 0xcc, 0x67, 0x8f, 0x35, 0x80, 0x33, 0xdd, 0xd1, 0x3e, 0xd4, 0x07, 0x04,
-	0xb7, 0x85, 0x51, 0x02, 0x14, 0x54, 0x81, 0x2c, 0x1d, 0x0d, 0x1d, 0xdc, 0x16, 0xe8, 0x8b, 0x37,
-	0x46, 0x0d, 0x48, 0xe3, 0x73, 0x3c, 0x20, 0x4e, 0x39, 0xc3, 0xb6, 0xfd, 0xea, 0x64, 0x3b, 0x4c,
-	0x5f, 0xef, 0x94, 0xe9, 0x66, 0x7f, 0xfb, 0xd5, 0xba, 0xcc, 0xb9, 0x5f, 0x30, 0xfb, 0x06, 0xc1,
-	0x7d, 0x8b, 0x5c, 0xa8, 0x42, 0x3e, 0x6c, 0x85, 0xec, 0x98, 0x15, 0x18, 0x0e, 0x58, 0x70, 0xdb,
-	0x7b, 0x6a, 0x53, 0xc3, 0xb4, 0x0d, 0x72, 0xa1, 0x16, 0xfb, 0xb8, 0x6f, 0x99, 0x66, 0x4f, 0xe3,
-	0x67, 0xbc, 0x0a, 0xa5, 0x70, 0x5e, 0x45, 0x4f, 0x41, 0xd1, 0xc6, 0x44, 0x37, 0x06, 0x5a, 0xa8,
-	0x08, 0x2e, 0x70, 0x22, 0x3f, 0x53, 0x7b, 0xc9, 0xac, 0x24, 0xc7, 0xf7, 0x92, 0xd9, 0xb8, 0x9c,
-	0x50, 0x8e, 0xe0, 0xca, 0xd4, 0xbc, 0x8a, 0x5e, 0x81, 0x9c, 0x9f, 0x92, 0x25, 0xb6, 0xda, 0x4b,
-	0x90, 0x16, 0x9f, 0x57, 0xf9, 0x83, 0xe4, 0xab, 0x0c, 0x63, 0x37, 0x75, 0x48, 0xdb, 0xd8, 0x19,
-	0xf6, 0x38, 0x9a, 0x52, 0xda, 0x7e, 0x71, 0xbe, 0x8c, 0x4c, 0xa9, 0xc3, 0x1e, 0x51, 0x85, 0xb0,
-	0xf2, 0x2e, 0xa4, 0x39, 0x05, 0xe5, 0x21, 0x73, 0x72, 0x70, 0xff, 0xe0, 0xf0, 0xed, 0x03, 0x39,
-	0x86, 0x00, 0xd2, 0xd5, 0x5a, 0xad, 0x7e, 0xd4, 0x94, 0x25, 0x94, 0x83, 0x54, 0x75, 0xe7, 0x50,
-	0x6d, 0xca, 0x71, 0x4a, 0x56, 0xeb, 0x7b, 0xf5, 0x5a, 0x53, 0x4e, 0xa0, 0x65, 0x28, 0xf2, 0x67,
-	0xed, 0xde, 0xa1, 0xfa, 0x66, 0xb5, 0x29, 0x27, 0x03, 0xa4, 0xe3, 0xfa, 0xc1, 0xdd, 0xba, 0x2a,
-	0xa7, 0x94, 0xff, 0x81, 0x6b, 0x91, 0x39, 0xdc, 0x07, 0x66, 0xa4, 0x00, 0x30, 0xa3, 0x7c, 0x16,
-	0xa7, 0x4d, 0x4d, 0x54, 0x62, 0x46, 0x7b, 0x63, 0x0b, 0xdf, 0x5e, 0x20, 0xab, 0x8f, 0xad, 0x9e,
-	0xf6, 0x31, 0x36, 0xee, 0x60, 0xd2, 0xea, 0xf2, 0x42, 0x81, 0x47, 0xa0, 0xa2, 0x5a, 0x14, 0x54,
-	0x26, 0xe4, 0x70, 0xb6, 0xf7, 0x71, 0x8b, 0x68, 0xdc, 0x89, 0x1c, 0xd6, 0x4c, 0xe4, 0x28, 0x1b,
-	0xa5, 0x1e, 0x73, 0xa2, 0xf2, 0xde, 0x42, 0xb6, 0xcc, 0x41, 0x4a, 0xad, 0x37, 0xd5, 0x77, 0xe4,
-	0x04, 0x42, 0x50, 0x62, 0x8f, 0xda, 0xf1, 0x41, 0xf5, 0xe8, 0xb8, 0x71, 0x48, 0x6d, 0xb9, 0x02,
-	0x4b, 0xae, 0x2d, 0x5d, 0x62, 0x4a, 0x79, 0x1e, 0x1e, 0x8b, 0xa8, 0x2a, 0x26, 0x5b, 0x2a, 0xe5,
-	0x37, 0x52, 0x90, 0x3b, 0x5c, 0x19, 0x1c, 0x42, 0xda, 0x21, 0x3a, 0x19, 0x3a, 0xc2, 0x88, 0xaf,
-	0xcc, 0x5b, 0x66, 0x6c, 0xba, 0x0f, 0xc7, 0x4c, 0x5c, 0x15, 0x6a, 0x94, 0xdb, 0x50, 0x0a, 0xbf,
-	0x89, 0xb6, 0x81, 0xef, 0x44, 0x71, 0xe5, 0x0e, 0xa0, 0xc9, 0xea, 0x63, 0x4a, 0x7b, 0x29, 0x4d,
-	0x6b, 0x2f, 0x7f, 0x2b, 0xc1, 0xe3, 0x97, 0x54, 0x1a, 0xe8, 0xad, 0xb1, 0x45, 0xbe, 0xb6, 0x48,
-	0x9d, 0xb2, 0xc9, 0x69, 0x63, 0xcb, 0xbc, 0x05, 0x85, 0x20, 0x7d, 0xbe, 0x45, 0x7e, 0x1b, 0xf7,
-	0x0f, 0x71, 0xb8, 0x0f, 0xf6, 0x43, 0xa0, 0xf4, 0x1d, 0x43, 0xe0, 0xeb, 0x00, 0x64, 0xa4, 0x71,
-	0xb7, 0x76, 0xf3, 0xe8, 0x13, 0x53, 0xf0, 0x45, 0xdc, 0x6a, 0x8e, 0xc4, 0x21, 0xc8, 0x11, 0xf1,
-	0xe4, 0xa0, 0xe3, 0x20, 0x28, 0x30, 0x64, 0x39, 0xd6, 0x11, 0x0d, 0xf3, 0xbc, 0xc9, 0xd8, 0x07,
-	0x0f, 0x38, 0xd9, 0x41, 0xef, 0xc0, 0x63, 0x63, 0x85, 0x82, 0xa7, 0x3a, 0x39, 0x6f, 0xbd, 0x70,
-	0x25, 0x5c, 0x2f, 0xb8, 0xaa, 0x83, 0xd9, 0x3e, 0x15, 0xce, 0xf6, 0xef, 0x00, 0xf8, 0xe0, 0x00,
-	0x8d, 0x30, 0xb6, 0x39, 0x1c, 0xb4, 0x99, 0x07, 0xa4, 0x54, 0x3e, 0x40, 0xb7, 0x21, 0x45, 0x3d,
-	0xc9, 0xb5, 0xd3, 0x64, 0x28, 0xa6, 0x9e, 0x10, 0x00, 0x17, 0x38, 0xb7, 0x62, 0x00, 0x9a, 0x04,
-	0x68, 0x23, 0xa6, 0x78, 0x23, 0x3c, 0xc5, 0x93, 0x91, 0x50, 0xef, 0xf4, 0xa9, 0x3e, 0x82, 0x14,
-	0xdb, 0x79, 0x9a, 0x74, 0xd9, 0x5f, 0x01, 0x51, 0x2d, 0xd2, 0x67, 0xf4, 0x63, 0x00, 0x9d, 0x10,
-	0xdb, 0x38, 0x1d, 0xfa, 0x13, 0xac, 0x4f, 0xf7, 0x9c, 0xaa, 0xcb, 0xb7, 0x73, 0x5d, 0xb8, 0xd0,
-	0xaa, 0x2f, 0x1a, 0x70, 0xa3, 0x80, 0x42, 0xe5, 0x00, 0x4a, 0x61, 0x59, 0xb7, 0xbe, 0xe1, 0xdf,
-	0x10, 0xae, 0x6f, 0x78, 0xb9, 0x2a, 0xea, 0x1b, 0xaf, 0x3a, 0x4a, 0xf0, 0x5f, 0x1f, 0x6c, 0xa0,
-	0xfc, 0x24, 0x0e, 0x85, 0xa0, 0xe3, 0xfd, 0xe7, 0x95, 0x20, 0xca, 0xcf, 0x25, 0xc8, 0x7a, 0xcb,
-	0x0f, 0xff, 0x07, 0x09, 0xfd, 0x38, 0xe2, 0xd6, 0x8b, 0x07, 0x7f, 0x5e, 0xf0, 0xdf, 0x44, 0x09,
-	0xef, 0x37, 0xd1, 0x1d, 0x2f, 0xfd, 0x45, 0x01, 0x22, 0x41, 0x5b, 0x0b, 0xaf, 0x72, 0xb3, 0xfd,
-	0x1d, 0xc8, 0x79, 0xa7, 0x97, 0x36, 0x1d, 0x2e, 0x70, 0x24, 0x89, 0x33, 0x24, 0x60, 0xbf, 0x55,
-	0x48, 0x59, 0xe6, 0x87, 0xe2, 0xcf, 0x48, 0x42, 0xe5, 0x03, 0xa5, 0x0d, 0x4b, 0x63, 0x47, 0x1f,
-	0xdd, 0x81, 0x8c, 0x35, 0x3c, 0xd5, 0x5c, 0xe7, 0x18, 0x83, 0xd7, 0xdc, 0x72, 0x76, 0x78, 0xda,
-	0x33, 0x5a, 0xf7, 0xf1, 0x85, 0xfb, 0x31, 0xd6, 0xf0, 0xf4, 0x3e, 0xf7, 0x21, 0x3e, 0x4b, 0x3c,
-	0x38, 0xcb, 0x2f, 0x25, 0xc8, 0xba, 0x67, 0x02, 0xfd, 0x1f, 0xe4, 0xbc, 0xb0, 0xe2, 0xfd, 0xda,
-	0x8c, 0x8c, 0x47, 0x42, 0xbf, 0x2f, 0x82, 0xaa, 0xee, 0x3f, 0x59, 0xa3, 0xad, 0x75, 0x7a, 0x3a,
-	0xf7, 0xa5, 0x52, 0xd8, 0x66, 0x3c, 0xf0, 0xb0, 0x78, 0xbc, 0x7b, 0xf7, 0x5e, 0x4f, 0x3f, 0x53,
-	0xf3, 0x4c, 0x66, 0xb7, 0x4d, 0x07, 0xa2, 0xb2, 0xfb, 0xbb, 0x04, 0xf2, 0xf8, 0x89, 0xfd, 0xce,
-	0x5f, 0x37, 0x99, 0xe6, 0x12, 0x53, 0xd2, 0x1c, 0xda, 0x82, 0x15, 0x8f, 0x43, 0x73, 0x8c, 0xb3,
-	0x81, 0x4e, 0x86, 0x36, 0x16, 0x80, 0x24, 0xf2, 0x5e, 0x1d, 0xbb, 0x6f, 0x26, 0x57, 0x9d, 0x7a,
-	0xc4, 0x55, 0x7f, 0x1c, 0x87, 0x7c, 0x00, 0x1e, 0x45, 0xff, 0x1b, 0x08, 0x46, 0xa5, 0x29, 0x99,
-	0x21, 0xc0, 0xeb, 0xff, 0xa6, 0x0c, 0x9b, 0x29, 0xbe, 0xb8, 0x99, 0xa2, 0x40, 0x68, 0x17, 0x6d,
-	0x4d, 0x2e, 0x8c, 0xb6, 0xbe, 0x00, 0x88, 0x98, 0x44, 0xef, 0x69, 0xe7, 0x26, 0x31, 0x06, 0x67,
-	0x1a, 0x77, 0x43, 0x1e, 0x3a, 0x64, 0xf6, 0xe6, 0x01, 0x7b, 0x71, 0xc4, 0x3c, 0xf2, 0xa7, 0x12,
-	0x64, 0xbd, 0xb2, 0x7b, 0xd1, 0x9f, 0x98, 0x57, 0x21, 0x2d, 0x2a, 0x4b, 0xfe, 0x17, 0x53, 0x8c,
-	0xa6, 0xc2, 0xca, 0x15, 0xc8, 0xf6, 0x31, 0xd1, 0x59, 0x1c, 0xe4, 0x59, 0xcd, 0x1b, 0xdf, 0x7c,
-	0x0d, 0xf2, 0x81, 0x1f, 0xc0, 0x34, 0x34, 0x1e, 0xd4, 0xdf, 0x96, 0x63, 0x95, 0xcc, 0x27, 0x9f,
-	0x6f, 0x24, 0x0e, 0xf0, 0x87, 0xf4, 0x34, 0xab, 0xf5, 0x5a, 0xa3, 0x5e, 0xbb, 0x2f, 0x4b, 0x95,
-	0xfc, 0x27, 0x9f, 0x6f, 0x64, 0x54, 0xcc, 0x10, 0xc5, 0x9b, 0xf7, 0x61, 0x69, 0x6c, 0x63, 0xc2,
-	0x65, 0x0b, 0x82, 0xd2, 0xdd, 0x93, 0xa3, 0xfd, 0xdd, 0x5a, 0xb5, 0x59, 0xd7, 0x1e, 0x1c, 0x36,
-	0xeb, 0xb2, 0x84, 0x1e, 0x83, 0x95, 0xfd, 0xdd, 0xff, 0x6f, 0x34, 0xb5, 0xda, 0xfe, 0x6e, 0xfd,
-	0xa0, 0xa9, 0x55, 0x9b, 0xcd, 0x6a, 0xed, 0xbe, 0x1c, 0xdf, 0xfe, 0x3c, 0x0f, 0xc9, 0xea, 0x4e,
-	0x6d, 0x17, 0xd5, 0x20, 0xc9, 0xa0, 0x90, 0x4b, 0x6f, 0x80, 0x55, 0x2e, 0xc7, 0x86, 0xd1, 0x3d,
-	0x48, 0x31, 0x94, 0x04, 0x5d, 0x7e, 0x25, 0xac, 0x32, 0x03, 0x2c, 0xa6, 0x1f, 0xc3, 0x4e, 0xe4,
-	0xa5, 0x77, 0xc4, 0x2a, 0x97, 0x63, 0xc7, 0x68, 0x1f, 0x32, 0x6e, 0x93, 0x3c, 0xeb, 0xe2, 0x56,
-	0x65, 0x26, 0xa0, 0x4b, 0x97, 0xc6, 0xc1, 0x86, 0xcb, 0xaf, 0x8f, 0x55, 0x66, 0xa0, 0xca, 0x68,
-	0x17, 0xd2, 0xa2, 0x1d, 0x9d, 0x71, 0x23, 0xac, 0x32, 0x0b, 0x27, 0x46, 0x2a, 0xe4, 0x7c, 0x18,
-	0x67, 0xf6, 0xa5, 0xb8, 0xca, 0x1c, 0x80, 0x39, 0x7a, 0x17, 0x8a, 0xe1, 0x56, 0x77, 0xbe, 0x5b,
-	0x67, 0x95, 0x39, 0x11, 0x69, 0xaa, 0x3f, 0xdc, 0xf7, 0xce, 0x77, 0x0b, 0xad, 0x32, 0x27, 0x40,
-	0x8d, 0xde, 0x87, 0xe5, 0xc9, 0xbe, 0x74, 0xfe, 0x4b, 0x69, 0x95, 0x05, 0x20, 0x6b, 0xd4, 0x07,
-	0x34, 0xa5, 0x9f, 0x5d, 0xe0, 0x8e, 0x5a, 0x65, 0x11, 0x04, 0x1b, 0xb5, 0x61, 0x69, 0xbc, 0x49,
-	0x9c, 0xf7, 0xce, 0x5a, 0x65, 0x6e, 0x34, 0x9b, 0xcf, 0x12, 0x6e, 0x2e, 0xe7, 0xbd, 0xc3, 0x56,
-	0x99, 0x1b, 0xdc, 0x46, 0x27, 0x00, 0x81, 0xfe, 0x70, 0x8e, 0x3b, 0x6d, 0x95, 0x79, 0x60, 0x6e,
-	0x64, 0xc1, 0xca, 0xb4, 0xc6, 0x71, 0x91, 0x2b, 0x6e, 0x95, 0x85, 0xd0, 0x6f, 0xea, 0xcf, 0xe1,
-	0x16, 0x70, 0xbe, 0x2b, 0x6f, 0x95, 0x39, 0x61, 0xf0, 0x9d, 0xea, 0x17, 0x5f, 0xaf, 0x49, 0x5f,
-	0x7e, 0xbd, 0x26, 0xfd, 0xed, 0xeb, 0x35, 0xe9, 0xd3, 0x6f, 0xd6, 0x62, 0x5f, 0x7e, 0xb3, 0x16,
-	0xfb, 0xcb, 0x37, 0x6b, 0xb1, 0x1f, 0x3c, 0x7b, 0x66, 0x90, 0xee, 0xf0, 0x74, 0xb3, 0x65, 0xf6,
-	0xb7, 0x5a, 0x66, 0x1f, 0x93, 0xd3, 0x0e, 0xf1, 0x1f, 0xfc, 0x9b, 0xcb, 0xa7, 0x69, 0x96, 0x41,
-	0x6f, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0x64, 0xd0, 0x90, 0x6e, 0xd9, 0x2c, 0x00, 0x00,
+	0xd5, 0x57, 0x4b, 0x2d, 0x59, 0x3a, 0x7a, 0xb8, 0x7d, 0xed, 0x19, 0x34, 0x62, 0xb0, 0x4d, 0x53,
+	0xc0, 0x30, 0x80, 0xcd, 0xe7, 0xf9, 0x86, 0x47, 0x0d, 0x7c, 0x55, 0xb2, 0x46, 0xf3, 0xc9, 0x9e,
+	0xc1, 0x36, 0x6d, 0xcd, 0x50, 0xe4, 0x41, 0xd3, 0x96, 0xae, 0xac, 0x66, 0x24, 0x75, 0xd3, 0x7d,
+	0x65, 0x64, 0x56, 0xa9, 0x90, 0x54, 0xa5, 0x58, 0x51, 0x95, 0x2c, 0x58, 0x84, 0x45, 0x16, 0xf9,
+	0x1f, 0xb2, 0x4a, 0x36, 0x59, 0xb0, 0xc8, 0x82, 0x65, 0x56, 0x24, 0x05, 0x9b, 0x14, 0xdb, 0x2c,
+	0xb2, 0x4d, 0xdd, 0x47, 0xbf, 0x24, 0xb5, 0x25, 0x0d, 0x64, 0x91, 0x4a, 0x76, 0x7d, 0x4f, 0x9f,
+	0x73, 0x6e, 0xdf, 0x73, 0xcf, 0x3d, 0x8f, 0x5f, 0x5f, 0x78, 0x9c, 0xe0, 0x41, 0x1b, 0x3b, 0x7d,
+	0x73, 0x40, 0xb6, 0x8d, 0x93, 0x96, 0xb9, 0x4d, 0xce, 0x6d, 0xec, 0x6e, 0xd9, 0x8e, 0x45, 0x2c,
+	0xb4, 0x1c, 0xbc, 0xdc, 0xa2, 0x2f, 0x2b, 0x4f, 0x84, 0xb8, 0x5b, 0xce, 0xb9, 0x4d, 0xac, 0x6d,
+	0xdb, 0xb1, 0xac, 0x0e, 0xe7, 0xaf, 0x5c, 0x9d, 0x7c, 0xfd, 0x10, 0x9f, 0x0b, 0x6d, 0x11, 0x61,
+	0x36, 0xcb, 0xb6, 0x6d, 0x38, 0x46, 0xdf, 0x7b, 0xbd, 0x39, 0xf1, 0xfa, 0xcc, 0xe8, 0x99, 0x6d,
+	0x83, 0x58, 0x8e, 0xe0, 0xd8, 0x38, 0xb5, 0xac, 0xd3, 0x1e, 0xde, 0x66, 0xa3, 0x93, 0x61, 0x67,
+	0x9b, 0x98, 0x7d, 0xec, 0x12, 0xa3, 0x6f, 0x0b, 0x86, 0xb5, 0x53, 0xeb, 0xd4, 0x62, 0x8f, 0xdb,
+	0xf4, 0x89, 0x53, 0xd5, 0x3f, 0xe4, 0x60, 0x49, 0xc3, 0x1f, 0x0c, 0xb1, 0x4b, 0xd0, 0x0e, 0xc8,
+	0xb8, 0xd5, 0xb5, 0xca, 0xd2, 0xa6, 0x74, 0x2d, 0xbf, 0x73, 0x75, 0x6b, 0x6c, 0x81, 0x5b, 0x82,
+	0xaf, 0xde, 0xea, 0x5a, 0x8d, 0x84, 0xc6, 0x78, 0xd1, 0x4d, 0x48, 0x77, 0x7a, 0x43, 0xb7, 0x5b,
+	0x4e, 0x32, 0xa1, 0x27, 0xe2, 0x84, 0xee, 0x50, 0xa6, 0x46, 0x42, 0xe3, 0xdc, 0x74, 0x2a, 0x73,
+	0xd0, 0xb1, 0xca, 0xa9, 0x8b, 0xa7, 0xda, 0x1b, 0x74, 0xd8, 0x54, 0x94, 0x17, 0xed, 0x02, 0x98,
+	0x03, 0x93, 0xe8, 0xad, 0xae, 0x61, 0x0e, 0xca, 0x69, 0x26, 0xf9, 0x64, 0xbc, 0xa4, 0x49, 0x6a,
+	0x94, 0xb1, 0x91, 0xd0, 0x72, 0xa6, 0x37, 0xa0, 0x9f, 0xfb, 0xc1, 0x10, 0x3b, 0xe7, 0xe5, 0xcc,
+	0xc5, 0x9f, 0xfb, 0x16, 0x65, 0xa2, 0x9f, 0xcb, 0xb8, 0xd1, 0xeb, 0x90, 0x6d, 0x75, 0x71, 0xeb,
+	0xa1, 0x4e, 0x46, 0xe5, 0x2c, 0x93, 0xdc, 0x88, 0x93, 0xac, 0x51, 0xbe, 0xe6, 0xa8, 0x91, 0xd0,
+	0x96, 0x5a, 0xfc, 0x11, 0xbd, 0x0a, 0x99, 0x96, 0xd5, 0xef, 0x9b, 0xa4, 0x9c, 0x67, 0xb2, 0xeb,
+	0xb1, 0xb2, 0x8c, 0xab, 0x91, 0xd0, 0x04, 0x3f, 0x3a, 0x80, 0x52, 0xcf, 0x74, 0x89, 0xee, 0x0e,
+	0x0c, 0xdb, 0xed, 0x5a, 0xc4, 0x2d, 0x17, 0x98, 0x86, 0xa7, 0xe3, 0x34, 0xdc, 0x33, 0x5d, 0x72,
+	0xec, 0x31, 0x37, 0x12, 0x5a, 0xb1, 0x17, 0x26, 0x50, 0x7d, 0x56, 0xa7, 0x83, 0x1d, 0x5f, 0x61,
+	0xb9, 0x78, 0xb1, 0xbe, 0x43, 0xca, 0xed, 0xc9, 0x53, 0x7d, 0x56, 0x98, 0x80, 0x7e, 0x08, 0xab,
+	0x3d, 0xcb, 0x68, 0xfb, 0xea, 0xf4, 0x56, 0x77, 0x38, 0x78, 0x58, 0x2e, 0x31, 0xa5, 0xcf, 0xc5,
+	0x7e, 0xa4, 0x65, 0xb4, 0x3d, 0x15, 0x35, 0x2a, 0xd0, 0x48, 0x68, 0x2b, 0xbd, 0x71, 0x22, 0x7a,
+	0x17, 0xd6, 0x0c, 0xdb, 0xee, 0x9d, 0x8f, 0x6b, 0x5f, 0x66, 0xda, 0xaf, 0xc7, 0x69, 0xaf, 0x52,
+	0x99, 0x71, 0xf5, 0xc8, 0x98, 0xa0, 0xa2, 0x26, 0x28, 0xb6, 0x83, 0x6d, 0xc3, 0xc1, 0xba, 0xed,
+	0x58, 0xb6, 0xe5, 0x1a, 0xbd, 0xb2, 0xc2, 0x74, 0x3f, 0x1b, 0xa7, 0xfb, 0x88, 0xf3, 0x1f, 0x09,
+	0xf6, 0x46, 0x42, 0x5b, 0xb6, 0xa3, 0x24, 0xae, 0xd5, 0x6a, 0x61, 0xd7, 0x0d, 0xb4, 0xae, 0xcc,
+	0xd2, 0xca, 0xf8, 0xa3, 0x5a, 0x23, 0x24, 0x54, 0x87, 0x3c, 0x1e, 0x51, 0x71, 0xfd, 0xcc, 0x22,
+	0xb8, 0x8c, 0x98, 0x42, 0x35, 0xf6, 0x84, 0x32, 0xd6, 0x07, 0x16, 0xc1, 0x8d, 0x84, 0x06, 0xd8,
+	0x1f, 0x21, 0x03, 0x2e, 0x9d, 0x61, 0xc7, 0xec, 0x9c, 0x33, 0x35, 0x3a, 0x7b, 0xe3, 0x9a, 0xd6,
+	0xa0, 0xbc, 0xca, 0x14, 0x3e, 0x1f, 0xa7, 0xf0, 0x01, 0x13, 0xa2, 0x2a, 0xea, 0x9e, 0x48, 0x23,
+	0xa1, 0xad, 0x9e, 0x4d, 0x92, 0xa9, 0x8b, 0x75, 0xcc, 0x81, 0xd1, 0x33, 0x3f, 0xc2, 0xfa, 0x49,
+	0xcf, 0x6a, 0x3d, 0x2c, 0xaf, 0x5d, 0xec, 0x62, 0x77, 0x04, 0xf7, 0x2e, 0x65, 0xa6, 0x2e, 0xd6,
+	0x09, 0x13, 0x76, 0x97, 0x20, 0x7d, 0x66, 0xf4, 0x86, 0x78, 0x5f, 0xce, 0xca, 0x4a, 0x7a, 0x5f,
+	0xce, 0x2e, 0x29, 0xd9, 0x7d, 0x39, 0x9b, 0x53, 0x60, 0x5f, 0xce, 0x82, 0x92, 0x57, 0x9f, 0x85,
+	0x7c, 0x28, 0x30, 0xa1, 0x32, 0x2c, 0xf5, 0xb1, 0xeb, 0x1a, 0xa7, 0x98, 0xc5, 0xb1, 0x9c, 0xe6,
+	0x0d, 0xd5, 0x12, 0x14, 0xc2, 0xc1, 0x48, 0xfd, 0x54, 0xf2, 0x25, 0x69, 0x9c, 0xa1, 0x92, 0x67,
+	0xd8, 0x61, 0xe6, 0x10, 0x92, 0x62, 0x88, 0x9e, 0x82, 0x22, 0x5b, 0x8a, 0xee, 0xbd, 0xa7, 0xc1,
+	0x4e, 0xd6, 0x0a, 0x8c, 0xf8, 0x40, 0x30, 0x6d, 0x40, 0xde, 0xde, 0xb1, 0x7d, 0x96, 0x14, 0x63,
+	0x01, 0x7b, 0xc7, 0xf6, 0x18, 0x9e, 0x84, 0x02, 0x5d, 0xb7, 0xcf, 0x21, 0xb3, 0x49, 0xf2, 0x94,
+	0x26, 0x58, 0xd4, 0x3f, 0x25, 0x41, 0x19, 0x0f, 0x60, 0xe8, 0x55, 0x90, 0x69, 0x2c, 0x17, 0x61,
+	0xb9, 0xb2, 0xc5, 0x03, 0xfd, 0x96, 0x17, 0xe8, 0xb7, 0x9a, 0x5e, 0xa0, 0xdf, 0xcd, 0x7e, 0xf1,
+	0xd5, 0x46, 0xe2, 0xd3, 0xbf, 0x6c, 0x48, 0x1a, 0x93, 0x40, 0x57, 0x68, 0xd8, 0x32, 0xcc, 0x81,
+	0x6e, 0xb6, 0xd9, 0x27, 0xe7, 0x68, 0x4c, 0x32, 0xcc, 0xc1, 0x5e, 0x1b, 0xdd, 0x03, 0xa5, 0x65,
+	0x0d, 0x5c, 0x3c, 0x70, 0x87, 0xae, 0xce, 0x53, 0x8d, 0x08, 0xc6, 0x91, 0x90, 0xca, 0x13, 0x5e,
+	0xcd, 0xe3, 0x3c, 0x62, 0x8c, 0xda, 0x72, 0x2b, 0x4a, 0x40, 0x77, 0x00, 0xfc, 0x7c, 0xe4, 0x96,
+	0xe5, 0xcd, 0xd4, 0xb5, 0xfc, 0xce, 0xe6, 0xc4, 0x86, 0x3f, 0xf0, 0x58, 0xee, 0xdb, 0x6d, 0x83,
+	0xe0, 0x5d, 0x99, 0x7e, 0xae, 0x16, 0x92, 0x44, 0xcf, 0xc0, 0xb2, 0x61, 0xdb, 0xba, 0x4b, 0x0c,
+	0x82, 0xf5, 0x93, 0x73, 0x82, 0x5d, 0x16, 0xe7, 0x0b, 0x5a, 0xd1, 0xb0, 0xed, 0x63, 0x4a, 0xdd,
+	0xa5, 0x44, 0xf4, 0x34, 0x94, 0x68, 0x4c, 0x37, 0x8d, 0x9e, 0xde, 0xc5, 0xe6, 0x69, 0x97, 0xb0,
+	0x78, 0x9e, 0xd2, 0x8a, 0x82, 0xda, 0x60, 0x44, 0xb5, 0xed, 0xef, 0x38, 0x8b, 0xe7, 0x08, 0x81,
+	0xdc, 0x36, 0x88, 0xc1, 0x2c, 0x59, 0xd0, 0xd8, 0x33, 0xa5, 0xd9, 0x06, 0xe9, 0x0a, 0xfb, 0xb0,
+	0x67, 0x74, 0x19, 0x32, 0x42, 0x6d, 0x8a, 0xa9, 0x15, 0x23, 0xb4, 0x06, 0x69, 0xdb, 0xb1, 0xce,
+	0x30, 0xdb, 0xba, 0xac, 0xc6, 0x07, 0xaa, 0x06, 0xa5, 0x68, 0xec, 0x47, 0x25, 0x48, 0x92, 0x91,
+	0x98, 0x25, 0x49, 0x46, 0xe8, 0x25, 0x90, 0xa9, 0x21, 0xd9, 0x1c, 0xa5, 0x29, 0xd9, 0x4e, 0xc8,
+	0x35, 0xcf, 0x6d, 0xac, 0x31, 0x4e, 0x75, 0x19, 0x8a, 0x91, 0x9c, 0xa0, 0x5e, 0x86, 0xb5, 0x69,
+	0x21, 0x5e, 0xed, 0xfa, 0xf4, 0x48, 0xa8, 0x46, 0x37, 0x21, 0xeb, 0xc7, 0x78, 0xee, 0x38, 0x57,
+	0x26, 0xa6, 0xf5, 0x98, 0x35, 0x9f, 0x95, 0x7a, 0x0c, 0xdd, 0x80, 0xae, 0x21, 0x32, 0x7a, 0x41,
+	0x5b, 0x32, 0x6c, 0xbb, 0x61, 0xb8, 0x5d, 0xf5, 0x3d, 0x28, 0xc7, 0xc5, 0xef, 0x90, 0xc1, 0x24,
+	0xe6, 0xf6, 0x9e, 0xc1, 0x2e, 0x43, 0xa6, 0x63, 0x39, 0x7d, 0x83, 0x30, 0x65, 0x45, 0x4d, 0x8c,
+	0xa8, 0x21, 0x79, 0x2c, 0x4f, 0x31, 0x32, 0x1f, 0xa8, 0x3a, 0x5c, 0x89, 0x8d, 0xe1, 0x54, 0xc4,
+	0x1c, 0xb4, 0x31, 0x37, 0x6b, 0x51, 0xe3, 0x83, 0x40, 0x11, 0xff, 0x58, 0x3e, 0xa0, 0xd3, 0xba,
+	0x6c, 0xad, 0x4c, 0x7f, 0x4e, 0x13, 0x23, 0xf5, 0xb3, 0x14, 0x5c, 0x9e, 0x1e, 0xc9, 0xd1, 0x26,
+	0x14, 0xfa, 0xc6, 0x48, 0x27, 0x23, 0xe1, 0x76, 0x12, 0xdb, 0x78, 0xe8, 0x1b, 0xa3, 0xe6, 0x88,
+	0xfb, 0x9c, 0x02, 0x29, 0x32, 0x72, 0xcb, 0xc9, 0xcd, 0xd4, 0xb5, 0x82, 0x46, 0x1f, 0xd1, 0x7d,
+	0x58, 0xe9, 0x59, 0x2d, 0xa3, 0xa7, 0xf7, 0x0c, 0x97, 0xe8, 0x22, 0xc5, 0xf3, 0x43, 0xf4, 0xd4,
+	0x84, 0xb1, 0x79, 0x4c, 0xc6, 0x6d, 0xbe, 0x9f, 0x34, 0xe0, 0x08, 0xff, 0x5f, 0x66, 0x3a, 0xee,
+	0x19, 0xde, 0x56, 0xa3, 0xdb, 0x90, 0xef, 0x9b, 0xee, 0x09, 0xee, 0x1a, 0x67, 0xa6, 0xe5, 0x88,
+	0xd3, 0x34, 0xe9, 0x34, 0x6f, 0x06, 0x3c, 0x42, 0x53, 0x58, 0x2c, 0xb4, 0x25, 0xe9, 0x88, 0x0f,
+	0x7b, 0xd1, 0x24, 0xb3, 0x70, 0x34, 0x79, 0x09, 0xd6, 0x06, 0x78, 0x44, 0xf4, 0xe0, 0xbc, 0x72,
+	0x3f, 0x59, 0x62, 0xa6, 0x47, 0xf4, 0x9d, 0x7f, 0xc2, 0x5d, 0xea, 0x32, 0xe8, 0x39, 0x96, 0x0b,
+	0x6d, 0xcb, 0xc5, 0x8e, 0x6e, 0xb4, 0xdb, 0x0e, 0x76, 0x5d, 0x56, 0x3e, 0x15, 0x58, 0x82, 0x63,
+	0xf4, 0x2a, 0x27, 0xab, 0xbf, 0x08, 0x6f, 0x4d, 0x34, 0xf7, 0x09, 0xc3, 0x4b, 0x81, 0xe1, 0x8f,
+	0x61, 0x4d, 0xc8, 0xb7, 0x23, 0xb6, 0xe7, 0x35, 0xe8, 0xe3, 0x93, 0xe7, 0x6b, 0xdc, 0xe6, 0xc8,
+	0x13, 0x8f, 0x37, 0x7b, 0xea, 0xd1, 0xcc, 0x8e, 0x40, 0x66, 0x46, 0x91, 0x79, 0x88, 0xa1, 0xcf,
+	0xff, 0x6e, 0x5b, 0xf1, 0x71, 0x0a, 0x56, 0x26, 0x0a, 0x09, 0x7f, 0x61, 0xd2, 0xd4, 0x85, 0x25,
+	0xa7, 0x2e, 0x2c, 0xb5, 0xf0, 0xc2, 0xc4, 0x5e, 0xcb, 0xb3, 0xf7, 0x3a, 0xfd, 0x3d, 0xee, 0x75,
+	0xe6, 0xd1, 0xf6, 0xfa, 0x5f, 0xba, 0x0b, 0xbf, 0x96, 0xa0, 0x12, 0x5f, 0x7d, 0x4d, 0xdd, 0x8e,
+	0xe7, 0x61, 0xc5, 0xff, 0x14, 0x5f, 0x3d, 0x0f, 0x8c, 0x8a, 0xff, 0x42, 0xe8, 0x8f, 0xcd, 0x71,
+	0x4f, 0x43, 0x69, 0xac, 0x36, 0xe4, 0xae, 0x5c, 0x3c, 0x0b, 0xcf, 0xaf, 0xfe, 0x2c, 0xe5, 0x27,
+	0x9e, 0x48, 0x01, 0x37, 0xe5, 0xb4, 0xbe, 0x05, 0xab, 0x6d, 0xdc, 0x32, 0xdb, 0x8f, 0x7a, 0x58,
+	0x57, 0x84, 0xf4, 0x7f, 0xcf, 0xea, 0xa4, 0x97, 0xfc, 0x0a, 0x20, 0xab, 0x61, 0xd7, 0xa6, 0xf5,
+	0x18, 0xda, 0x85, 0x1c, 0x1e, 0xb5, 0xb0, 0x4d, 0xbc, 0x12, 0x76, 0x7a, 0x8b, 0xc0, 0xb9, 0xeb,
+	0x1e, 0x27, 0x6d, 0x90, 0x7d, 0x31, 0x74, 0x43, 0x60, 0x00, 0xf1, 0xed, 0xbc, 0x10, 0x0f, 0x83,
+	0x00, 0x2f, 0x7b, 0x20, 0x40, 0x2a, 0xb6, 0xbf, 0xe5, 0x52, 0x63, 0x28, 0xc0, 0x0d, 0x81, 0x02,
+	0xc8, 0x33, 0x26, 0x8b, 0xc0, 0x00, 0xb5, 0x08, 0x0c, 0x90, 0x99, 0xb1, 0xcc, 0x18, 0x1c, 0xe0,
+	0x65, 0x0f, 0x07, 0x58, 0x9a, 0xf1, 0xc5, 0x63, 0x40, 0xc0, 0x1b, 0x21, 0x20, 0x20, 0xc7, 0x44,
+	0x37, 0x63, 0x45, 0xa7, 0x20, 0x01, 0xaf, 0xf9, 0x48, 0x40, 0x21, 0x16, 0x45, 0x10, 0xc2, 0xe3,
+	0x50, 0xc0, 0xe1, 0x04, 0x14, 0xc0, 0x5b, 0xf7, 0x67, 0x62, 0x55, 0xcc, 0xc0, 0x02, 0x0e, 0x27,
+	0xb0, 0x80, 0xd2, 0x0c, 0x85, 0x33, 0xc0, 0x80, 0x1f, 0x4d, 0x07, 0x03, 0xe2, 0xdb, 0x75, 0xf1,
+	0x99, 0xf3, 0xa1, 0x01, 0x7a, 0x0c, 0x1a, 0xa0, 0xc4, 0x76, 0xae, 0x5c, 0xfd, 0xdc, 0x70, 0xc0,
+	0xfd, 0x29, 0x70, 0x00, 0x6f, 0xdc, 0xaf, 0xc5, 0x2a, 0x9f, 0x03, 0x0f, 0xb8, 0x3f, 0x05, 0x0f,
+	0x40, 0x33, 0xd5, 0xce, 0x04, 0x04, 0xee, 0x44, 0x01, 0x81, 0xd5, 0x98, 0xaa, 0x33, 0x38, 0xed,
+	0x31, 0x88, 0xc0, 0x49, 0x1c, 0x22, 0xc0, 0xbb, 0xf6, 0x17, 0x62, 0x35, 0x2e, 0x00, 0x09, 0x1c,
+	0x4e, 0x40, 0x02, 0x97, 0x66, 0x78, 0xda, 0xfc, 0x98, 0x40, 0x5a, 0xc9, 0xec, 0xcb, 0xd9, 0xac,
+	0x92, 0xe3, 0x68, 0xc0, 0xbe, 0x9c, 0xcd, 0x2b, 0x05, 0xf5, 0x39, 0x5a, 0xc1, 0x8c, 0xc5, 0x39,
+	0xda, 0x2b, 0x60, 0xc7, 0xb1, 0x1c, 0xd1, 0xdd, 0xf3, 0x81, 0x7a, 0x8d, 0xf6, 0x88, 0x41, 0x4c,
+	0xbb, 0x00, 0x3f, 0x60, 0x3d, 0x59, 0x28, 0x8e, 0xa9, 0xbf, 0x93, 0x02, 0x59, 0x86, 0x20, 0x84,
+	0xfb, 0xcb, 0x9c, 0xe8, 0x2f, 0x43, 0xa8, 0x42, 0x32, 0x8a, 0x2a, 0x6c, 0x40, 0x9e, 0xf6, 0x5a,
+	0x63, 0x80, 0x81, 0x61, 0xfb, 0x80, 0xc1, 0x75, 0x58, 0x61, 0x09, 0x93, 0x63, 0x0f, 0x22, 0x2d,
+	0xc9, 0x2c, 0x2d, 0x2d, 0xd3, 0x17, 0xdc, 0x3a, 0x3c, 0x3f, 0xbd, 0x08, 0xab, 0x21, 0x5e, 0xbf,
+	0x87, 0xe3, 0xdd, 0xb3, 0xe2, 0x73, 0x57, 0x45, 0x33, 0xf7, 0x47, 0x29, 0xb0, 0x50, 0x80, 0x34,
+	0x4c, 0x03, 0x05, 0xa4, 0xef, 0x09, 0x14, 0x48, 0x3e, 0x32, 0x28, 0x10, 0xee, 0x49, 0x53, 0xd1,
+	0x9e, 0xf4, 0x1f, 0x52, 0xb0, 0x27, 0x7e, 0x8b, 0xdf, 0xb2, 0xda, 0x58, 0x74, 0x89, 0xec, 0x99,
+	0x96, 0x24, 0x3d, 0xeb, 0x54, 0xf4, 0x82, 0xf4, 0x91, 0x72, 0xf9, 0x89, 0x27, 0x27, 0xf2, 0x8a,
+	0xdf, 0x60, 0xf2, 0xc4, 0x2f, 0x1a, 0x4c, 0x05, 0x52, 0x0f, 0x31, 0x87, 0x8b, 0x0b, 0x1a, 0x7d,
+	0xa4, 0x7c, 0xcc, 0xf9, 0x44, 0x02, 0xe7, 0x03, 0xf4, 0x2a, 0xe4, 0x18, 0xd8, 0xaf, 0x5b, 0xb6,
+	0x2b, 0x20, 0xe2, 0x48, 0x69, 0xc3, 0x11, 0xff, 0xad, 0x23, 0xca, 0x73, 0x68, 0xbb, 0x5a, 0xd6,
+	0x16, 0x4f, 0xa1, 0x8a, 0x23, 0x17, 0xa9, 0x38, 0xae, 0x42, 0x8e, 0x7e, 0xbd, 0x6b, 0x1b, 0x2d,
+	0x5c, 0x06, 0xf6, 0xa1, 0x01, 0x41, 0xfd, 0x5b, 0x12, 0x96, 0xc7, 0x12, 0xcd, 0xd4, 0xb5, 0x7b,
+	0x2e, 0x99, 0x0c, 0x41, 0x1e, 0xf3, 0xd9, 0x63, 0x1d, 0xe0, 0xd4, 0x70, 0xf5, 0x0f, 0x8d, 0x01,
+	0xc1, 0x6d, 0x61, 0x94, 0x10, 0x05, 0x55, 0x20, 0x4b, 0x47, 0x43, 0x17, 0xb7, 0x05, 0xfa, 0xe2,
+	0x8f, 0x51, 0x03, 0x32, 0xf8, 0x0c, 0x0f, 0x88, 0x5b, 0x5e, 0x62, 0xdb, 0x7e, 0x79, 0xb2, 0x1d,
+	0xa6, 0xaf, 0x77, 0xcb, 0x74, 0xb3, 0xbf, 0xfd, 0x6a, 0x43, 0xe1, 0xdc, 0x2f, 0x58, 0x7d, 0x93,
+	0xe0, 0xbe, 0x4d, 0xce, 0x35, 0x21, 0x1f, 0xb5, 0x42, 0x76, 0xcc, 0x0a, 0xa1, 0x46, 0x3f, 0x17,
+	0x6e, 0xf4, 0xe9, 0xb7, 0xd9, 0x8e, 0x69, 0x39, 0x26, 0x39, 0x67, 0xa6, 0x4b, 0x69, 0xfe, 0x98,
+	0xbe, 0x73, 0x69, 0xe1, 0x3a, 0x68, 0x61, 0x96, 0x85, 0x65, 0xcd, 0x1f, 0xf3, 0xe8, 0xa1, 0x15,
+	0xfb, 0xb8, 0x6f, 0x5b, 0x56, 0x4f, 0xe7, 0x11, 0xa2, 0x0a, 0xa5, 0x68, 0x56, 0x46, 0x4f, 0x41,
+	0xd1, 0xc1, 0xc4, 0x30, 0x07, 0x7a, 0xa4, 0x84, 0x2e, 0x70, 0x22, 0x3f, 0x91, 0xfb, 0x72, 0x56,
+	0x52, 0x92, 0xfb, 0x72, 0x36, 0xa9, 0xa4, 0xd4, 0x23, 0xb8, 0x34, 0x35, 0x2b, 0xa3, 0x57, 0x20,
+	0x17, 0x24, 0x74, 0x89, 0xd9, 0xea, 0x02, 0x9c, 0x26, 0xe0, 0x55, 0x7f, 0x2f, 0x05, 0x2a, 0xa3,
+	0xc8, 0x4f, 0x1d, 0x32, 0x0e, 0x76, 0x87, 0x3d, 0x8e, 0xc5, 0x94, 0x76, 0x5e, 0x9c, 0x2f, 0x9f,
+	0x53, 0xea, 0xb0, 0x47, 0x34, 0x21, 0xac, 0xbe, 0x0b, 0x19, 0x4e, 0x41, 0x79, 0x58, 0xba, 0x7f,
+	0x70, 0xf7, 0xe0, 0xf0, 0xed, 0x03, 0x25, 0x81, 0x00, 0x32, 0xd5, 0x5a, 0xad, 0x7e, 0xd4, 0x54,
+	0x24, 0x94, 0x83, 0x74, 0x75, 0xf7, 0x50, 0x6b, 0x2a, 0x49, 0x4a, 0xd6, 0xea, 0xfb, 0xf5, 0x5a,
+	0x53, 0x49, 0xa1, 0x15, 0x28, 0xf2, 0x67, 0xfd, 0xce, 0xa1, 0xf6, 0x66, 0xb5, 0xa9, 0xc8, 0x21,
+	0xd2, 0x71, 0xfd, 0xe0, 0x76, 0x5d, 0x53, 0xd2, 0xea, 0xff, 0xc0, 0x95, 0xd8, 0x0a, 0x20, 0x80,
+	0x75, 0xa4, 0x10, 0xac, 0xa3, 0x7e, 0x96, 0xa4, 0x2d, 0x51, 0x5c, 0x5a, 0x47, 0xfb, 0x63, 0x0b,
+	0xdf, 0x59, 0xa0, 0x26, 0x18, 0x5b, 0x3d, 0xed, 0x82, 0x1c, 0xdc, 0xc1, 0xa4, 0xd5, 0xe5, 0x65,
+	0x06, 0x8f, 0x5f, 0x45, 0xad, 0x28, 0xa8, 0x4c, 0xc8, 0xe5, 0x6c, 0xef, 0xe3, 0x16, 0xd1, 0xb9,
+	0xe3, 0xb9, 0xac, 0x15, 0xc9, 0x51, 0x36, 0x4a, 0x3d, 0xe6, 0x44, 0xf5, 0xbd, 0x85, 0x6c, 0x99,
+	0x83, 0xb4, 0x56, 0x6f, 0x6a, 0xef, 0x28, 0x29, 0x84, 0xa0, 0xc4, 0x1e, 0xf5, 0xe3, 0x83, 0xea,
+	0xd1, 0x71, 0xe3, 0x90, 0xda, 0x72, 0x15, 0x96, 0x3d, 0x5b, 0x7a, 0xc4, 0xb4, 0xfa, 0x3c, 0x3c,
+	0x16, 0x53, 0x93, 0x4c, 0x36, 0x64, 0xea, 0x6f, 0xa4, 0x30, 0x77, 0xb4, 0xae, 0x38, 0x84, 0x8c,
+	0x4b, 0x0c, 0x32, 0x74, 0x85, 0x11, 0x5f, 0x99, 0xb7, 0x48, 0xd9, 0xf2, 0x1e, 0x8e, 0x99, 0xb8,
+	0x26, 0xd4, 0xa8, 0x37, 0xa1, 0x14, 0x7d, 0x13, 0x6f, 0x83, 0xc0, 0x89, 0x92, 0xea, 0x2d, 0x40,
+	0x93, 0xb5, 0xcb, 0x94, 0xe6, 0x54, 0x9a, 0xd6, 0x9c, 0xfe, 0x56, 0x82, 0xc7, 0x2f, 0xa8, 0x53,
+	0xd0, 0x5b, 0x63, 0x8b, 0x7c, 0x6d, 0x91, 0x2a, 0x67, 0x8b, 0xd3, 0xc6, 0x96, 0x79, 0x03, 0x0a,
+	0x61, 0xfa, 0x7c, 0x8b, 0xfc, 0x36, 0x19, 0x1c, 0xe2, 0x68, 0x17, 0x1d, 0x04, 0x50, 0xe9, 0x3b,
+	0x06, 0xd0, 0xd7, 0x01, 0xc8, 0x48, 0xe7, 0x6e, 0xed, 0x65, 0xe1, 0x27, 0xa6, 0xa0, 0x93, 0xb8,
+	0xd5, 0x1c, 0x89, 0x43, 0x90, 0x23, 0xe2, 0xc9, 0x45, 0xc7, 0x61, 0x48, 0x61, 0xc8, 0x32, 0xb4,
+	0x2b, 0xda, 0xed, 0x79, 0x53, 0x79, 0x00, 0x3d, 0x70, 0xb2, 0x8b, 0xde, 0x81, 0xc7, 0xc6, 0xca,
+	0x0c, 0x5f, 0xb5, 0x3c, 0x6f, 0xb5, 0x71, 0x29, 0x5a, 0x6d, 0x78, 0xaa, 0xc3, 0xb5, 0x42, 0x3a,
+	0x5a, 0x2b, 0xbc, 0x03, 0x10, 0x40, 0x0b, 0x34, 0xc2, 0x38, 0xd6, 0x70, 0xd0, 0x66, 0x1e, 0x90,
+	0xd6, 0xf8, 0x00, 0xdd, 0x84, 0x34, 0xf5, 0x24, 0xcf, 0x4e, 0x93, 0xa1, 0x98, 0x7a, 0x42, 0x08,
+	0x9a, 0xe0, 0xdc, 0xaa, 0x09, 0x68, 0x12, 0xde, 0x8d, 0x99, 0xe2, 0x8d, 0xe8, 0x14, 0x4f, 0xc6,
+	0x02, 0xc5, 0xd3, 0xa7, 0xfa, 0x08, 0xd2, 0x6c, 0xe7, 0x69, 0xca, 0x66, 0xff, 0x14, 0x44, 0xad,
+	0x49, 0x9f, 0xd1, 0x8f, 0x01, 0x0c, 0x42, 0x1c, 0xf3, 0x64, 0x18, 0x4c, 0xb0, 0x31, 0xdd, 0x73,
+	0xaa, 0x1e, 0xdf, 0xee, 0x55, 0xe1, 0x42, 0x6b, 0x81, 0x68, 0xc8, 0x8d, 0x42, 0x0a, 0xd5, 0x03,
+	0x28, 0x45, 0x65, 0xbd, 0xea, 0x88, 0x7f, 0x43, 0xb4, 0x3a, 0xe2, 0xc5, 0xae, 0xa8, 0x8e, 0xfc,
+	0xda, 0x2a, 0xc5, 0x7f, 0x9c, 0xb0, 0x81, 0xfa, 0x93, 0x24, 0x14, 0xc2, 0x8e, 0xf7, 0x9f, 0x57,
+	0xc0, 0xa8, 0x3f, 0x97, 0x20, 0xeb, 0x2f, 0x3f, 0xfa, 0x17, 0x25, 0xf2, 0xdb, 0x89, 0x5b, 0x2f,
+	0x19, 0xfe, 0xf5, 0xc1, 0x7f, 0x32, 0xa5, 0xfc, 0x9f, 0x4c, 0xb7, 0xfc, 0xf4, 0x17, 0x07, 0xa7,
+	0x84, 0x6d, 0x2d, 0xbc, 0xca, 0xcb, 0xf6, 0xb7, 0x20, 0xe7, 0x9f, 0x5e, 0xda, 0xb2, 0x78, 0xb0,
+	0x93, 0x24, 0xce, 0x90, 0x00, 0x0d, 0xd7, 0x20, 0x6d, 0x5b, 0x1f, 0x8a, 0xff, 0x2a, 0x29, 0x8d,
+	0x0f, 0xd4, 0x36, 0x2c, 0x8f, 0x1d, 0x7d, 0x74, 0x0b, 0x96, 0xec, 0xe1, 0x89, 0xee, 0x39, 0xc7,
+	0x18, 0x38, 0xe7, 0x15, 0xc3, 0xc3, 0x93, 0x9e, 0xd9, 0xba, 0x8b, 0xcf, 0xbd, 0x8f, 0xb1, 0x87,
+	0x27, 0x77, 0xb9, 0x0f, 0xf1, 0x59, 0x92, 0xe1, 0x59, 0x7e, 0x29, 0x41, 0xd6, 0x3b, 0x13, 0xe8,
+	0xff, 0x20, 0xe7, 0x87, 0x15, 0xff, 0xc7, 0x68, 0x6c, 0x3c, 0x12, 0xfa, 0x03, 0x11, 0x54, 0xf5,
+	0xfe, 0xe8, 0x9a, 0x6d, 0xbd, 0xd3, 0x33, 0xb8, 0x2f, 0x95, 0xa2, 0x36, 0xe3, 0x81, 0x87, 0xc5,
+	0xe3, 0xbd, 0xdb, 0x77, 0x7a, 0xc6, 0xa9, 0x96, 0x67, 0x32, 0x7b, 0x6d, 0x3a, 0x10, 0x95, 0xdd,
+	0xdf, 0x25, 0x50, 0xc6, 0x4f, 0xec, 0x77, 0xfe, 0xba, 0xc9, 0x34, 0x97, 0x9a, 0x92, 0xe6, 0xd0,
+	0x36, 0xac, 0xfa, 0x1c, 0xba, 0x6b, 0x9e, 0x0e, 0x0c, 0x32, 0x74, 0xb0, 0x80, 0x33, 0x91, 0xff,
+	0xea, 0xd8, 0x7b, 0x33, 0xb9, 0xea, 0xf4, 0x23, 0xae, 0xfa, 0xe3, 0x24, 0xe4, 0x43, 0xe0, 0x2a,
+	0xfa, 0xdf, 0x50, 0x30, 0x2a, 0x4d, 0xc9, 0x0c, 0x21, 0xde, 0xe0, 0x27, 0x67, 0xd4, 0x4c, 0xc9,
+	0xc5, 0xcd, 0x14, 0x07, 0x61, 0x7b, 0x58, 0xad, 0xbc, 0x30, 0x56, 0xfb, 0x02, 0x20, 0x62, 0x11,
+	0xa3, 0xa7, 0x9f, 0x59, 0xc4, 0x1c, 0x9c, 0xea, 0xdc, 0x0d, 0x79, 0xe8, 0x50, 0xd8, 0x9b, 0x07,
+	0xec, 0xc5, 0x11, 0xf3, 0xc8, 0x9f, 0x4a, 0x90, 0xf5, 0xcb, 0xee, 0x45, 0x7f, 0x81, 0x5e, 0x86,
+	0x8c, 0xa8, 0x2c, 0xf9, 0x3f, 0x50, 0x31, 0x9a, 0x0a, 0x4a, 0x57, 0x20, 0xdb, 0xc7, 0xc4, 0x60,
+	0x71, 0x90, 0x67, 0x35, 0x7f, 0x7c, 0xfd, 0x35, 0xc8, 0x87, 0x7e, 0x1f, 0xd3, 0xd0, 0x78, 0x50,
+	0x7f, 0x5b, 0x49, 0x54, 0x96, 0x3e, 0xf9, 0x7c, 0x33, 0x75, 0x80, 0x3f, 0xa4, 0xa7, 0x59, 0xab,
+	0xd7, 0x1a, 0xf5, 0xda, 0x5d, 0x45, 0xaa, 0xe4, 0x3f, 0xf9, 0x7c, 0x73, 0x49, 0xc3, 0x0c, 0x8f,
+	0xbc, 0x7e, 0x17, 0x96, 0xc7, 0x36, 0x26, 0x5a, 0xb6, 0x20, 0x28, 0xdd, 0xbe, 0x7f, 0x74, 0x6f,
+	0xaf, 0x56, 0x6d, 0xd6, 0xf5, 0x07, 0x87, 0xcd, 0xba, 0x22, 0xa1, 0xc7, 0x60, 0xf5, 0xde, 0xde,
+	0xff, 0x37, 0x9a, 0x7a, 0xed, 0xde, 0x5e, 0xfd, 0xa0, 0xa9, 0x57, 0x9b, 0xcd, 0x6a, 0xed, 0xae,
+	0x92, 0xdc, 0xf9, 0x3c, 0x0f, 0x72, 0x75, 0xb7, 0xb6, 0x87, 0x6a, 0x20, 0x33, 0x20, 0xe5, 0xc2,
+	0xfb, 0x63, 0x95, 0x8b, 0x91, 0x65, 0x74, 0x07, 0xd2, 0x0c, 0x63, 0x41, 0x17, 0x5f, 0x28, 0xab,
+	0xcc, 0x80, 0x9a, 0xe9, 0xc7, 0xb0, 0x13, 0x79, 0xe1, 0x0d, 0xb3, 0xca, 0xc5, 0xc8, 0x33, 0xba,
+	0x07, 0x4b, 0x5e, 0x8b, 0x3d, 0xeb, 0xda, 0x57, 0x65, 0x26, 0x1c, 0x4c, 0x97, 0xc6, 0xa1, 0x8a,
+	0x8b, 0x2f, 0x9f, 0x55, 0x66, 0x60, 0xd2, 0x68, 0x0f, 0x32, 0xa2, 0x1d, 0x9d, 0x71, 0x9f, 0xac,
+	0x32, 0x0b, 0x65, 0x46, 0x1a, 0xe4, 0x02, 0x10, 0x68, 0xf6, 0x95, 0xba, 0xca, 0x1c, 0x70, 0x3b,
+	0x7a, 0x17, 0x8a, 0xd1, 0x56, 0x77, 0xbe, 0x3b, 0x6b, 0x95, 0x39, 0xf1, 0x6c, 0xaa, 0x3f, 0xda,
+	0xf7, 0xce, 0x77, 0x87, 0xad, 0x32, 0x27, 0xbc, 0x8d, 0xde, 0x87, 0x95, 0xc9, 0xbe, 0x74, 0xfe,
+	0x2b, 0x6d, 0x95, 0x05, 0x00, 0x6f, 0xd4, 0x07, 0x34, 0xa5, 0x9f, 0x5d, 0xe0, 0x86, 0x5b, 0x65,
+	0x11, 0xfc, 0x1b, 0xb5, 0x61, 0x79, 0xbc, 0x49, 0x9c, 0xf7, 0xc6, 0x5b, 0x65, 0x6e, 0x2c, 0x9c,
+	0xcf, 0x12, 0x6d, 0x2e, 0xe7, 0xbd, 0x01, 0x57, 0x99, 0x1b, 0x1a, 0x47, 0xf7, 0x01, 0x42, 0xfd,
+	0xe1, 0x1c, 0x37, 0xe2, 0x2a, 0xf3, 0x80, 0xe4, 0xc8, 0x86, 0xd5, 0x69, 0x8d, 0xe3, 0x22, 0x17,
+	0xe4, 0x2a, 0x0b, 0x61, 0xe7, 0xd4, 0x9f, 0xa3, 0x2d, 0xe0, 0x7c, 0x17, 0xe6, 0x2a, 0x73, 0x82,
+	0xe8, 0xbb, 0xd5, 0x2f, 0xbe, 0x5e, 0x97, 0xbe, 0xfc, 0x7a, 0x5d, 0xfa, 0xeb, 0xd7, 0xeb, 0xd2,
+	0xa7, 0xdf, 0xac, 0x27, 0xbe, 0xfc, 0x66, 0x3d, 0xf1, 0xe7, 0x6f, 0xd6, 0x13, 0x3f, 0x78, 0xf6,
+	0xd4, 0x24, 0xdd, 0xe1, 0xc9, 0x56, 0xcb, 0xea, 0x6f, 0xb7, 0xac, 0x3e, 0x26, 0x27, 0x1d, 0x12,
+	0x3c, 0x04, 0xf7, 0x9e, 0x4f, 0x32, 0x2c, 0x83, 0xde, 0xf8, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x4c, 0x12, 0x29, 0x4f, 0x17, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6400,6 +6435,23 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -8383,6 +8435,16 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -13782,6 +13844,76 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -232,7 +232,7 @@ func (bcR *Reactor) respondToPeer(msg *bcproto.BlockRequest, src p2p.Peer) (queu
 func (bcR *Reactor) Receive(e p2p.Envelope) {
 	if err := ValidateMsg(e.Message); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
-		bcR.Switch.StopPeerForError(e.Src, err)
+		bcR.Switch.ReportPeerBehaviour(e.Src, p2p.BadMessage(err))
 		return
 	}
 
@@ -333,7 +333,7 @@ func (bcR *Reactor) poolRoutine(stateSynced bool) {
 			case err := <-bcR.errorsCh:
 				peer := bcR.Switch.Peers().Get(err.peerID)
 				if peer != nil {
-					bcR.Switch.StopPeerForError(peer, err)
+					bcR.Switch.ReportPeerBehaviour(peer, p2p.Unresponsive(err))
 				}
 
 			case <-statusUpdateTicker.C:
@@ -484,14 +484,14 @@ FOR_LOOP:
 				if peer != nil {
 					// NOTE: we've already removed the peer's request, but we
 					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer, ErrReactorValidation{Err: err})
+					bcR.Switch.ReportPeerBehaviour(peer, p2p.BadMessage(ErrReactorValidation{Err: err}))
 				}
 				peerID2 := bcR.pool.RedoRequest(second.Height)
 				peer2 := bcR.Switch.Peers().Get(peerID2)
 				if peer2 != nil && peer2 != peer {
 					// NOTE: we've already removed the peer's request, but we
 					// still need to clean up the rest.
-					bcR.Switch.StopPeerForError(peer2, ErrReactorValidation{Err: err})
+					bcR.Switch.ReportPeerBehaviour(peer2, p2p.BadMessage(ErrReactorValidation{Err: err}))
 				}
 				continue FOR_LOOP
 			}
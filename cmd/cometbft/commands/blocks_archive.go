@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/compress"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+var (
+	archiveStartHeight int64
+	archiveEndHeight   int64
+	archiveChunkSize   int
+)
+
+func init() {
+	ExportBlocksCmd.Flags().Int64Var(&archiveStartHeight, "start-height", 0,
+		"the first height to export (default: the base height of the blockstore)")
+	ExportBlocksCmd.Flags().Int64Var(&archiveEndHeight, "end-height", 0,
+		"the last height to export (default: the height of the blockstore)")
+	ExportBlocksCmd.Flags().IntVar(&archiveChunkSize, "chunk-size", store.DefaultArchiveChunkSize,
+		"the size in bytes above which the chunks of the archive are written out")
+}
+
+// ExportBlocksCmd exports a range of blocks to an archive file.
+var ExportBlocksCmd = &cobra.Command{
+	Use:     "export-blocks [file]",
+	Aliases: []string{"export_blocks"},
+	Short:   "export blocks to an archive file",
+	Long: `
+export-blocks writes the blocks of a height range, with their commits, validators,
+consensus params and FinalizeBlock responses, to an archive file that can be
+imported with import-blocks by a node using any database backend.
+The node must be stopped.
+	`,
+	Example: `
+	cometbft export-blocks blocks.archive
+	cometbft export-blocks --start-height 2 --end-height 10 blocks.archive
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		st, err := ss.LoadFromDBOrGenesisFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+		from, to := archiveStartHeight, archiveEndHeight
+		if from == 0 {
+			from = bs.Base()
+		}
+		if to == 0 {
+			to = bs.Height()
+		}
+
+		f, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		if err := store.ExportBlocks(f, bs, ss, st.ChainID, from, to, archiveChunkSize); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to export blocks: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		fmt.Printf("Exported blocks %d to %d to %s\n", from, to, args[0])
+		return nil
+	},
+}
+
+// ImportBlocksCmd imports the blocks of an archive file.
+var ImportBlocksCmd = &cobra.Command{
+	Use:     "import-blocks [file]",
+	Aliases: []string{"import_blocks"},
+	Short:   "import blocks from an archive file",
+	Long: `
+import-blocks verifies the blocks of an archive file written by export-blocks
+against their commits, and saves them to the blockstore along with their
+validators, consensus params and FinalizeBlock responses. The blocks must
+follow the ones of the blockstore, if any, or else follow the latest state,
+which is the genesis state of a new node, and be committed by its validators.
+The validators of each block must be the next validators of the previous one.
+The node must be stopped.
+
+The FinalizeBlock response of the last block of the archive is not imported,
+since it can only be verified against the next block. The state is restored
+up to the height before the last block, which the node replays to the
+application on start.
+	`,
+	Example: `
+	cometbft import-blocks blocks.archive
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bs, ss, err := openStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		st, err := ss.LoadFromDBOrGenesisFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		from, to, err := store.ImportBlocks(f, bs, ss, st)
+		if err != nil {
+			if to >= from && from > 0 {
+				fmt.Printf("Imported blocks %d to %d\n", from, to)
+			}
+			return fmt.Errorf("failed to import blocks: %w", err)
+		}
+
+		fmt.Printf("Imported blocks %d to %d from %s\n", from, to, args[0])
+		return nil
+	},
+}
+
+// openStateAndBlockStore opens the block and state stores, creating them if
+// they do not exist.
+func openStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
+	dbType := dbm.BackendType(config.DBBackend)
+	compression, err := compress.CodecFromName(config.Storage.Compression)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses:    config.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: config.Storage.KeepRecentABCIResponses,
+		Compression:             compression,
+	})
+	return store.NewBlockStore(blockStoreDB, store.WithCompression(compression)), stateStore, nil
+}
@@ -66,7 +66,10 @@ func runInspect(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	stateStore := state.NewStore(stateDB, state.StoreOptions{DiscardABCIResponses: false})
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses:    config.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: config.Storage.KeepRecentABCIResponses,
+	})
 	defer stateStore.Close()
 
 	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
@@ -0,0 +1,352 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/progressbar"
+)
+
+// migratedDBs are the databases of a node copied by migrate-db, if they exist.
+var migratedDBs = []string{"blockstore", "state", "evidence", "tx_index"}
+
+// migrateBatchSize is the number of keys written at once, after which the
+// progress of a migration is saved.
+const migrateBatchSize = 10000
+
+var migrateDBTo string
+
+func init() {
+	MigrateDBCmd.Flags().StringVar(&migrateDBTo, "to", "", "the database backend to migrate to")
+	_ = MigrateDBCmd.MarkFlagRequired("to")
+}
+
+// MigrateDBCmd copies the databases of a node to another database backend.
+var MigrateDBCmd = &cobra.Command{
+	Use:     "migrate-db",
+	Aliases: []string{"migrate_db"},
+	Short:   "migrate the node databases to another database backend",
+	Long: `
+migrate-db copies the blockstore, state, evidence and tx_index databases, key
+by key, to databases of another backend, and verifies the copies. The node must
+be stopped. If interrupted, running the command again resumes the migration.
+
+Once all the databases are copied, the former ones are moved to the
+backup-<backend> directory of the data directory, and db_backend must be set to
+the new backend in config.toml before restarting the node. An interrupted move
+is resumed as well; the copies are never deleted until all of them are moved.
+	`,
+	Example: `
+	cometbft migrate-db --to rocksdb
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		to := dbm.BackendType(migrateDBTo)
+		if err := migrateDBs(config, to); err != nil {
+			return fmt.Errorf("failed to migrate databases: %w", err)
+		}
+
+		fmt.Printf("Migrated databases to %s. Set db_backend = %q in config.toml before restarting the node.\n",
+			to, to)
+		return nil
+	},
+}
+
+// migrateDBs migrates the databases of a node from the backend in its
+// configuration to the given one. The databases are copied to a staging
+// directory, and swapped with the former ones once all of them are verified.
+// The swap is recorded in a journal, from which an interrupted swap resumes.
+func migrateDBs(config *cfg.Config, to dbm.BackendType) error {
+	from := dbm.BackendType(config.DBBackend)
+	if to == from {
+		return fmt.Errorf("the databases already use %s", to)
+	}
+	dbDir := config.DBDir()
+	stagingDir := filepath.Join(dbDir, "migrate-"+string(to))
+	backupDir := filepath.Join(dbDir, "backup-"+string(from))
+	journalFile := filepath.Join(dbDir, "migrate-"+string(to)+".json")
+
+	names, err := loadSwapJournal(journalFile)
+	if err != nil {
+		return err
+	}
+	if names == nil {
+		for _, name := range migratedDBs {
+			if !cmtos.FileExists(filepath.Join(dbDir, name+".db")) {
+				continue
+			}
+			if cmtos.FileExists(filepath.Join(backupDir, name+".db")) {
+				return fmt.Errorf("a backup of %s already exists in %v", name, backupDir)
+			}
+			names = append(names, name)
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("no database found in %v", dbDir)
+		}
+		if err := cmtos.EnsureDir(stagingDir, 0o700); err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			fmt.Printf("Migrating %s:\n", name)
+			if err := migrateDB(name, dbDir, from, stagingDir, to); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		if err := saveSwapJournal(journalFile, names); err != nil {
+			return err
+		}
+	}
+
+	return swapDBs(names, dbDir, stagingDir, backupDir, journalFile)
+}
+
+// swapDBs moves the databases of the given names from dbDir to backupDir, and
+// from stagingDir to dbDir. Each step is skipped if it was already done by an
+// interrupted run, so that the swap can be resumed until all the databases are
+// in dbDir. The staging directory and the journal are only removed then.
+func swapDBs(names []string, dbDir, stagingDir, backupDir, journalFile string) error {
+	if err := cmtos.EnsureDir(backupDir, 0o700); err != nil {
+		return err
+	}
+	for _, name := range names {
+		file := name + ".db"
+		current, staged, backup := filepath.Join(dbDir, file), filepath.Join(stagingDir, file),
+			filepath.Join(backupDir, file)
+
+		if !cmtos.FileExists(staged) {
+			if !cmtos.FileExists(current) {
+				return fmt.Errorf("%s is missing from both %v and %v", file, dbDir, stagingDir)
+			}
+			continue
+		}
+		if cmtos.FileExists(current) {
+			if cmtos.FileExists(backup) {
+				return fmt.Errorf("%s exists in %v, %v and %v", file, dbDir, stagingDir, backupDir)
+			}
+			if err := os.Rename(current, backup); err != nil {
+				return err
+			}
+		}
+		if err := os.Rename(staged, current); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(stagingDir); err != nil {
+		return err
+	}
+	return os.Remove(journalFile)
+}
+
+// migrateDB copies, then verifies, the database of the given name.
+func migrateDB(name, srcDir string, from dbm.BackendType, dstDir string, to dbm.BackendType) error {
+	src, err := dbm.NewDB(name, from, srcDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := dbm.NewDB(name, to, dstDir)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	return copyDB(src, dst, filepath.Join(dstDir, name+".migration.json"))
+}
+
+// migrationProgress is saved while copying a database, to resume the copy
+// after an interruption.
+type migrationProgress struct {
+	// LastKey is the last key copied, in the order of the keys.
+	LastKey []byte `json:"last_key"`
+	Copied  int64  `json:"copied"`
+	// Verified is set once the copy is complete and verified.
+	Verified bool `json:"verified"`
+}
+
+// copyDB copies all the keys of src to dst, then verifies that both hold the
+// same data. The progress is saved to progressFile, from which an interrupted
+// copy resumes.
+func copyDB(src, dst dbm.DB, progressFile string) error {
+	progress, err := loadMigrationProgress(progressFile)
+	if err != nil {
+		return err
+	}
+	if progress.Verified {
+		fmt.Println("already migrated")
+		return nil
+	}
+
+	total, err := countKeys(src)
+	if err != nil {
+		return err
+	}
+	var bar progressbar.Bar
+	bar.NewOption(0, max(total, 1))
+	bar.Play(progress.Copied)
+
+	it, err := src.Iterator(progress.LastKey, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	defer func() { _ = batch.Close() }()
+	size := 0
+	flush := func() error {
+		if err := batch.WriteSync(); err != nil {
+			return err
+		}
+		if err := batch.Close(); err != nil {
+			return err
+		}
+		batch = dst.NewBatch()
+		size = 0
+		bar.Play(progress.Copied)
+		return saveMigrationProgress(progressFile, progress)
+	}
+
+	for ; it.Valid(); it.Next() {
+		// The iteration resumes from the last key copied, included.
+		if progress.LastKey != nil && bytes.Equal(it.Key(), progress.LastKey) {
+			continue
+		}
+		// Iterators may reuse the slices they return.
+		key, value := bytes.Clone(it.Key()), bytes.Clone(it.Value())
+		if err := batch.Set(key, value); err != nil {
+			return err
+		}
+		progress.LastKey = key
+		progress.Copied++
+		if size++; size == migrateBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	bar.Finish()
+
+	fmt.Println("verifying")
+	if err := verifyDBCopy(src, dst); err != nil {
+		return err
+	}
+	progress.Verified = true
+	return saveMigrationProgress(progressFile, progress)
+}
+
+// verifyDBCopy checks that dst holds exactly the keys and values of src.
+func verifyDBCopy(src, dst dbm.DB) error {
+	srcIt, err := src.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer srcIt.Close()
+	dstIt, err := dst.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer dstIt.Close()
+
+	for ; srcIt.Valid(); srcIt.Next() {
+		if !dstIt.Valid() {
+			return fmt.Errorf("key %X is missing from the copy", srcIt.Key())
+		}
+		if !bytes.Equal(srcIt.Key(), dstIt.Key()) || !bytes.Equal(srcIt.Value(), dstIt.Value()) {
+			return fmt.Errorf("copy differs at key %X", srcIt.Key())
+		}
+		dstIt.Next()
+	}
+	if dstIt.Valid() {
+		return fmt.Errorf("unexpected key %X in the copy", dstIt.Key())
+	}
+	if err := srcIt.Error(); err != nil {
+		return err
+	}
+	return dstIt.Error()
+}
+
+func countKeys(db dbm.DB) (int64, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var n int64
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	return n, it.Error()
+}
+
+func loadMigrationProgress(file string) (*migrationProgress, error) {
+	progress := &migrationProgress{}
+	bz, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return progress, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, progress); err != nil {
+		return nil, fmt.Errorf("reading migration progress: %w", err)
+	}
+	return progress, nil
+}
+
+func saveMigrationProgress(file string, progress *migrationProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return cmtos.WriteFile(file, bz, 0o600)
+}
+
+// swapJournal is saved once all the databases are migrated, to resume their
+// swap after an interruption.
+type swapJournal struct {
+	DBs []string `json:"dbs"`
+}
+
+// loadSwapJournal returns the names of the databases being swapped, or nil if
+// no swap was started.
+func loadSwapJournal(file string) ([]string, error) {
+	bz, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	journal := &swapJournal{}
+	if err := json.Unmarshal(bz, journal); err != nil {
+		return nil, fmt.Errorf("reading migration journal: %w", err)
+	}
+	if len(journal.DBs) == 0 {
+		return nil, fmt.Errorf("no database in migration journal %v", file)
+	}
+	return journal.DBs, nil
+}
+
+func saveSwapJournal(file string, names []string) error {
+	bz, err := json.Marshal(&swapJournal{DBs: names})
+	if err != nil {
+		return err
+	}
+	return cmtos.WriteFile(file, bz, 0o600)
+}
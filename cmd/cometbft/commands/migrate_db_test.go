@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+)
+
+func TestCopyDB(t *testing.T) {
+	src := dbm.NewMemDB()
+	for i := 0; i < 2*migrateBatchSize+10; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key-%06d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	progressFile := filepath.Join(t.TempDir(), "progress.json")
+
+	// Simulate an interrupted copy, whose first keys were copied.
+	dst := dbm.NewMemDB()
+	lastKey := []byte(fmt.Sprintf("key-%06d", migrateBatchSize-1))
+	for i := 0; i < migrateBatchSize; i++ {
+		key := []byte(fmt.Sprintf("key-%06d", i))
+		value, err := src.Get(key)
+		require.NoError(t, err)
+		require.NoError(t, dst.Set(key, value))
+	}
+	require.NoError(t, saveMigrationProgress(progressFile,
+		&migrationProgress{LastKey: lastKey, Copied: migrateBatchSize}))
+
+	require.NoError(t, copyDB(src, dst, progressFile))
+	require.NoError(t, verifyDBCopy(src, dst))
+
+	progress, err := loadMigrationProgress(progressFile)
+	require.NoError(t, err)
+	require.True(t, progress.Verified)
+	require.EqualValues(t, 2*migrateBatchSize+10, progress.Copied)
+
+	// A verified copy is not copied again.
+	require.NoError(t, src.Set([]byte("new"), []byte("value")))
+	require.NoError(t, copyDB(src, dst, progressFile))
+	require.Error(t, verifyDBCopy(src, dst))
+}
+
+func TestVerifyDBCopy(t *testing.T) {
+	src := dbm.NewMemDB()
+	require.NoError(t, src.Set([]byte("a"), []byte("1")))
+	require.NoError(t, src.Set([]byte("b"), []byte("2")))
+
+	testCases := map[string]struct {
+		keys map[string]string
+		err  string
+	}{
+		"same":         {map[string]string{"a": "1", "b": "2"}, ""},
+		"missing":      {map[string]string{"a": "1"}, "missing"},
+		"extra":        {map[string]string{"a": "1", "b": "2", "c": "3"}, "unexpected key"},
+		"other value":  {map[string]string{"a": "1", "b": "3"}, "differs"},
+		"other key":    {map[string]string{"a": "1", "c": "2"}, "differs"},
+		"empty":        {map[string]string{}, "missing"},
+		"before first": {map[string]string{"0": "1", "b": "2"}, "differs"},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			dst := dbm.NewMemDB()
+			for k, v := range tc.keys {
+				require.NoError(t, dst.Set([]byte(k), []byte(v)))
+			}
+			err := verifyDBCopy(src, dst)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestMigrateDBsSameBackend(t *testing.T) {
+	config := cfg.TestConfig()
+	config.SetRoot(t.TempDir())
+	err := migrateDBs(config, dbm.BackendType(config.DBBackend))
+	require.ErrorContains(t, err, "already use")
+}
+
+// makeDBDirs creates a directory for each of the given databases in dir.
+func makeDBDirs(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		require.NoError(t, cmtos.EnsureDir(filepath.Join(dir, name+".db"), 0o700))
+	}
+}
+
+func TestSwapDBsResumes(t *testing.T) {
+	dbDir := t.TempDir()
+	stagingDir := filepath.Join(dbDir, "migrate-rocksdb")
+	backupDir := filepath.Join(dbDir, "backup-goleveldb")
+	journalFile := filepath.Join(dbDir, "migrate-rocksdb.json")
+	names := []string{"blockstore", "state", "evidence"}
+	require.NoError(t, saveSwapJournal(journalFile, names))
+
+	// Simulate a swap interrupted after the blockstore was backed up, and
+	// after the state was swapped.
+	makeDBDirs(t, backupDir, "blockstore", "state")
+	makeDBDirs(t, stagingDir, "blockstore", "evidence")
+	makeDBDirs(t, dbDir, "state", "evidence")
+
+	loaded, err := loadSwapJournal(journalFile)
+	require.NoError(t, err)
+	require.Equal(t, names, loaded)
+	require.NoError(t, swapDBs(loaded, dbDir, stagingDir, backupDir, journalFile))
+
+	for _, name := range names {
+		require.True(t, cmtos.FileExists(filepath.Join(dbDir, name+".db")), name)
+		require.True(t, cmtos.FileExists(filepath.Join(backupDir, name+".db")), name)
+	}
+	require.False(t, cmtos.FileExists(stagingDir))
+	require.False(t, cmtos.FileExists(journalFile))
+}
+
+func TestSwapDBsKeepsStaging(t *testing.T) {
+	dbDir := t.TempDir()
+	stagingDir := filepath.Join(dbDir, "migrate-rocksdb")
+	backupDir := filepath.Join(dbDir, "backup-goleveldb")
+	journalFile := filepath.Join(dbDir, "migrate-rocksdb.json")
+	names := []string{"blockstore", "state"}
+	require.NoError(t, saveSwapJournal(journalFile, names))
+
+	// The blockstore is in all the directories: it is not clear which copy
+	// must be kept, so nothing must be deleted.
+	makeDBDirs(t, dbDir, "blockstore", "state")
+	makeDBDirs(t, stagingDir, "blockstore", "state")
+	makeDBDirs(t, backupDir, "blockstore")
+	err := swapDBs(names, dbDir, stagingDir, backupDir, journalFile)
+	require.ErrorContains(t, err, "blockstore.db exists in")
+	require.True(t, cmtos.FileExists(filepath.Join(stagingDir, "blockstore.db")))
+	require.True(t, cmtos.FileExists(filepath.Join(stagingDir, "state.db")))
+	require.True(t, cmtos.FileExists(journalFile))
+
+	// The state is missing: the staging directory must be kept.
+	dbDir = t.TempDir()
+	stagingDir = filepath.Join(dbDir, "migrate-rocksdb")
+	makeDBDirs(t, dbDir, "blockstore")
+	makeDBDirs(t, stagingDir, "blockstore")
+	err = swapDBs(names, dbDir, stagingDir, filepath.Join(dbDir, "backup-goleveldb"), journalFile)
+	require.ErrorContains(t, err, "state.db is missing")
+	require.True(t, cmtos.FileExists(stagingDir))
+}
+
+func TestMigrateDBsExistingBackup(t *testing.T) {
+	config := cfg.TestConfig()
+	config.SetRoot(t.TempDir())
+	dbDir := config.DBDir()
+	makeDBDirs(t, dbDir, "blockstore", "state")
+	makeDBDirs(t, filepath.Join(dbDir, "backup-"+config.DBBackend), "state")
+
+	err := migrateDBs(config, dbm.GoLevelDBBackend)
+	require.ErrorContains(t, err, "a backup of state already exists")
+	require.False(t, cmtos.FileExists(filepath.Join(dbDir, "migrate-"+string(dbm.GoLevelDBBackend))))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/libs/progressbar"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/block"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
 	"github.com/cometbft/cometbft/state/txindex"
@@ -44,7 +45,7 @@ the tooling will reindex until the latest block height(inclusive). User can omit
 either or both arguments.
 
 Note: This operation requires ABCI Responses. Do not set DiscardABCIResponses to true if you
-want to use this command.
+want to use this command, or only the heights kept with KeepRecentABCIResponses can be reindexed.
 	`,
 	Example: `
 	cometbft reindex-event
@@ -103,6 +104,10 @@ func init() {
 }
 
 func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, txindex.TxIndexer, error) {
+	filter, err := block.EventFilterFromConfig(cfg.TxIndex)
+	if err != nil {
+		return nil, nil, err
+	}
 	switch strings.ToLower(cfg.TxIndex.Indexer) {
 	case "null":
 		return nil, nil, errors.New("found null event sink, please check the tx-index section in the config.toml")
@@ -111,19 +116,25 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 		if conn == "" {
 			return nil, nil, errors.New("the psql connection settings cannot be empty")
 		}
-		es, err := psql.NewEventSink(conn, chainID)
+		es, err := psql.NewEventSink(conn, chainID, psql.WithEventFilter(filter))
 		if err != nil {
 			return nil, nil, err
 		}
 		return es.BlockIndexer(), es.TxIndexer(), nil
+	case "sqlite":
+		txIndexer, blockIndexer, err := block.SQLiteIndexerFromConfig(cfg, chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return blockIndexer, txIndexer, nil
 	case "kv":
 		store, err := dbm.NewDB("tx_index", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
 		if err != nil {
 			return nil, nil, err
 		}
 
-		txIndexer := kv.NewTxIndex(store)
-		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		txIndexer := kv.NewTxIndex(store, kv.WithEventFilter(filter))
+		blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithEventFilter(filter))
 		return blockIndexer, txIndexer, nil
 	default:
 		return nil, nil, fmt.Errorf("unsupported event sink type: %s", cfg.TxIndex.Indexer)
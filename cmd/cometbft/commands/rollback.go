@@ -11,18 +11,27 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/os"
 	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer/block"
 	"github.com/cometbft/cometbft/store"
 )
 
-var removeBlock = false
+var (
+	removeBlock      = false
+	rollbackToHeight int64
+	removeBlocks     = false
+)
 
 func init() {
 	RollbackStateCmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	RollbackStateCmd.Flags().Int64Var(&rollbackToHeight, "to-height", 0,
+		"roll back to the given height instead of by one height")
+	RollbackStateCmd.Flags().BoolVar(&removeBlocks, "remove-blocks", false,
+		"with --to-height, remove the blocks above the next height, which can no longer be replayed")
 }
 
 var RollbackStateCmd = &cobra.Command{
 	Use:   "rollback",
-	Short: "rollback CometBFT state by one height",
+	Short: "rollback CometBFT state by one height, or to a given height",
 	Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -31,9 +40,31 @@ The application should also roll back to height n - 1. If the --hard flag is not
 no blocks will be removed so upon restarting CometBFT the transactions in block n will be 
 re-executed against the application. Using --hard will also remove block n. This can
 be done multiple times.
+
+With --to-height, the state is rolled back to the given height h in one go. The
+application should also roll back to height h. The blocks above h + 1 cannot be
+replayed, and must be removed before CometBFT can start, which --remove-blocks
+does. Using --hard will remove block h + 1 as well as the blocks above it.
+
+In both cases, the transactions and blocks indexed above the rolled back height are
+removed from the indexer.
 `,
+	Example: `
+	cometbft rollback
+	cometbft rollback --to-height 100 --remove-blocks
+	cometbft rollback --to-height 100 --hard
+	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		height, hash, err := RollbackState(config, removeBlock)
+		var (
+			height int64
+			hash   []byte
+			err    error
+		)
+		if rollbackToHeight > 0 {
+			height, hash, err = RollbackStateToHeight(config, rollbackToHeight, removeBlocks, removeBlock)
+		} else {
+			height, hash, err = RollbackState(config, removeBlock)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to rollback state: %w", err)
 		}
@@ -52,6 +83,27 @@ be done multiple times.
 // at height n - 1. Note state here refers to CometBFT state not application state.
 // Returns the latest state height and app hash alongside an error if there was one.
 func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error) {
+	return rollbackState(config, func(bs state.BlockStore, ss state.Store) (int64, []byte, error) {
+		return state.Rollback(bs, ss, removeBlock)
+	})
+}
+
+// RollbackStateToHeight overwrites the current state with the state at the given
+// height, removing the blocks which can no longer be replayed if removeBlocks is
+// true, or all the blocks above the height if removeBlock is true.
+// Returns the latest state height and app hash alongside an error if there was one.
+func RollbackStateToHeight(config *cfg.Config, height int64, removeBlocks, removeBlock bool) (int64, []byte, error) {
+	return rollbackState(config, func(bs state.BlockStore, ss state.Store) (int64, []byte, error) {
+		return state.RollbackToHeight(bs, ss, height, removeBlocks, removeBlock)
+	})
+}
+
+// rollbackState rolls back the state with the given function, then removes the
+// heights above the rolled back one from the indexers.
+func rollbackState(
+	config *cfg.Config,
+	rollback func(state.BlockStore, state.Store) (int64, []byte, error),
+) (int64, []byte, error) {
 	// use the parsed config to load the block and state store
 	blockStore, stateStore, err := loadStateAndBlockStore(config)
 	if err != nil {
@@ -62,8 +114,28 @@ func RollbackState(config *cfg.Config, removeBlock bool) (int64, []byte, error)
 		_ = stateStore.Close()
 	}()
 
-	// rollback the last state
-	return state.Rollback(blockStore, stateStore, removeBlock)
+	height, hash, err := rollback(blockStore, stateStore)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	st, err := stateStore.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	txIndexer, blockIndexer, err := block.IndexerFromConfig(config, cfg.DefaultDBProvider, st.ChainID)
+	if err != nil {
+		return -1, nil, err
+	}
+	// The transactions are removed first, as the blocks they belong to may be
+	// referred to by them.
+	if err := txIndexer.DeleteHeightsAbove(height); err != nil {
+		return -1, nil, fmt.Errorf("failed to remove transactions from the indexer: %w", err)
+	}
+	if err := blockIndexer.DeleteHeightsAbove(height); err != nil {
+		return -1, nil, fmt.Errorf("failed to remove blocks from the indexer: %w", err)
+	}
+	return height, hash, nil
 }
 
 func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store, error) {
@@ -90,7 +162,8 @@ func loadStateAndBlockStore(config *cfg.Config) (*store.BlockStore, state.Store,
 		return nil, nil, err
 	}
 	stateStore := state.NewStore(stateDB, state.StoreOptions{
-		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DiscardABCIResponses:    config.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: config.Storage.KeepRecentABCIResponses,
 	})
 
 	return blockStore, stateStore, nil
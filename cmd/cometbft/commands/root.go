@@ -82,15 +82,23 @@ var RootCmd = &cobra.Command{
 			logger = log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
 		}
 
-		logger, err = cmtflags.ParseLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel)
+		// The log level can be changed while the node is running, e.g. via
+		// the privileged gRPC ConsensusControlService.
+		baseLogger := logger
+		logger, err = log.NewDynamicLevelLogger(config.LogLevel, func(level string) (log.Logger, error) {
+			leveledLogger, err := cmtflags.ParseLogLevel(level, baseLogger, cfg.DefaultLogLevel)
+			if err != nil {
+				return nil, err
+			}
+			if viper.GetBool(cli.TraceFlag) {
+				leveledLogger = log.NewTracingLogger(leveledLogger)
+			}
+			return leveledLogger, nil
+		})
 		if err != nil {
 			return err
 		}
 
-		if viper.GetBool(cli.TraceFlag) {
-			logger = log.NewTracingLogger(logger)
-		}
-
 		logger = logger.With("module", "main")
 		return nil
 	},
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/store"
+)
+
+var (
+	verifyStartHeight int64
+	verifyEndHeight   int64
+)
+
+func init() {
+	VerifyDataCmd.Flags().Int64Var(&verifyStartHeight, "start-height", 0,
+		"the first height to verify (default: the base height of the blockstore)")
+	VerifyDataCmd.Flags().Int64Var(&verifyEndHeight, "end-height", 0,
+		"the last height to verify (default: the height of the blockstore)")
+}
+
+// VerifyDataCmd checks the consistency of the block store, the state store and
+// the indexes of a node.
+var VerifyDataCmd = &cobra.Command{
+	Use:     "verify-data",
+	Aliases: []string{"verify_data"},
+	Short:   "verify the consistency of the node databases",
+	Long: `
+verify-data walks the blocks of the blockstore and checks their hashes, their
+commits, the links between them, and their validators, consensus params and
+FinalizeBlock responses in the state store. It then checks that the latest state
+matches the blockstore, and, with the kv indexer, that every block and
+transaction is indexed. The node must be stopped.
+
+Every inconsistency found is printed in a JSON report, and the command fails
+if there is any.
+	`,
+	Example: `
+	cometbft verify-data
+	cometbft verify-data --start-height 2 --end-height 10
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		// Only the kv indexer can be looked up by height and by hash.
+		var (
+			blockIndexer indexer.BlockIndexer
+			txIndexer    txindex.TxIndexer
+		)
+		if strings.ToLower(config.TxIndex.Indexer) == "kv" {
+			if blockIndexer, txIndexer, err = loadEventSinks(config, ""); err != nil {
+				return err
+			}
+		} else {
+			fmt.Fprintf(os.Stderr, "Skipping the index checks of the %q indexer\n", config.TxIndex.Indexer)
+		}
+
+		report, err := store.VerifyData(cmd.Context(), bs, ss, txIndexer, blockIndexer,
+			verifyStartHeight, verifyEndHeight)
+		if err != nil {
+			return fmt.Errorf("failed to verify data: %w", err)
+		}
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bz))
+
+		if n := len(report.Inconsistencies); n > 0 {
+			return fmt.Errorf("found %d inconsistencies", n)
+		}
+		return nil
+	},
+}
@@ -28,6 +28,10 @@ func main() {
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
+		cmd.ExportBlocksCmd,
+		cmd.ImportBlocksCmd,
+		cmd.MigrateDBCmd,
+		cmd.VerifyDataCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
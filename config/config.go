@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,11 +41,27 @@ const (
 	DefaultPrivValKeyName   = "priv_validator_key.json"
 	DefaultPrivValStateName = "priv_validator_state.json"
 
-	DefaultNodeKeyName  = "node_key.json"
-	DefaultAddrBookName = "addrbook.json"
+	DefaultNodeKeyName    = "node_key.json"
+	DefaultAddrBookName   = "addrbook.json"
+	DefaultPeerScoresName = "peer_scores.json"
 
 	DefaultPruningInterval = 10 * time.Second
 
+	// MempoolTypeFlood is a mempool that keeps transactions in the order in
+	// which they were received (FIFO) and floods them to all peers.
+	MempoolTypeFlood = "flood"
+	// MempoolTypePriority is a mempool that orders transactions by the
+	// priority returned by the application in CheckTx and evicts lower
+	// priority transactions when full.
+	MempoolTypePriority = "priority"
+
+	// StorageCompressionNone stores the block parts and the FinalizeBlock
+	// responses uncompressed.
+	StorageCompressionNone = "none"
+	// StorageCompressionSnappy compresses the block parts and the
+	// FinalizeBlock responses with snappy.
+	StorageCompressionSnappy = "snappy"
+
 	v0 = "v0"
 	v1 = "v1"
 	v2 = "v2"
@@ -62,8 +79,9 @@ var (
 	defaultPrivValKeyPath   = filepath.Join(DefaultConfigDir, DefaultPrivValKeyName)
 	defaultPrivValStatePath = filepath.Join(DefaultDataDir, DefaultPrivValStateName)
 
-	defaultNodeKeyPath  = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
-	defaultAddrBookPath = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
+	defaultNodeKeyPath    = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
+	defaultAddrBookPath   = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
+	defaultPeerScoresPath = filepath.Join(DefaultConfigDir, DefaultPeerScoresName)
 
 	minSubscriptionBufferSize     = 100
 	defaultSubscriptionBufferSize = 200
@@ -164,6 +182,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Storage.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [storage] section: %w", err)
 	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "tx_index", Err: err}
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "instrumentation", Err: err}
 	}
@@ -536,6 +557,18 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service allows submitting and checking transactions,
+	// and provides information about the transactions in the mempool.
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
+	// The gRPC event service allows subscribing to events, subject to the
+	// same limits as the JSON-RPC subscribe endpoint.
+	EventService *GRPCEventServiceConfig `mapstructure:"event_service"`
+
+	// The gRPC tx service allows looking up and searching the indexed
+	// transactions.
+	TxService *GRPCTxServiceConfig `mapstructure:"tx_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -547,6 +580,9 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
+		EventService:        DefaultGRPCEventServiceConfig(),
+		TxService:           DefaultGRPCTxServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -557,6 +593,9 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
+		EventService:        TestGRPCEventServiceConfig(),
+		TxService:           TestGRPCTxServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -571,6 +610,9 @@ func (cfg *GRPCConfig) ValidateBasic() error {
 			)
 		}
 	}
+	if cfg.Privileged.ReindexService.MaxHeightsPerSecond < 0 {
+		return errors.New("privileged.reindex_service.max_heights_per_second cannot be negative")
+	}
 	return nil
 }
 
@@ -616,6 +658,54 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: true,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCEventServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCEventServiceConfig() *GRPCEventServiceConfig {
+	return &GRPCEventServiceConfig{
+		Enabled: true,
+	}
+}
+
+func TestGRPCEventServiceConfig() *GRPCEventServiceConfig {
+	return &GRPCEventServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCTxServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCTxServiceConfig() *GRPCTxServiceConfig {
+	return &GRPCTxServiceConfig{
+		Enabled: true,
+	}
+}
+
+func TestGRPCTxServiceConfig() *GRPCTxServiceConfig {
+	return &GRPCTxServiceConfig{
+		Enabled: true,
+	}
+}
+
 //-----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -629,19 +719,43 @@ type GRPCPrivilegedConfig struct {
 	// The gRPC pruning service provides control over the depth of block
 	// storage information that the node
 	PruningService *GRPCPruningServiceConfig `mapstructure:"pruning_service"`
+
+	// The gRPC consensus control service provides operational actions on the
+	// running node, such as dialing peers or changing the log level.
+	ConsensusControlService *GRPCConsensusControlServiceConfig `mapstructure:"consensus_control_service"`
+
+	// The gRPC data companion service pushes the committed blocks and their
+	// results to a data companion.
+	DataCompanionService *GRPCDataCompanionServiceConfig `mapstructure:"data_companion_service"`
+
+	// The gRPC reindex service rebuilds the transaction and block indexes of a
+	// range of heights in the background.
+	ReindexService *GRPCReindexServiceConfig `mapstructure:"reindex_service"`
+
+	// The gRPC peer filter service changes the IP ranges and node IDs which
+	// peers are accepted from.
+	PeerFilterService *GRPCPeerFilterServiceConfig `mapstructure:"peer_filter_service"`
 }
 
 func DefaultGRPCPrivilegedConfig() *GRPCPrivilegedConfig {
 	return &GRPCPrivilegedConfig{
-		ListenAddress:  "",
-		PruningService: DefaultGRPCPruningServiceConfig(),
+		ListenAddress:           "",
+		PruningService:          DefaultGRPCPruningServiceConfig(),
+		ConsensusControlService: DefaultGRPCConsensusControlServiceConfig(),
+		DataCompanionService:    DefaultGRPCDataCompanionServiceConfig(),
+		ReindexService:          DefaultGRPCReindexServiceConfig(),
+		PeerFilterService:       DefaultGRPCPeerFilterServiceConfig(),
 	}
 }
 
 func TestGRPCPrivilegedConfig() *GRPCPrivilegedConfig {
 	return &GRPCPrivilegedConfig{
-		ListenAddress:  "tcp://127.0.0.1:36671",
-		PruningService: TestGRPCPruningServiceConfig(),
+		ListenAddress:           "tcp://127.0.0.1:36671",
+		PruningService:          TestGRPCPruningServiceConfig(),
+		ConsensusControlService: TestGRPCConsensusControlServiceConfig(),
+		DataCompanionService:    TestGRPCDataCompanionServiceConfig(),
+		ReindexService:          TestGRPCReindexServiceConfig(),
+		PeerFilterService:       TestGRPCPeerFilterServiceConfig(),
 	}
 }
 
@@ -661,6 +775,75 @@ func TestGRPCPruningServiceConfig() *GRPCPruningServiceConfig {
 	}
 }
 
+type GRPCConsensusControlServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCConsensusControlServiceConfig() *GRPCConsensusControlServiceConfig {
+	return &GRPCConsensusControlServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCConsensusControlServiceConfig() *GRPCConsensusControlServiceConfig {
+	return &GRPCConsensusControlServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCDataCompanionServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCDataCompanionServiceConfig() *GRPCDataCompanionServiceConfig {
+	return &GRPCDataCompanionServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCDataCompanionServiceConfig() *GRPCDataCompanionServiceConfig {
+	return &GRPCDataCompanionServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCReindexServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// The maximum number of heights reindexed per second, or 0 for no limit.
+	MaxHeightsPerSecond int `mapstructure:"max_heights_per_second"`
+}
+
+func DefaultGRPCReindexServiceConfig() *GRPCReindexServiceConfig {
+	return &GRPCReindexServiceConfig{
+		Enabled:             false,
+		MaxHeightsPerSecond: 100,
+	}
+}
+
+func TestGRPCReindexServiceConfig() *GRPCReindexServiceConfig {
+	return &GRPCReindexServiceConfig{
+		Enabled:             true,
+		MaxHeightsPerSecond: 0,
+	}
+}
+
+type GRPCPeerFilterServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCPeerFilterServiceConfig() *GRPCPeerFilterServiceConfig {
+	return &GRPCPeerFilterServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCPeerFilterServiceConfig() *GRPCPeerFilterServiceConfig {
+	return &GRPCPeerFilterServiceConfig{
+		Enabled: true,
+	}
+}
+
 //-----------------------------------------------------------------------------
 // P2PConfig
 
@@ -674,6 +857,11 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Set true to also accept and dial QUIC connections, over UDP on the port
+	// of ListenAddress. Peers which do not accept QUIC connections are dialed
+	// over TCP.
+	QUIC bool `mapstructure:"quic"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
@@ -688,6 +876,14 @@ type P2PConfig struct { //nolint: maligned
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
 
+	// Path to the scores of the peers, which reactors lower when peers
+	// misbehave and raise when they contribute
+	PeerScores string `mapstructure:"peer_scores_file"`
+
+	// How long peers whose score drops to the minimum are banned for
+	// (0 disables bans)
+	PeerBanDuration time.Duration `mapstructure:"peer_ban_duration"`
+
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
@@ -712,6 +908,12 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Comma separated list of channel ID:rate pairs, limiting the rate at
+	// which the packets of some channels can be sent, in bytes/second.
+	// A channel above its rate only sends when the other channels have
+	// nothing to send.
+	ChannelSendRates string `mapstructure:"channel_send_rates"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -728,6 +930,17 @@ type P2PConfig struct { //nolint: maligned
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
+	// Comma separated lists of IPs and CIDR ranges, e.g. "10.0.0.0/8", of the
+	// peers accepted and rejected. If allowed_peer_cidrs is empty, peers with
+	// any IP but the denied ones are accepted.
+	AllowedPeerCIDRs string `mapstructure:"allowed_peer_cidrs"`
+	DeniedPeerCIDRs  string `mapstructure:"denied_peer_cidrs"`
+
+	// Comma separated lists of the IDs of the peers accepted and rejected. If
+	// allowed_peer_ids is empty, all peers but the denied ones are accepted.
+	AllowedPeerIDs string `mapstructure:"allowed_peer_ids"`
+	DeniedPeerIDs  string `mapstructure:"denied_peer_ids"`
+
 	// Peer connection configuration.
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
@@ -747,6 +960,8 @@ func DefaultP2PConfig() *P2PConfig {
 		ExternalAddress:              "",
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
+		PeerScores:                   defaultPeerScoresPath,
+		PeerBanDuration:              24 * time.Hour,
 		MaxNumInboundPeers:           40,
 		MaxNumOutboundPeers:          10,
 		PersistentPeersMaxDialPeriod: 0 * time.Second,
@@ -779,6 +994,11 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// PeerScoresFile returns the full path to the peer scores
+func (cfg *P2PConfig) PeerScoresFile() string {
+	return rootify(cfg.PeerScores, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -794,6 +1014,9 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.PersistentPeersMaxDialPeriod < 0 {
 		return cmterrors.ErrNegativeField{Field: "persistent_peers_max_dial_period"}
 	}
+	if cfg.PeerBanDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_ban_duration"}
+	}
 	if cfg.MaxPacketMsgPayloadSize < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_packet_msg_payload_size"}
 	}
@@ -803,9 +1026,43 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return cmterrors.ErrNegativeField{Field: "recv_rate"}
 	}
+	if _, err := cfg.ChannelSendRatesByID(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ChannelSendRatesByID parses ChannelSendRates into the send rates by channel
+// ID. IDs are decimal, or hexadecimal with a 0x prefix, e.g. "0x30:512000".
+func (cfg *P2PConfig) ChannelSendRatesByID() (map[byte]int64, error) {
+	rates := make(map[byte]int64)
+	for _, pair := range strings.Split(cfg.ChannelSendRates, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		id, rate, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("channel_send_rates: expected channel ID:rate, got %q", pair)
+		}
+		chID, err := strconv.ParseUint(strings.TrimSpace(id), 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("channel_send_rates: invalid channel ID %q: %w", id, err)
+		}
+		if _, ok := rates[byte(chID)]; ok {
+			return nil, fmt.Errorf("channel_send_rates: duplicate channel ID %q", id)
+		}
+		r, err := strconv.ParseInt(strings.TrimSpace(rate), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("channel_send_rates: invalid rate %q: %w", rate, err)
+		}
+		if r < 0 {
+			return nil, cmterrors.ErrNegativeField{Field: "channel_send_rates"}
+		}
+		rates[byte(chID)] = r
+	}
+	return rates, nil
+}
+
 // FuzzConnConfig is a FuzzedConnection configuration.
 type FuzzConnConfig struct {
 	Mode         int
@@ -834,12 +1091,22 @@ func DefaultFuzzConnConfig() *FuzzConnConfig {
 // Note: Until v0.37 there was a `Version` field to select which implementation
 // of the mempool to use. Two versions used to exist: the current, default
 // implementation (previously called v0), and a prioritized mempool (v1), which
-// was removed (see https://github.com/cometbft/cometbft/issues/260).
+// was removed (see https://github.com/cometbft/cometbft/issues/260). The
+// implementation is now selected with the `Type` field.
 type MempoolConfig struct {
 	// RootDir is the root directory for all data. This should be configured via
 	// the $CMTHOME env variable or --home cmd flag rather than overriding this
 	// struct field.
 	RootDir string `mapstructure:"home"`
+	// Type (default: "flood") selects the mempool implementation. Possible
+	// values are:
+	//   - "flood": transactions are kept and reaped in the order in which
+	//     they were received (FIFO).
+	//   - "priority": transactions are reaped in descending order of the
+	//     priority returned by the application in CheckTx. When the mempool
+	//     is full, lower priority transactions are evicted to make room for
+	//     higher priority ones.
+	Type string `mapstructure:"type"`
 	// Recheck (default: true) defines whether CometBFT should recheck the
 	// validity for all remaining transaction in the mempool after a block.
 	// Since a block affects the application state, some transactions in the
@@ -881,6 +1148,7 @@ type MempoolConfig struct {
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
+		Type:      MempoolTypeFlood,
 		Recheck:   true,
 		Broadcast: true,
 		WalPath:   "",
@@ -913,6 +1181,11 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
+	switch cfg.Type {
+	case MempoolTypeFlood, MempoolTypePriority:
+	default:
+		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
+	}
 	if cfg.Size < 0 {
 		return cmterrors.ErrNegativeField{Field: "size"}
 	}
@@ -1226,6 +1499,14 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+	// If ABCI responses are discarded, the number of latest heights whose ABCI
+	// responses are still kept to serve `/block_results` queries. The older
+	// responses are deleted by the pruner.
+	KeepRecentABCIResponses int64 `mapstructure:"keep_recent_abci_responses"`
+	// The compression of the block parts and of the FinalizeBlock responses
+	// saved from now on: "none" or "snappy". Data saved with any other
+	// compression, or none, can still be read.
+	Compression string `mapstructure:"compression"`
 	// Configuration related to storage pruning.
 	Pruning *PruningConfig `mapstructure:"pruning"`
 
@@ -1242,9 +1523,11 @@ type StorageConfig struct {
 // CometBFT storage optimization.
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
-		Pruning:              DefaultPruningConfig(),
-		GenesisHash:          "",
+		DiscardABCIResponses:    false,
+		KeepRecentABCIResponses: 0,
+		Compression:             StorageCompressionNone,
+		Pruning:                 DefaultPruningConfig(),
+		GenesisHash:             "",
 	}
 }
 
@@ -1252,13 +1535,24 @@ func DefaultStorageConfig() *StorageConfig {
 // testing.
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
-		Pruning:              TestPruningConfig(),
-		GenesisHash:          "",
+		DiscardABCIResponses:    false,
+		KeepRecentABCIResponses: 0,
+		Compression:             StorageCompressionNone,
+		Pruning:                 TestPruningConfig(),
+		GenesisHash:             "",
 	}
 }
 
 func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.KeepRecentABCIResponses < 0 {
+		return errors.New("keep_recent_abci_responses cannot be negative")
+	}
+	switch cfg.Compression {
+	case StorageCompressionNone, StorageCompressionSnappy:
+	default:
+		return fmt.Errorf("unknown compression %q, must be %q or %q",
+			cfg.Compression, StorageCompressionNone, StorageCompressionSnappy)
+	}
 	if err := cfg.Pruning.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [pruning] section: %w", err)
 	}
@@ -1286,11 +1580,23 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite
+	//      database, stored in the data directory. Only supported by the
+	//      binaries built with cgo.
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// Event types and composite keys ("type.key") whose attributes are
+	// indexed, among those the application flags for indexing. "*" matches
+	// any sequence of characters. If empty, all of them are indexed.
+	AllowEvents []string `mapstructure:"allow_events"`
+
+	// Event types and composite keys whose attributes are not indexed, even if
+	// allowed.
+	DenyEvents []string `mapstructure:"deny_events"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
@@ -1305,6 +1611,19 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// ValidateBasic performs basic validation and returns an error if any check
+// fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	for _, patterns := range [][]string{cfg.AllowEvents, cfg.DenyEvents} {
+		for _, pattern := range patterns {
+			if pattern == "" {
+				return errors.New("allow_events and deny_events cannot contain empty patterns")
+			}
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
@@ -1392,12 +1711,15 @@ type PruningConfig struct {
 	Interval time.Duration `mapstructure:"interval"`
 	// Data companion-related pruning configuration.
 	DataCompanion *DataCompanionPruningConfig `mapstructure:"data_companion"`
+	// Retention policies evaluated by the pruner.
+	Retention *RetentionPruningConfig `mapstructure:"retention"`
 }
 
 func DefaultPruningConfig() *PruningConfig {
 	return &PruningConfig{
 		Interval:      DefaultPruningInterval,
 		DataCompanion: DefaultDataCompanionPruningConfig(),
+		Retention:     DefaultRetentionPruningConfig(),
 	}
 }
 
@@ -1405,6 +1727,7 @@ func TestPruningConfig() *PruningConfig {
 	return &PruningConfig{
 		Interval:      DefaultPruningInterval,
 		DataCompanion: TestDataCompanionPruningConfig(),
+		Retention:     TestRetentionPruningConfig(),
 	}
 }
 
@@ -1415,6 +1738,9 @@ func (cfg *PruningConfig) ValidateBasic() error {
 	if err := cfg.DataCompanion.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [data_companion] section: %w", err)
 	}
+	if err := cfg.Retention.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [retention] section: %w", err)
+	}
 	return nil
 }
 
@@ -1469,3 +1795,46 @@ func (cfg *DataCompanionPruningConfig) ValidateBasic() error {
 	}
 	return nil
 }
+
+//-----------------------------------------------------------------------------
+// RetentionPruningConfig
+
+type RetentionPruningConfig struct {
+	// Keep the blocks committed within this period. 0 disables this policy.
+	BlockRetainAge time.Duration `mapstructure:"block_retain_age"`
+	// Keep as many of the latest blocks as fit in this number of bytes. 0
+	// disables this policy.
+	BlockStoreMaxBytes int64 `mapstructure:"block_store_max_bytes"`
+	// Keep the ABCI results of this number of latest blocks. 0 disables this
+	// policy.
+	ABCIResultsRetainBlocks int64 `mapstructure:"abci_results_retain_blocks"`
+}
+
+func DefaultRetentionPruningConfig() *RetentionPruningConfig {
+	return &RetentionPruningConfig{
+		BlockRetainAge:          0,
+		BlockStoreMaxBytes:      0,
+		ABCIResultsRetainBlocks: 0,
+	}
+}
+
+func TestRetentionPruningConfig() *RetentionPruningConfig {
+	return &RetentionPruningConfig{
+		BlockRetainAge:          0,
+		BlockStoreMaxBytes:      0,
+		ABCIResultsRetainBlocks: 0,
+	}
+}
+
+func (cfg *RetentionPruningConfig) ValidateBasic() error {
+	if cfg.BlockRetainAge < 0 {
+		return errors.New("block_retain_age cannot be negative")
+	}
+	if cfg.BlockStoreMaxBytes < 0 {
+		return errors.New("block_store_max_bytes cannot be negative")
+	}
+	if cfg.ABCIResultsRetainBlocks < 0 {
+		return errors.New("abci_results_retain_blocks cannot be negative")
+	}
+	return nil
+}
@@ -105,6 +105,23 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestP2PConfigChannelSendRates(t *testing.T) {
+	cfg := config.TestP2PConfig()
+	rates, err := cfg.ChannelSendRatesByID()
+	require.NoError(t, err)
+	assert.Empty(t, rates)
+
+	cfg.ChannelSendRates = "0x30:512000, 32:1024000"
+	rates, err = cfg.ChannelSendRatesByID()
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]int64{0x30: 512000, 0x20: 1024000}, rates)
+
+	for _, invalid := range []string{"0x30", "0x100:1", "0x30:-1", "0x30:1,0x30:2", "0x30:abc"} {
+		cfg.ChannelSendRates = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
 func TestMempoolConfigValidateBasic(t *testing.T) {
 	cfg := config.TestMempoolConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -121,6 +138,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Type = config.MempoolTypePriority
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Type = "fifo"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
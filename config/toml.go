@@ -285,6 +285,25 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service allows submitting and checking transactions, looking
+# up and listing the transactions in the mempool, and streaming the
+# transactions entering and leaving the mempool.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
+# The gRPC event service allows subscribing to events using the same query
+# language as the JSON-RPC subscribe endpoint. Subscriptions count towards the
+# max_subscription_clients and max_subscriptions_per_client limits of the
+# [rpc] section.
+[grpc.event_service]
+enabled = {{ .GRPC.EventService.Enabled }}
+
+# The gRPC tx service allows looking up indexed transactions by hash, and
+# streaming the indexed transactions matching a query. It requires transaction
+# indexing to be enabled in the [tx_index] section.
+[grpc.tx_service]
+enabled = {{ .GRPC.TxService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -306,6 +325,59 @@ laddr = "{{ .GRPC.Privileged.ListenAddress }}"
 # Disabled by default.
 enabled = {{ .GRPC.Privileged.PruningService.Enabled }}
 
+#
+# Configuration for the gRPC consensus control service, which is considered a
+# privileged service. It allows dialing peers and seeds, flushing the mempool,
+# dumping the consensus state, and changing whether empty blocks are created
+# and the log level of the node at runtime. These changes are not persisted
+# and are lost when the node restarts.
+#
+[grpc.privileged.consensus_control_service]
+
+# Disabled by default.
+enabled = {{ .GRPC.Privileged.ConsensusControlService.Enabled }}
+
+#
+# Configuration for the gRPC data companion service, which is considered a
+# privileged service. It pushes the committed blocks and their results to a
+# data companion, and persists the height of the last block it acknowledged so
+# that it can resume from there. The data companion can have the retain heights
+# advanced as it acknowledges blocks, if data companion pruning is enabled in
+# the [storage.pruning.data_companion] section.
+#
+[grpc.privileged.data_companion_service]
+
+# Disabled by default.
+enabled = {{ .GRPC.Privileged.DataCompanionService.Enabled }}
+
+#
+# Configuration for the gRPC reindex service, which is considered a privileged
+# service. It rebuilds the transaction and block indexes of a range of heights
+# in the background, from the blocks and FinalizeBlock responses stored by the
+# node, e.g. after changing the indexer. The new blocks keep being indexed
+# meanwhile.
+#
+[grpc.privileged.reindex_service]
+
+# Disabled by default.
+enabled = {{ .GRPC.Privileged.ReindexService.Enabled }}
+
+# The maximum number of heights reindexed per second, so that reindexing does
+# not slow down the node. 0 means no limit.
+max_heights_per_second = {{ .GRPC.Privileged.ReindexService.MaxHeightsPerSecond }}
+
+#
+# Configuration for the gRPC peer filter service, which is considered a
+# privileged service. It changes the IP ranges and node IDs which peers are
+# accepted from, i.e. the allowed_peer_cidrs, denied_peer_cidrs,
+# allowed_peer_ids and denied_peer_ids of the [p2p] section, without restarting
+# the node.
+#
+[grpc.privileged.peer_filter_service]
+
+# Disabled by default.
+enabled = {{ .GRPC.Privileged.PeerFilterService.Enabled }}
+
 #######################################################
 ###           P2P Configuration Options             ###
 #######################################################
@@ -319,6 +391,12 @@ laddr = "{{ .P2P.ListenAddress }}"
 # address. IP and port are required. Example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Set true to also accept and dial QUIC connections, over UDP on the port of
+# laddr. Each channel gets its own QUIC stream, so that a busy channel does not
+# delay the others. Peers which do not accept QUIC connections are dialed over
+# TCP, and TCP connections are still accepted. Requires an ed25519 node key.
+quic = {{ .P2P.QUIC }}
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
@@ -332,6 +410,17 @@ addr_book_file = "{{ js .P2P.AddrBook }}"
 # Set false for private or local networks
 addr_book_strict = {{ .P2P.AddrBookStrict }}
 
+# Path to the scores of the peers. Reactors lower the score of the peers
+# which misbehave, e.g. by sending invalid messages, and raise the score of
+# those which contribute. Peers with a higher score are dialed first. Scores
+# decay toward 0 over time.
+peer_scores_file = "{{ js .P2P.PeerScores }}"
+
+# How long the peers whose score drops to the minimum are banned for, during
+# which the node neither dials nor accepts them. Persistent and unconditional
+# peers are never banned. 0 disables bans.
+peer_ban_duration = "{{ .P2P.PeerBanDuration }}"
+
 # Maximum number of inbound peers
 max_num_inbound_peers = {{ .P2P.MaxNumInboundPeers }}
 
@@ -356,6 +445,14 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Comma separated list of channel ID:rate pairs, limiting the rate at which the
+# packets of some channels can be sent, in bytes/second, within send_rate. IDs
+# are decimal, or hexadecimal with a 0x prefix. A channel above its rate only
+# sends when the other channels have nothing to send, e.g. "0x30:512000" keeps
+# mempool gossip from delaying consensus messages, while letting it use the
+# bandwidth consensus leaves unused.
+channel_send_rates = "{{ .P2P.ChannelSendRates }}"
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -371,6 +468,22 @@ private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
+# Comma separated lists of IPs and CIDR ranges, e.g. "10.0.0.0/8", of the peers
+# accepted and rejected, whether they connect to this node or are dialed by it.
+# If allowed_peer_cidrs is empty, peers with any IP but the denied ones are
+# accepted.
+allowed_peer_cidrs = "{{ .P2P.AllowedPeerCIDRs }}"
+denied_peer_cidrs = "{{ .P2P.DeniedPeerCIDRs }}"
+
+# Comma separated lists of the IDs of the peers accepted and rejected, e.g. to
+# only let its sentries connect to a validator. If allowed_peer_ids is empty,
+# all peers but the denied ones are accepted.
+#
+# The four lists can be changed while the node is running, with the peer
+# filter service of the privileged gRPC server.
+allowed_peer_ids = "{{ .P2P.AllowedPeerIDs }}"
+denied_peer_ids = "{{ .P2P.DeniedPeerIDs }}"
+
 # Peer connection configuration.
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
@@ -380,6 +493,16 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 #######################################################
 [mempool]
 
+# The type of mempool for this node to use.
+#
+# Possible types:
+# - "flood" : concurrent linked list mempool with flooding gossip protocol
+# (default). Transactions are reaped in the order in which they were received.
+# - "priority" : transactions are reaped in descending order of the priority
+# returned by the application in CheckTx, and lower priority transactions are
+# evicted to make room for higher priority ones when the mempool is full.
+type = "{{ .Mempool.Type }}"
+
 # recheck (default: true) defines whether CometBFT should recheck the
 # validity for all remaining transaction in the mempool after a block.
 # Since a block affects the application state, some transactions in the
@@ -527,6 +650,19 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# If discard_abci_responses is true, the number of latest heights whose ABCI
+# responses are still kept, so that /block_results queries and the gRPC block
+# results service can be served for these heights. The older responses are
+# deleted by the pruner, every [storage.pruning] interval. 0 keeps none of them.
+keep_recent_abci_responses = {{ .Storage.KeepRecentABCIResponses }}
+
+# The compression of the block parts and of the FinalizeBlock responses of every
+# height saved from now on: "none" or "snappy". Compression saves disk space on
+# chains with large transactions or verbose events, at the cost of some CPU.
+# The data saved with another compression, or by older versions, can still be
+# read, so this can be changed at any time.
+compression = "{{ .Storage.Compression }}"
+
 [storage.pruning]
 
 # The time period between automated background pruning operations.
@@ -557,6 +693,31 @@ initial_block_retain_height = {{ .Storage.Pruning.DataCompanion.InitialBlockReta
 # already set a block results retain height, this is ignored.
 initial_block_results_retain_height = {{ .Storage.Pruning.DataCompanion.InitialBlockResultsRetainHeight }}
 
+#
+# Storage retention policies, evaluated by the pruner alongside the retain
+# heights set by the application and the data companion. The pruner always
+# picks the most conservative retain height, so that these policies never prune
+# data that the application or the data companion retains, and may hold back
+# the pruning they request.
+#
+# Blocks are pruned according to these policies even if the application has not
+# set any retain height.
+#
+[storage.pruning.retention]
+
+# Keep the blocks committed within this period, e.g. "720h" to keep 30 days of
+# blocks. 0 disables this policy.
+block_retain_age = "{{ .Storage.Pruning.Retention.BlockRetainAge }}"
+
+# Keep as many of the latest blocks as fit in this number of bytes, e.g.
+# 200000000000 to keep the block store under 200 GB. 0 disables this policy.
+block_store_max_bytes = {{ .Storage.Pruning.Retention.BlockStoreMaxBytes }}
+
+# Keep the ABCI results of this number of latest blocks. ABCI results are pruned
+# according to this policy even if the data companion is disabled. 0 disables
+# this policy.
+abci_results_retain_blocks = {{ .Storage.Pruning.Retention.ABCIResultsRetainBlocks }}
+
 
 # Hash of the Genesis file (as hex string), passed to CometBFT via the command line. 
 # If this hash mismatches the hash that CometBFT computes on the genesis file,
@@ -578,13 +739,32 @@ genesis_hash = "{{ .Storage.GenesisHash }}"
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite database,
+#      stored in the data directory, which requires no database server.
+#      Only supported by the binaries built with cgo (CGO_ENABLED=1).
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# Restricts the event attributes indexed by the "kv", "psql" and "sqlite"
+# indexers, among those the application flags for indexing, to save disk space.
+# Each pattern is either an event type, e.g. "transfer", selecting all its
+# attributes, or a composite key, e.g. "transfer.sender". "*" matches any
+# sequence of characters, e.g. "transfer.*" or "*.sender".
+#
+# If allow_events is not empty, only the matching attributes are indexed. The
+# attributes matching deny_events are never indexed. Changes only apply to the
+# blocks indexed afterwards.
+#
+# Example:
+#   allow_events = ["transfer", "message.action"]
+#   deny_events = ["*.amount"]
+allow_events = [{{ range .TxIndex.AllowEvents }}{{ printf "%q, " . }}{{end}}]
+deny_events = [{{ range .TxIndex.DenyEvents }}{{ printf "%q, " . }}{{end}}]
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
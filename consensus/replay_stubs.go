@@ -47,6 +47,13 @@ func (emptyMempool) EnableTxsAvailable()                    {}
 func (emptyMempool) SetTxRemovedCallback(func(types.TxKey)) {}
 func (emptyMempool) TxsBytes() int64                        { return 0 }
 func (emptyMempool) InMempool(types.TxKey) bool             { return false }
+func (emptyMempool) GetTxByKey(types.TxKey) (types.Tx, bool) {
+	return nil, false
+}
+
+func (emptyMempool) SubscribeTxEvents(int) (<-chan mempl.TxEvent, func()) {
+	return nil, func() {}
+}
 
 func (emptyMempool) TxsFront() *clist.CElement    { return nil }
 func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
@@ -33,6 +33,7 @@ import (
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -1232,8 +1233,9 @@ func (bs *mockBlockStore) PruneBlocks(height int64, _ sm.State) (uint64, int64,
 	return pruned, evidencePoint, nil
 }
 
-func (bs *mockBlockStore) DeleteLatestBlock() error { return nil }
-func (bs *mockBlockStore) Close() error             { return nil }
+func (bs *mockBlockStore) DeleteLatestBlock() error      { return nil }
+func (bs *mockBlockStore) DeleteBlocksAbove(int64) error { return nil }
+func (bs *mockBlockStore) Close() error                  { return nil }
 
 //---------------------------------------
 // Test handshake/init chain
@@ -1284,3 +1286,71 @@ func TestHandshakeUpdatesValidators(t *testing.T) {
 	assert.NotEqual(t, oldValAddr, newValAddr)
 	assert.Equal(t, newValAddr, expectValAddr)
 }
+
+// TestHandshakeAfterImportBlocks checks that a new node replays the blocks
+// imported from an archive, whose last one is ahead of the restored state.
+func TestHandshakeAfterImportBlocks(t *testing.T) {
+	const numBlocks = 5
+	config := ResetConfig("handshake_import_test_")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+	genDoc, err := sm.MakeGenesisDocFromFile(config.GenesisFile())
+	require.NoError(t, err)
+	privVal := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+
+	// Commit blocks on a node, with a kvstore application.
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	state.Version.Consensus.App = kvstore.AppVersion
+	_, err = proxyApp.Consensus().InitChain(context.Background(), &abci.RequestInitChain{
+		Validators: types.TM2PB.ValidatorUpdates(state.Validators),
+	})
+	require.NoError(t, err)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, stateStore.Save(state))
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		txs := types.Txs{kvstore.NewTxFromID(int(height))}
+		block := state.MakeBlock(height, txs, lastCommit, nil, state.Validators.Proposer.Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit, err = test.MakeCommit(blockID, height, 0, state.Validators,
+			[]types.PrivValidator{privVal}, genDoc.ChainID, block.Time.Add(time.Second))
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, parts, lastCommit)
+		state = applyBlock(t, stateStore, emptyMempool{}, sm.EmptyEvidencePool{}, state, block, proxyApp, blockStore)
+	}
+
+	var archive bytes.Buffer
+	require.NoError(t, store.ExportBlocks(&archive, blockStore, stateStore, genDoc.ChainID, 1, numBlocks,
+		store.DefaultArchiveChunkSize))
+
+	// Import them on a new node, which replays them to its application.
+	newStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	newBlockStore := store.NewBlockStore(dbm.NewMemDB())
+	genState, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	_, _, err = store.ImportBlocks(&archive, newBlockStore, newStateStore, genState)
+	require.NoError(t, err)
+	newState, err := newStateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, numBlocks-1, newState.LastBlockHeight)
+
+	newProxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()),
+		proxy.NopMetrics())
+	require.NoError(t, newProxyApp.Start())
+	t.Cleanup(func() { _ = newProxyApp.Stop() })
+	handshaker := NewHandshaker(newStateStore, newState, newBlockStore, genDoc)
+	require.NoError(t, handshaker.Handshake(context.Background(), newProxyApp))
+
+	newState, err = newStateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, numBlocks, newState.LastBlockHeight)
+	assert.Equal(t, state.AppHash, newState.AppHash)
+	assert.Equal(t, state.Validators.Hash(), newState.Validators.Hash())
+}
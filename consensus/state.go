@@ -263,6 +263,30 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return cmtjson.Marshal(cs.RoundState.RoundStateSimple())
 }
 
+// CreateEmptyBlocks returns whether empty blocks are created.
+func (cs *State) CreateEmptyBlocks() bool {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.config.CreateEmptyBlocks
+}
+
+// SetCreateEmptyBlocks changes whether empty blocks are created, overriding
+// the create_empty_blocks configuration parameter.
+//
+// Not creating empty blocks requires the TxsAvailable channel of the mempool
+// to be enabled, otherwise the node waits for transactions forever.
+func (cs *State) SetCreateEmptyBlocks(createEmptyBlocks bool) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	cs.config.CreateEmptyBlocks = createEmptyBlocks
+
+	// If we are waiting for txs in round 0 and should not anymore, enter the
+	// propose step right away instead of waiting for the next round.
+	if !cs.config.WaitForTxs() && cs.Round == 0 && cs.Step == cstypes.RoundStepNewRound {
+		cs.scheduleTimeout(0, cs.Height, 0, cstypes.RoundStepNewRound)
+	}
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -74,7 +74,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		evR.Switch.StopPeerForError(e.Src, err)
+		evR.Switch.ReportPeerBehaviour(e.Src, p2p.BadMessage(err))
 		return
 	}
 
@@ -84,7 +84,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
-			evR.Switch.StopPeerForError(e.Src, err)
+			evR.Switch.ReportPeerBehaviour(e.Src, p2p.BadMessage(err))
 			return
 		case nil:
 		default:
@@ -86,7 +86,10 @@ func NewFromConfig(cfg *config.Config) (*Inspector, error) {
 	if err != nil {
 		return nil, err
 	}
-	ss := state.NewStore(sDB, state.StoreOptions{})
+	ss := state.NewStore(sDB, state.StoreOptions{
+		DiscardABCIResponses:    cfg.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: cfg.Storage.KeepRecentABCIResponses,
+	})
 	return New(cfg.RPC, bs, ss, txidx, blkidx), nil
 }
 
@@ -147,7 +147,7 @@ func TestTxSearch(t *testing.T) {
 	require.NoError(t, err)
 
 	page := 1
-	resultTxSearch, err := cli.TxSearch(context.Background(), testQuery, false, &page, &page, "")
+	resultTxSearch, err := cli.TxSearch(context.Background(), testQuery, false, &page, &page, "", "")
 	require.NoError(t, err)
 	require.Len(t, resultTxSearch.Txs, 1)
 	require.Equal(t, types.Tx(testTx), resultTxSearch.Txs[0].Tx)
@@ -560,7 +560,7 @@ func TestBlockSearch(t *testing.T) {
 	testPage := 1
 	testPerPage := 100
 	testOrderBy := "desc"
-	res, err := cli.BlockSearch(context.Background(), testQuery, &testPage, &testPerPage, testOrderBy)
+	res, err := cli.BlockSearch(context.Background(), testQuery, &testPage, &testPerPage, testOrderBy, "")
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, testBlockHash, []byte(res.Blocks[0].BlockID.Hash))
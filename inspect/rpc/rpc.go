@@ -11,9 +11,13 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/txindex"
+	txidxkv "github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/store"
 )
 
 // Server defines parameters for running an Inspector rpc server.
@@ -46,8 +50,31 @@ func Routes(cfg config.RPCConfig, s state.Store, bs state.BlockStore, txidx txin
 		"header_by_hash":   server.NewRPCFunc(env.HeaderByHash, "hash"),
 		"validators":       server.NewRPCFunc(env.Validators, "height,page,per_page"),
 		"tx":               server.NewRPCFunc(env.Tx, "hash,prove"),
-		"tx_search":        server.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
-		"block_search":     server.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
+		"tx_search":        server.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by,cursor"),
+		"block_search":     server.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by,cursor"),
+		"verify_data":      server.NewRPCFunc(makeVerifyDataFunc(s, bs, txidx, blkidx), "start_height,end_height"),
+	}
+}
+
+type rpcVerifyDataFunc func(ctx *rpctypes.Context, startHeight, endHeight int64) (*store.DataReport, error)
+
+// makeVerifyDataFunc returns a function checking the consistency of the
+// stores and of the indexes. Only the kv indexers are checked, as the other
+// ones cannot be looked up by height and by hash.
+func makeVerifyDataFunc(
+	s state.Store,
+	bs state.BlockStore,
+	txidx txindex.TxIndexer,
+	blkidx indexer.BlockIndexer,
+) rpcVerifyDataFunc {
+	if _, ok := txidx.(*txidxkv.TxIndex); !ok {
+		txidx = nil
+	}
+	if _, ok := blkidx.(*blockidxkv.BlockerIndexer); !ok {
+		blkidx = nil
+	}
+	return func(ctx *rpctypes.Context, startHeight, endHeight int64) (*store.DataReport, error) {
+		return store.VerifyData(ctx.Context(), bs, s, txidx, blkidx, startHeight, endHeight)
 	}
 }
 
@@ -117,3 +117,30 @@ func CheckBounds(ranges indexer.QueryRange, v interface{}) (bool, error) {
 	}
 	return true, nil
 }
+
+// KeyByValue re-keys the given matches, keyed by value and event sequence, by
+// their value only. This merges the matches of a same transaction or block
+// across events.
+func KeyByValue(matches map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(matches))
+	for _, v := range matches {
+		out[string(v)] = v
+	}
+	return out
+}
+
+// Intersect removes from matches the keys that are not in other.
+func Intersect(matches, other map[string][]byte) {
+	for k := range matches {
+		if _, ok := other[k]; !ok {
+			delete(matches, k)
+		}
+	}
+}
+
+// Subtract removes from matches the keys that are in other.
+func Subtract(matches, other map[string][]byte) {
+	for k := range other {
+		delete(matches, k)
+	}
+}
@@ -0,0 +1,91 @@
+// Package compress implements the optional compression of the data persisted
+// by a node.
+//
+// Compressed data starts with a zero byte, which never starts an encoded
+// protobuf message since 0 is not a valid field number, followed by the codec
+// it was compressed with. Compressed data can thus be stored alongside the
+// uncompressed data written by older versions, and be read back whatever the
+// codec currently configured.
+package compress
+
+import (
+	"fmt"
+
+	"github.com/golang/snappy"
+)
+
+// Codec is a compression algorithm. Its value is stored in the header of the
+// compressed data, so the value of an existing codec must never change.
+type Codec byte
+
+const (
+	// None leaves the data uncompressed.
+	None Codec = 0
+	// Snappy compresses the data with snappy.
+	Snappy Codec = 1
+)
+
+// marker is the first byte of compressed data.
+const marker byte = 0
+
+// headerSize is the size of the header of compressed data: the marker and
+// the codec.
+const headerSize = 2
+
+// CodecFromName returns the codec with the given name, as found in the
+// configuration. An empty name stands for None.
+func CodecFromName(name string) (Codec, error) {
+	switch name {
+	case "", "none":
+		return None, nil
+	case "snappy":
+		return Snappy, nil
+	default:
+		return None, fmt.Errorf("unknown compression codec %q", name)
+	}
+}
+
+func (c Codec) String() string {
+	switch c {
+	case None:
+		return "none"
+	case Snappy:
+		return "snappy"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(c))
+	}
+}
+
+// Compress compresses bz with the codec, or returns it unchanged with None.
+func (c Codec) Compress(bz []byte) []byte {
+	switch c {
+	case Snappy:
+		out := make([]byte, headerSize+snappy.MaxEncodedLen(len(bz)))
+		out[0], out[1] = marker, byte(c)
+		n := len(snappy.Encode(out[headerSize:], bz))
+		return out[:headerSize+n]
+	default:
+		return bz
+	}
+}
+
+// Decompress returns the decompressed data of bz, or bz itself if it is not
+// compressed.
+func Decompress(bz []byte) ([]byte, error) {
+	if len(bz) == 0 || bz[0] != marker {
+		return bz, nil
+	}
+	if len(bz) < headerSize {
+		return nil, fmt.Errorf("compressed data too short: %d bytes", len(bz))
+	}
+	switch codec := Codec(bz[1]); codec {
+	case Snappy:
+		out, err := snappy.Decode(nil, bz[headerSize:])
+		if err != nil {
+			return nil, fmt.Errorf("decompressing %s data: %w", codec, err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
+}
@@ -0,0 +1,54 @@
+package compress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestCompressDecompress(t *testing.T) {
+	part := &cmtproto.Part{Bytes: bytes.Repeat([]byte("block part"), 100)}
+	bz, err := part.Marshal()
+	require.NoError(t, err)
+
+	for _, codec := range []Codec{None, Snappy} {
+		compressed := codec.Compress(bz)
+		if codec == None {
+			assert.Equal(t, bz, compressed)
+		} else {
+			assert.Less(t, len(compressed), len(bz))
+		}
+		decompressed, err := Decompress(compressed)
+		require.NoError(t, err, codec)
+		assert.Equal(t, bz, decompressed, codec)
+	}
+
+	decompressed, err := Decompress(Snappy.Compress(nil))
+	require.NoError(t, err)
+	assert.Empty(t, decompressed)
+}
+
+func TestDecompressInvalid(t *testing.T) {
+	for name, bz := range map[string][]byte{
+		"no codec":      {marker},
+		"unknown codec": {marker, 42, 1, 2, 3},
+		"corrupted":     {marker, byte(Snappy), 0xff, 0xff},
+	} {
+		_, err := Decompress(bz)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestCodecFromName(t *testing.T) {
+	for name, codec := range map[string]Codec{"": None, "none": None, "snappy": Snappy} {
+		c, err := CodecFromName(name)
+		require.NoError(t, err)
+		assert.Equal(t, codec, c)
+	}
+	_, err := CodecFromName("zip")
+	require.Error(t, err)
+}
@@ -0,0 +1,104 @@
+package log
+
+import (
+	"sync/atomic"
+)
+
+// LevelSetter is implemented by loggers whose log level can be changed while
+// they are in use.
+type LevelSetter interface {
+	// SetLevel changes the log level of the logger, and of all the loggers
+	// derived from it.
+	SetLevel(level string) error
+}
+
+// NewDynamicLevelLogger returns a logger whose log level can be changed at
+// runtime. The returned logger, and all the loggers derived from it using
+// With, implement LevelSetter.
+//
+// newLogger is called with the initial level, and with every level passed to
+// SetLevel afterwards, and must return the logger to use for that level, e.g.
+// a logger wrapped with NewFilter. If it returns an error, the level is left
+// unchanged.
+func NewDynamicLevelLogger(level string, newLogger func(level string) (Logger, error)) (Logger, error) {
+	next, err := newLogger(level)
+	if err != nil {
+		return nil, err
+	}
+	root := &dynamicLevelRoot{newLogger: newLogger}
+	root.current.Store(&dynamicLevelNext{next: next})
+	return &dynamicLevelLogger{root: root}, nil
+}
+
+type dynamicLevelRoot struct {
+	newLogger func(level string) (Logger, error)
+	current   atomic.Pointer[dynamicLevelNext]
+}
+
+// dynamicLevelNext is a logger built for a given level. Loggers derived from
+// the root cache their own dynamicLevelNext, which is rebuilt whenever the
+// level of the root changes.
+type dynamicLevelNext struct {
+	generation uint64
+	next       Logger
+}
+
+type dynamicLevelLogger struct {
+	root *dynamicLevelRoot
+	// The arguments of the successive calls to With that led to this logger.
+	withKeyvals [][]interface{}
+	cached      atomic.Pointer[dynamicLevelNext]
+}
+
+var _ LevelSetter = (*dynamicLevelLogger)(nil)
+
+func (l *dynamicLevelLogger) Info(msg string, keyvals ...interface{}) {
+	l.next().Info(msg, keyvals...)
+}
+
+func (l *dynamicLevelLogger) Debug(msg string, keyvals ...interface{}) {
+	l.next().Debug(msg, keyvals...)
+}
+
+func (l *dynamicLevelLogger) Error(msg string, keyvals ...interface{}) {
+	l.next().Error(msg, keyvals...)
+}
+
+func (l *dynamicLevelLogger) With(keyvals ...interface{}) Logger {
+	withKeyvals := make([][]interface{}, len(l.withKeyvals), len(l.withKeyvals)+1)
+	copy(withKeyvals, l.withKeyvals)
+	return &dynamicLevelLogger{
+		root:        l.root,
+		withKeyvals: append(withKeyvals, keyvals),
+	}
+}
+
+// SetLevel implements LevelSetter.
+func (l *dynamicLevelLogger) SetLevel(level string) error {
+	next, err := l.root.newLogger(level)
+	if err != nil {
+		return err
+	}
+	for {
+		current := l.root.current.Load()
+		if l.root.current.CompareAndSwap(current, &dynamicLevelNext{generation: current.generation + 1, next: next}) {
+			return nil
+		}
+	}
+}
+
+func (l *dynamicLevelLogger) next() Logger {
+	current := l.root.current.Load()
+	if len(l.withKeyvals) == 0 {
+		return current.next
+	}
+	if cached := l.cached.Load(); cached != nil && cached.generation == current.generation {
+		return cached.next
+	}
+	next := current.next
+	for _, keyvals := range l.withKeyvals {
+		next = next.With(keyvals...)
+	}
+	l.cached.Store(&dynamicLevelNext{generation: current.generation, next: next})
+	return next
+}
@@ -0,0 +1,52 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+func TestDynamicLevelLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	newLogger := func(level string) (log.Logger, error) {
+		option, err := log.AllowLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		return log.NewFilter(log.NewTMJSONLoggerNoTS(&buf), option), nil
+	}
+	logger, err := log.NewDynamicLevelLogger("error", newLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A logger derived before the level changes must follow the changes.
+	derived := logger.With("module", "test")
+
+	derived.Info("foo", "bar", "baz")
+	if want, have := ``, strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+
+	if err := derived.(log.LevelSetter).SetLevel("info"); err != nil {
+		t.Fatal(err)
+	}
+	derived.Info("foo", "bar", "baz")
+	want := `{"_msg":"foo","bar":"baz","level":"info","module":"test"}`
+	if have := strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+
+	// An invalid level leaves the current level unchanged.
+	buf.Reset()
+	if err := logger.(log.LevelSetter).SetLevel("invalid"); err == nil {
+		t.Error("expected an error for an invalid level")
+	}
+	logger.Info("foo", "bar", "baz")
+	want = `{"_msg":"foo","bar":"baz","level":"info"}`
+	if have := strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+}
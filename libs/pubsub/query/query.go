@@ -2,6 +2,7 @@
 // subscriptions in CometBFT.
 //
 //	abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//	transfer.sender='a' OR NOT (transfer.recipient='a' AND transfer.amount > 5)
 //
 // Query expressions can handle attribute values encoding numbers, strings,
 // dates, and timestamps.  The complete query grammar is described in the
@@ -24,8 +25,8 @@ var All *Query
 
 // A Query is the compiled form of a query.
 type Query struct {
-	ast   syntax.Query
-	conds []condition
+	ast  syntax.Expr
+	expr matcher
 }
 
 // New parses and compiles the query expression into an executable query.
@@ -51,16 +52,55 @@ func MustCompile(query string) *Query {
 }
 
 // Compile compiles the given query AST so it can be used to match events.
-func Compile(ast syntax.Query) (*Query, error) {
-	conds := make([]condition, len(ast))
-	for i, q := range ast {
-		cond, err := compileCondition(q)
+func Compile(ast syntax.Expr) (*Query, error) {
+	expr, err := compileExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &Query{ast: ast, expr: expr}, nil
+}
+
+func compileExpr(ast syntax.Expr) (matcher, error) {
+	switch ast := ast.(type) {
+	case syntax.Condition:
+		cond, err := compileCondition(ast)
+		if err != nil {
+			return nil, fmt.Errorf("compile %s: %w", ast, err)
+		}
+		return cond, nil
+	case syntax.And:
+		subs, err := compileExprs(ast)
+		if err != nil {
+			return nil, err
+		}
+		return and(subs), nil
+	case syntax.Or:
+		subs, err := compileExprs(ast)
+		if err != nil {
+			return nil, err
+		}
+		return or(subs), nil
+	case syntax.Not:
+		sub, err := compileExpr(ast.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return not{sub}, nil
+	default:
+		return nil, fmt.Errorf("compile %s: unknown expression type %T", ast, ast)
+	}
+}
+
+func compileExprs(asts []syntax.Expr) ([]matcher, error) {
+	out := make([]matcher, len(asts))
+	for i, ast := range asts {
+		m, err := compileExpr(ast)
 		if err != nil {
-			return nil, fmt.Errorf("compile %s: %w", q, err)
+			return nil, err
 		}
-		conds[i] = cond
+		out[i] = m
 	}
-	return &Query{ast: ast, conds: conds}, nil
+	return out, nil
 }
 
 func ExpandEvents(flattenedEvents map[string][]string) []types.Event {
@@ -104,21 +144,54 @@ func (q *Query) String() string {
 }
 
 // Syntax returns the syntax tree representation of q.
-func (q *Query) Syntax() syntax.Query {
+func (q *Query) Syntax() syntax.Expr {
 	if q == nil {
 		return nil
 	}
 	return q.ast
 }
 
-// matchesEvents reports whether all the conditions match the given events.
+// matchesEvents reports whether the query expression matches the given
+// events.
 func (q *Query) matchesEvents(events []types.Event) bool {
-	for _, cond := range q.conds {
-		if !cond.matchesAny(events) {
+	return len(events) != 0 && q.expr.matchesAny(events)
+}
+
+// A matcher is a compiled query expression.
+type matcher interface {
+	// matchesAny reports whether the expression holds for the given events.
+	matchesAny(events []types.Event) bool
+}
+
+// and is a compiled conjunction of expressions.
+type and []matcher
+
+func (a and) matchesAny(events []types.Event) bool {
+	for _, m := range a {
+		if !m.matchesAny(events) {
 			return false
 		}
 	}
-	return len(events) != 0
+	return true
+}
+
+// or is a compiled disjunction of expressions.
+type or []matcher
+
+func (o or) matchesAny(events []types.Event) bool {
+	for _, m := range o {
+		if m.matchesAny(events) {
+			return true
+		}
+	}
+	return false
+}
+
+// not is a compiled negation of an expression.
+type not struct{ matcher }
+
+func (n not) matchesAny(events []types.Event) bool {
+	return !n.matcher.matchesAny(events)
 }
 
 // A condition is a compiled match condition.  A condition matches an event if
@@ -190,6 +263,30 @@ func compileCondition(cond syntax.Condition) (condition, error) {
 		return out, nil
 	}
 
+	// An IN condition matches if one of its arguments is equal to the value.
+	if cond.Op == syntax.TIn {
+		if len(cond.Args) == 0 {
+			return condition{}, fmt.Errorf("missing arguments for %v", cond.Op)
+		}
+		matches := make([]func(string) bool, len(cond.Args))
+		for i, arg := range cond.Args {
+			eq, err := compileCondition(syntax.Condition{Tag: cond.Tag, Op: syntax.TEq, Arg: arg})
+			if err != nil {
+				return condition{}, err
+			}
+			matches[i] = eq.match
+		}
+		out.match = func(s string) bool {
+			for _, match := range matches {
+				if match(s) {
+					return true
+				}
+			}
+			return false
+		}
+		return out, nil
+	}
+
 	// All the other operators require an argument.
 	if cond.Arg == nil {
 		return condition{}, fmt.Errorf("missing argument for %v", cond.Op)
@@ -202,6 +299,13 @@ func compileCondition(cond syntax.Condition) (condition, error) {
 	switch argType {
 	case syntax.TString:
 		argValue = cond.Arg.Value()
+		if cond.Op == syntax.TMatches {
+			re, err := regexp.Compile(cond.Arg.Value())
+			if err != nil {
+				return condition{}, err
+			}
+			argValue = re
+		}
 	case syntax.TNumber:
 		argValue = cond.Arg.Number()
 	case syntax.TTime, syntax.TDate:
@@ -251,6 +355,18 @@ var opTypeMap = map[syntax.Token]map[syntax.Token]func(interface{}) func(string)
 			}
 		},
 	},
+	syntax.TStartsWith: {
+		syntax.TString: func(v interface{}) func(string) bool {
+			return func(s string) bool {
+				return strings.HasPrefix(s, v.(string))
+			}
+		},
+	},
+	syntax.TMatches: {
+		syntax.TString: func(v interface{}) func(string) bool {
+			return v.(*regexp.Regexp).MatchString
+		},
+	},
 	syntax.TEq: {
 		syntax.TString: func(v interface{}) func(string) bool {
 			return func(s string) bool { return s == v.(string) }
@@ -378,6 +378,43 @@ func TestCompiledMatches(t *testing.T) {
 			apiEvents, false},
 		{`tm.event = 'Tx' AND rewards.withdraw.source = 'W'`,
 			apiEvents, false},
+
+		// Disjunctions, negations and grouping.
+		{`transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrD'`,
+			apiEvents, true},
+		{`transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrZ'`,
+			apiEvents, false},
+		{`NOT transfer.sender = 'AddrZ'`,
+			apiEvents, true},
+		{`NOT transfer.sender = 'AddrC'`,
+			apiEvents, false},
+		{`tm.event = 'Tx' AND NOT (transfer.sender = 'AddrZ' OR transfer.recipient = 'AddrZ')`,
+			apiEvents, true},
+		{`tm.event = 'Block' OR transfer.sender = 'AddrC' AND NOT slash EXISTS`,
+			apiEvents, true},
+		{`(tm.event = 'Block' OR transfer.sender = 'AddrC') AND slash EXISTS`,
+			apiEvents, false},
+		{`NOT NOT tm.event = 'Tx'`,
+			apiEvents, true},
+
+		// Sets of values, prefixes and regular expressions.
+		{`transfer.sender IN ('AddrA', 'AddrC')`,
+			apiEvents, true},
+		{`transfer.sender IN ('AddrA', 'AddrB')`,
+			apiEvents, false},
+		{`tm.height IN (4, 5.0)`,
+			apiEvents, true},
+		{`tx.date IN (DATE 2017-01-01, DATE 2018-01-01)`,
+			newTestEvents(`tx|date=` + txDate),
+			true},
+		{`rewards.withdraw.address STARTS WITH 'Addr'`,
+			apiEvents, true},
+		{`rewards.withdraw.address STARTS WITH 'ddr'`,
+			apiEvents, false},
+		{`rewards.withdraw.source MATCHES '^Src[XY]$'`,
+			apiEvents, true},
+		{`rewards.withdraw.source MATCHES 'rc[A-W]'`,
+			apiEvents, false},
 	}
 
 	// NOTE: The original implementation allowed arbitrary prefix matches on
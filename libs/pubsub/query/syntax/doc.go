@@ -5,14 +5,33 @@
 //
 // The grammar of the query language is defined by the following EBNF:
 //
-//	query      = conditions EOF
-//	conditions = condition {"AND" condition}
-//	condition  = tag comparison
-//	comparison = equal / order / contains / "EXISTS"
-//	equal      = "=" (date / number / time / value)
-//	order      = cmp (date / number / time)
-//	contains   = "CONTAINS" value
-//	cmp        = "<" / "<=" / ">" / ">="
+//	query       = disjunction EOF
+//	disjunction = conjunction {"OR" conjunction}
+//	conjunction = factor {"AND" factor}
+//	factor      = "NOT" factor / "(" disjunction ")" / condition
+//	condition   = tag comparison
+//	comparison  = equal / order / contains / prefix / match / in / "EXISTS"
+//	equal       = "=" (date / number / time / value)
+//	order       = cmp (date / number / time)
+//	contains    = "CONTAINS" value
+//	prefix      = "STARTS WITH" value
+//	match       = "MATCHES" value
+//	in          = "IN" "(" (date / number / time / value) {"," (date / number / time / value)} ")"
+//	cmp         = "<" / "<=" / ">" / ">="
+//
+// NOT binds more tightly than AND, which binds more tightly than OR, so that
+//
+//	a = 1 OR NOT b = 2 AND c = 3
+//
+// is equivalent to
+//
+//	a = 1 OR ((NOT b = 2) AND c = 3)
+//
+// The value of a MATCHES condition is a regular expression in the RE2 syntax
+// accepted by the regexp package, of at most MaxRegexpLength bytes. It matches
+// the attribute values containing a match of the expression: use ^ and $ to
+// match whole values. An IN condition holds if one of its values is equal to
+// the attribute value, as with "=".
 //
 // The lexical terms are defined here using RE2 regular expression notation:
 //
@@ -4,41 +4,135 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"regexp"
 	"strings"
 	"time"
 )
 
 // Parse parses the specified query string. It is shorthand for constructing a
 // parser for s and calling its Parse method.
-func Parse(s string) (Query, error) {
+func Parse(s string) (Expr, error) {
 	return NewParser(strings.NewReader(s)).Parse()
 }
 
-// Query is the root of the parse tree for a query.  A query is the conjunction
-// of one or more conditions.
-type Query []Condition
+// Expr is a node of the parse tree for a query. The root of the tree is the
+// whole query. An expression is one of Condition, And, Or or Not.
+type Expr interface {
+	String() string
 
-func (q Query) String() string {
-	ss := make([]string, len(q))
-	for i, cond := range q {
-		ss[i] = cond.String()
+	isExpr()
+}
+
+// And is the conjunction of two or more expressions.
+type And []Expr
+
+func (And) isExpr() {}
+
+func (a And) String() string {
+	ss := make([]string, len(a))
+	for i, e := range a {
+		// AND binds more tightly than OR.
+		ss[i] = group(e, isOr)
 	}
 	return strings.Join(ss, " AND ")
 }
 
+// Or is the disjunction of two or more expressions.
+type Or []Expr
+
+func (Or) isExpr() {}
+
+func (o Or) String() string {
+	ss := make([]string, len(o))
+	for i, e := range o {
+		ss[i] = e.String()
+	}
+	return strings.Join(ss, " OR ")
+}
+
+// Not is the negation of an expression.
+type Not struct {
+	Expr Expr
+}
+
+func (Not) isExpr() {}
+
+func (n Not) String() string {
+	// NOT binds more tightly than AND and OR.
+	return "NOT " + group(n.Expr, func(e Expr) bool { return isAnd(e) || isOr(e) })
+}
+
+func isAnd(e Expr) bool {
+	_, ok := e.(And)
+	return ok
+}
+
+func isOr(e Expr) bool {
+	_, ok := e.(Or)
+	return ok
+}
+
+// group returns the string representation of e, enclosed in parentheses if
+// needsParens reports true for e.
+func group(e Expr, needsParens func(Expr) bool) string {
+	if needsParens(e) {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// Conjunction reports whether e is a single condition or a conjunction of
+// conditions and, if so, returns these conditions. A nil expression is the
+// empty conjunction.
+func Conjunction(e Expr) ([]Condition, bool) {
+	switch e := e.(type) {
+	case nil:
+		return nil, true
+	case Condition:
+		return []Condition{e}, true
+	case And:
+		conds := make([]Condition, len(e))
+		for i, sub := range e {
+			cond, ok := sub.(Condition)
+			if !ok {
+				return nil, false
+			}
+			conds[i] = cond
+		}
+		return conds, true
+	default:
+		return nil, false
+	}
+}
+
+// MaxRegexpLength is the maximum length of the regular expression of a
+// MATCHES condition.
+const MaxRegexpLength = 256
+
 // A Condition is a single conditional expression, consisting of a tag, a
 // comparison operator, and an optional argument. The type of the argument
-// depends on the operator.
+// depends on the operator. The IN operator takes a list of arguments, Args,
+// instead of Arg.
 type Condition struct {
-	Tag string
-	Op  Token
-	Arg *Arg
+	Tag  string
+	Op   Token
+	Arg  *Arg
+	Args []*Arg
 
 	opText string
 }
 
+func (Condition) isExpr() {}
+
 func (c Condition) String() string {
 	s := c.Tag + " " + c.opText
+	if c.Op == TIn {
+		args := make([]string, len(c.Args))
+		for i, arg := range c.Args {
+			args[i] = arg.String()
+		}
+		return s + " (" + strings.Join(args, ", ") + ")"
+	}
 	if c.Arg != nil {
 		return s + " " + c.Arg.String()
 	}
@@ -130,6 +224,7 @@ func (a *Arg) Value() string {
 // defined in the syntax package documentation.
 type Parser struct {
 	scanner *Scanner
+	eof     bool // whether the scanner reached the end of the input
 }
 
 // NewParser constructs a new parser that reads the input from r.
@@ -138,33 +233,139 @@ func NewParser(r io.Reader) *Parser {
 }
 
 // Parse parses the complete input and returns the resulting query.
-func (p *Parser) Parse() (Query, error) {
-	cond, err := p.parseCond()
+func (p *Parser) Parse() (Expr, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
 	if err != nil {
 		return nil, err
 	}
-	conds := []Condition{cond}
-	for p.scanner.Next() != io.EOF {
-		if tok := p.scanner.Token(); tok != TAnd {
-			return nil, fmt.Errorf("offset %d: got %v, want %v", p.scanner.Pos(), tok, TAnd)
+	if !p.eof {
+		return nil, fmt.Errorf("offset %d: got %v, wanted %s", p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TAnd, TOr}))
+	}
+	return expr, nil
+}
+
+// parseOr parses a disjunction of conjunctions: conj {OR conj}.
+func (p *Parser) parseOr() (Expr, error) {
+	var terms Or
+	for {
+		expr, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if or, ok := expr.(Or); ok { // parenthesized disjunction
+			terms = append(terms, or...)
+		} else {
+			terms = append(terms, expr)
+		}
+		if p.eof || p.scanner.Token() != TOr {
+			break
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return terms, nil
+}
+
+// parseAnd parses a conjunction of factors: factor {AND factor}.
+func (p *Parser) parseAnd() (Expr, error) {
+	var terms And
+	for {
+		expr, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		if and, ok := expr.(And); ok { // parenthesized conjunction
+			terms = append(terms, and...)
+		} else {
+			terms = append(terms, expr)
+		}
+		if p.eof || p.scanner.Token() != TAnd {
+			break
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return terms, nil
+}
+
+// parseFactor parses a condition, a negated factor, or a parenthesized
+// expression. On success, the scanner is positioned on the token following the
+// factor.
+func (p *Parser) parseFactor() (Expr, error) {
+	if p.eof {
+		return nil, fmt.Errorf("offset %d: %w", p.scanner.Pos(), io.EOF)
+	}
+	switch p.scanner.Token() {
+	case TNot:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	case TLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.eof || p.scanner.Token() != TRParen {
+			return nil, fmt.Errorf("offset %d: got %v, wanted %v", p.scanner.Pos(), p.currentLabel(), TRParen)
 		}
+		return expr, p.next()
+	case TTag:
 		cond, err := p.parseCond()
 		if err != nil {
 			return nil, err
 		}
-		conds = append(conds, cond)
+		return cond, p.next()
+	default:
+		return nil, fmt.Errorf("offset %d: got %v, wanted %s", p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TTag, TNot, TLParen}))
+	}
+}
+
+// next advances the scanner to the next token. Reaching the end of the input
+// is not an error, and is recorded in p.eof instead.
+func (p *Parser) next() error {
+	err := p.scanner.Next()
+	if err == io.EOF {
+		p.eof = true
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
 	}
-	return conds, nil
+	return nil
 }
 
-// parseCond parses a conditional expression: tag OP value.
+// currentLabel describes the current token, for error messages.
+func (p *Parser) currentLabel() string {
+	if p.eof {
+		return "end of input"
+	}
+	return p.scanner.Token().String()
+}
+
+// parseCond parses a conditional expression: tag OP value. The scanner must be
+// positioned on the tag, and is left on the last token of the condition.
 func (p *Parser) parseCond() (Condition, error) {
 	var cond Condition
-	if err := p.require(TTag); err != nil {
-		return cond, err
-	}
 	cond.Tag = p.scanner.Text()
-	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TExists); err != nil {
+	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TStartsWith, TMatches, TIn, TExists); err != nil {
 		return cond, err
 	}
 	cond.Op = p.scanner.Token()
@@ -176,8 +377,10 @@ func (p *Parser) parseCond() (Condition, error) {
 		err = p.require(TNumber, TTime, TDate)
 	case TEq:
 		err = p.require(TNumber, TTime, TDate, TString)
-	case TContains:
+	case TContains, TStartsWith, TMatches:
 		err = p.require(TString)
+	case TIn:
+		return p.parseIn(cond)
 	case TExists:
 		// no argument
 		return cond, nil
@@ -188,9 +391,36 @@ func (p *Parser) parseCond() (Condition, error) {
 		return cond, err
 	}
 	cond.Arg = &Arg{Type: p.scanner.Token(), text: p.scanner.Text()}
+	if cond.Op == TMatches {
+		if len(cond.Arg.text) > MaxRegexpLength {
+			return cond, fmt.Errorf("offset %d: regular expression longer than %d bytes", p.scanner.Pos(), MaxRegexpLength)
+		}
+		if _, err := regexp.Compile(cond.Arg.text); err != nil {
+			return cond, fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
+		}
+	}
 	return cond, nil
 }
 
+// parseIn parses the list of arguments of an IN condition: (value {, value}).
+func (p *Parser) parseIn(cond Condition) (Condition, error) {
+	if err := p.require(TLParen); err != nil {
+		return cond, err
+	}
+	for {
+		if err := p.require(TNumber, TTime, TDate, TString); err != nil {
+			return cond, err
+		}
+		cond.Args = append(cond.Args, &Arg{Type: p.scanner.Token(), text: p.scanner.Text()})
+		if err := p.require(TComma, TRParen); err != nil {
+			return cond, err
+		}
+		if p.scanner.Token() == TRParen {
+			return cond, nil
+		}
+	}
+}
+
 // require advances the scanner and requires that the resulting token is one of
 // the specified token types.
 func (p *Parser) require(tokens ...Token) error {
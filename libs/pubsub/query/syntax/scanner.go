@@ -14,39 +14,55 @@ import (
 type Token byte
 
 const (
-	TInvalid  = iota // invalid or unknown token
-	TTag             // field tag: x.y
-	TString          // string value: 'foo bar'
-	TNumber          // number: 0, 15.5, 100
-	TTime            // timestamp: TIME yyyy-mm-ddThh:mm:ss([-+]hh:mm|Z)
-	TDate            // datestamp: DATE yyyy-mm-dd
-	TAnd             // operator: AND
-	TContains        // operator: CONTAINS
-	TExists          // operator: EXISTS
-	TEq              // operator: =
-	TLt              // operator: <
-	TLeq             // operator: <=
-	TGt              // operator: >
-	TGeq             // operator: >=
+	TInvalid    = iota // invalid or unknown token
+	TTag               // field tag: x.y
+	TString            // string value: 'foo bar'
+	TNumber            // number: 0, 15.5, 100
+	TTime              // timestamp: TIME yyyy-mm-ddThh:mm:ss([-+]hh:mm|Z)
+	TDate              // datestamp: DATE yyyy-mm-dd
+	TAnd               // operator: AND
+	TContains          // operator: CONTAINS
+	TExists            // operator: EXISTS
+	TEq                // operator: =
+	TLt                // operator: <
+	TLeq               // operator: <=
+	TGt                // operator: >
+	TGeq               // operator: >=
+	TOr                // operator: OR
+	TNot               // operator: NOT
+	TLParen            // grouping: (
+	TRParen            // grouping: )
+	TIn                // operator: IN
+	TStartsWith        // operator: STARTS WITH
+	TMatches           // operator: MATCHES
+	TComma             // separator: ,
 
 	// Do not reorder these values without updating the scanner code.
 )
 
 var tString = [...]string{
-	TInvalid:  "invalid token",
-	TTag:      "tag",
-	TString:   "string",
-	TNumber:   "number",
-	TTime:     "timestamp",
-	TDate:     "datestamp",
-	TAnd:      "AND operator",
-	TContains: "CONTAINS operator",
-	TExists:   "EXISTS operator",
-	TEq:       "= operator",
-	TLt:       "< operator",
-	TLeq:      "<= operator",
-	TGt:       "> operator",
-	TGeq:      ">= operator",
+	TInvalid:    "invalid token",
+	TTag:        "tag",
+	TString:     "string",
+	TNumber:     "number",
+	TTime:       "timestamp",
+	TDate:       "datestamp",
+	TAnd:        "AND operator",
+	TContains:   "CONTAINS operator",
+	TExists:     "EXISTS operator",
+	TEq:         "= operator",
+	TLt:         "< operator",
+	TLeq:        "<= operator",
+	TGt:         "> operator",
+	TGeq:        ">= operator",
+	TOr:         "OR operator",
+	TNot:        "NOT operator",
+	TLParen:     "left parenthesis",
+	TRParen:     "right parenthesis",
+	TIn:         "IN operator",
+	TStartsWith: "STARTS WITH operator",
+	TMatches:    "MATCHES operator",
+	TComma:      "comma",
 }
 
 func (t Token) String() string {
@@ -107,6 +123,18 @@ func (s *Scanner) Next() error {
 			return s.scanString(ch)
 		case '<', '>', '=':
 			return s.scanCompare(ch)
+		case '(':
+			s.buf.WriteRune(ch)
+			s.tok = TLParen
+			return nil
+		case ')':
+			s.buf.WriteRune(ch)
+			s.tok = TRParen
+			return nil
+		case ',':
+			s.buf.WriteRune(ch)
+			s.tok = TComma
+			return nil
 		default:
 			return s.invalid(ch)
 		}
@@ -224,6 +252,19 @@ func (s *Scanner) scanTagLike(first rune) error {
 		s.tok = TTag
 	case "AND":
 		s.tok = TAnd
+	case "OR":
+		s.tok = TOr
+	case "NOT":
+		s.tok = TNot
+	case "IN":
+		s.tok = TIn
+	case "MATCHES":
+		s.tok = TMatches
+	case "STARTS":
+		if hasSpace {
+			return s.scanStartsWith()
+		}
+		s.tok = TTag
 	case "EXISTS":
 		s.tok = TExists
 	case "CONTAINS":
@@ -235,6 +276,29 @@ func (s *Scanner) scanTagLike(first rune) error {
 	return nil
 }
 
+func (s *Scanner) scanStartsWith() error {
+	var word bytes.Buffer
+	for {
+		ch, err := s.rune()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return s.fail(err)
+		}
+		if !isTagRune(ch) {
+			s.unrune()
+			break
+		}
+		word.WriteRune(ch)
+	}
+	if word.String() != "WITH" {
+		return s.fail(fmt.Errorf("invalid input %q after STARTS at offset %d, wanted WITH", word.String(), s.end))
+	}
+	s.buf.WriteString(" WITH")
+	s.tok = TStartsWith
+	return nil
+}
+
 func (s *Scanner) scanTimestamp() error {
 	s.buf.Reset() // discard "TIME" label
 	if err := s.scanWhile(isTimeRune); err != nil {
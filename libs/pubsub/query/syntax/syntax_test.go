@@ -40,6 +40,16 @@ func TestScanner(t *testing.T) {
 		{`x.y CONTAINS 'z'`, []syntax.Token{syntax.TTag, syntax.TContains, syntax.TString}},
 		{`foo EXISTS`, []syntax.Token{syntax.TTag, syntax.TExists}},
 		{`and AND`, []syntax.Token{syntax.TTag, syntax.TAnd}},
+		{`x OR NOT y`, []syntax.Token{syntax.TTag, syntax.TOr, syntax.TNot, syntax.TTag}},
+		{`(x.y=1)`, []syntax.Token{
+			syntax.TLParen, syntax.TTag, syntax.TEq, syntax.TNumber, syntax.TRParen,
+		}},
+		{`x IN ('a', 1)`, []syntax.Token{
+			syntax.TTag, syntax.TIn, syntax.TLParen, syntax.TString, syntax.TComma, syntax.TNumber, syntax.TRParen,
+		}},
+		{`x STARTS WITH 'a'`, []syntax.Token{syntax.TTag, syntax.TStartsWith, syntax.TString}},
+		{`x MATCHES '^a+$'`, []syntax.Token{syntax.TTag, syntax.TMatches, syntax.TString}},
+		{`STARTS`, []syntax.Token{syntax.TTag}},
 
 		// Timestamp
 		{`TIME 2021-11-23T15:16:17Z`, []syntax.Token{syntax.TTime}},
@@ -81,6 +91,7 @@ func TestScannerErrors(t *testing.T) {
 		{`TIME 2021-01-99T14:56:08Z`},
 		{`TIME 2021-01-99T34:56:08`},
 		{`TIME 2021-01-99T34:56:11+3`},
+		{`STARTS AT`},
 	}
 	for _, test := range tests {
 		s := syntax.NewScanner(strings.NewReader(test.input))
@@ -90,6 +101,56 @@ func TestScannerErrors(t *testing.T) {
 	}
 }
 
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"a.b=1", "a.b = 1"},
+		{"a.b=1 AND c.d=2", "a.b = 1 AND c.d = 2"},
+		{"a.b=1 OR c.d=2 AND e.f=3", "a.b = 1 OR c.d = 2 AND e.f = 3"},
+		{"(a.b=1 OR c.d=2) AND e.f=3", "(a.b = 1 OR c.d = 2) AND e.f = 3"},
+		{"NOT a.b=1 AND c.d=2", "NOT a.b = 1 AND c.d = 2"},
+		{"NOT (a.b=1 AND c.d=2)", "NOT (a.b = 1 AND c.d = 2)"},
+		{"a.b=1 AND (c.d=2 AND e.f=3)", "a.b = 1 AND c.d = 2 AND e.f = 3"},
+		{"(a.b=1 OR c.d=2) OR (e.f=3)", "a.b = 1 OR c.d = 2 OR e.f = 3"},
+	}
+	for _, test := range tests {
+		q, err := syntax.Parse(test.input)
+		if err != nil {
+			t.Errorf("Parse %#q: unexpected error: %v", test.input, err)
+			continue
+		}
+		if got := q.String(); got != test.want {
+			t.Errorf("Parse %#q: got %#q, want %#q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestConjunction(t *testing.T) {
+	tests := []struct {
+		input string
+		conds int
+		ok    bool
+	}{
+		{"a.b=1", 1, true},
+		{"a.b=1 AND c.d=2 AND e.f EXISTS", 3, true},
+		{"a.b=1 OR c.d=2", 0, false},
+		{"a.b=1 AND NOT c.d=2", 0, false},
+		{"a.b=1 AND (c.d=2 OR e.f=3)", 0, false},
+	}
+	for _, test := range tests {
+		q, err := syntax.Parse(test.input)
+		if err != nil {
+			t.Fatalf("Parse %#q: unexpected error: %v", test.input, err)
+		}
+		conds, ok := syntax.Conjunction(q)
+		if ok != test.ok || len(conds) != test.conds {
+			t.Errorf("Conjunction %#q: got %d conditions, %v; want %d, %v", test.input, len(conds), ok, test.conds, test.ok)
+		}
+	}
+}
+
 // These parser tests were copied from the original implementation of the query
 // parser, and are preserved here as a compatibility check.
 func TestParseValid(t *testing.T) {
@@ -167,6 +228,36 @@ func TestParseValid(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"transfer.sender='a' OR transfer.recipient='a'", true},
+		{"transfer.sender='a' OR", false},
+		{"OR transfer.sender='a'", false},
+		{"NOT transfer.sender='a'", true},
+		{"NOT NOT transfer.sender='a'", true},
+		{"transfer.sender NOT ='a'", false},
+		{"NOT", false},
+		{"(transfer.sender='a' OR transfer.recipient='a') AND transfer.amount > 5", true},
+		{"NOT (transfer.sender='a' AND transfer.amount > 5) OR slash EXISTS", true},
+		{"((transfer.sender='a'))", true},
+		{"(transfer.sender='a'", false},
+		{"transfer.sender='a')", false},
+		{"()", false},
+		{"transfer.sender=('a')", false},
+
+		{"transfer.sender IN ('a')", true},
+		{"transfer.sender IN ('a', 'b', 1, DATE 2021-01-01, TIME 2021-01-01T00:00:00Z)", true},
+		{"transfer.sender IN ()", false},
+		{"transfer.sender IN ('a',)", false},
+		{"transfer.sender IN ('a' 'b')", false},
+		{"transfer.sender IN 'a'", false},
+		{"transfer.sender IN ('a', 'b') AND NOT transfer.recipient IN ('c')", true},
+		{"transfer.sender STARTS WITH 'cosmos1'", true},
+		{"transfer.sender STARTS WITH 1", false},
+		{"transfer.sender STARTS 'cosmos1'", false},
+		{"transfer.sender MATCHES '^cosmos1[a-z0-9]+$'", true},
+		{"transfer.sender MATCHES '(unclosed'", false},
+		{"transfer.sender MATCHES 'a{1,10000}'", false},
+		{"transfer.sender MATCHES '" + strings.Repeat("a", syntax.MaxRegexpLength+1) + "'", false},
 	}
 
 	for _, test := range tests {
@@ -218,6 +218,10 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) CrawledPeers(ctx context.Context) (*ctypes.ResultCrawledPeers, error) {
+	return c.next.CrawledPeers(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -499,8 +503,9 @@ func (c *Client) TxSearch(
 	prove bool,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy, cursor)
 }
 
 func (c *Client) BlockSearch(
@@ -508,8 +513,9 @@ func (c *Client) BlockSearch(
 	query string,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	return c.next.BlockSearch(ctx, query, page, perPage, orderBy, cursor)
 }
 
 // Validators fetches and verifies validators.
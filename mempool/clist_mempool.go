@@ -58,10 +58,23 @@ type CListMempool struct {
 	txs    *clist.CList
 	txsMap sync.Map
 
+	// `senderTxs`: sender -> sequence -> CElement, for the txs for which the
+	// application returned a sender in CheckTx. It is used to reap the txs of
+	// each sender in sequence order and to replace txs with the same sender
+	// and sequence.
+	senderTxs    map[string]map[uint64]*clist.CElement
+	senderTxsMtx cmtsync.Mutex
+
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Subscribers to txs entering and leaving the mempool.
+	txEvents txEventBroadcaster
+
+	// Set by the mempools built on CListMempool, e.g. PriorityMempool.
+	hooks mempoolHooks
+
 	logger  log.Logger
 	metrics *Metrics
 }
@@ -71,6 +84,24 @@ var _ Mempool = &CListMempool{}
 // CListMempoolOption sets an optional parameter on the mempool.
 type CListMempoolOption func(*CListMempool)
 
+// mempoolHooks let the mempools built on CListMempool change how it admits
+// transactions and index the transactions it holds. Any of them may be nil.
+type mempoolHooks struct {
+	// fullCheck is called when a valid transaction does not fit in the
+	// mempool, and returns whether it made room for it. Otherwise, the
+	// transaction is rejected. If set, CheckTx does not reject transactions
+	// when the mempool is full, since room may be made for them once the
+	// application checked them.
+	fullCheck func(tx types.Tx, res *abci.ResponseCheckTx) bool
+	// txAdded and txRemoved are called with the transactions added to and
+	// removed from the mempool.
+	txAdded   func(memTx *mempoolTx)
+	txRemoved func(memTx *mempoolTx)
+	// txRechecked is called with the transactions still valid after being
+	// rechecked.
+	txRechecked func(memTx *mempoolTx, res *abci.ResponseCheckTx)
+}
+
 // NewCListMempool returns a new mempool with the given configuration and
 // connection to an application.
 func NewCListMempool(
@@ -83,6 +114,7 @@ func NewCListMempool(
 		config:        cfg,
 		proxyAppConn:  proxyAppConn,
 		txs:           clist.New(),
+		senderTxs:     make(map[string]map[uint64]*clist.CElement),
 		height:        height,
 		recheckCursor: nil,
 		recheckEnd:    nil,
@@ -112,6 +144,16 @@ func (mem *CListMempool) getCElement(txKey types.TxKey) (*clist.CElement, bool)
 	return nil, false
 }
 
+// GetTxByKey implements Mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	if e, ok := mem.getCElement(txKey); ok {
+		return e.Value.(*mempoolTx).tx, true
+	}
+	return nil, false
+}
+
 func (mem *CListMempool) InMempool(txKey types.TxKey) bool {
 	_, ok := mem.getCElement(txKey)
 	return ok
@@ -140,11 +182,20 @@ func (mem *CListMempool) removeAllTxs() {
 		e.DetachPrev()
 	}
 
-	mem.txsMap.Range(func(key, _ interface{}) bool {
+	mem.txsMap.Range(func(key, value interface{}) bool {
 		mem.txsMap.Delete(key)
 		mem.invokeRemoveTxOnReactor(key.(types.TxKey))
+		memTx := value.(*clist.CElement).Value.(*mempoolTx)
+		if mem.hooks.txRemoved != nil {
+			mem.hooks.txRemoved(memTx)
+		}
+		mem.txEvents.publish(TxEvent{Type: TxRemoved, Tx: memTx.tx})
 		return true
 	})
+
+	mem.senderTxsMtx.Lock()
+	mem.senderTxs = make(map[string]map[uint64]*clist.CElement)
+	mem.senderTxsMtx.Unlock()
 }
 
 // NOTE: not thread safe - should only be called once, on startup
@@ -156,6 +207,13 @@ func (mem *CListMempool) SetTxRemovedCallback(cb func(txKey types.TxKey)) {
 	mem.removeTxOnReactorCb = cb
 }
 
+// SubscribeTxEvents implements Mempool.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) SubscribeTxEvents(capacity int) (<-chan TxEvent, func()) {
+	return mem.txEvents.subscribe(capacity)
+}
+
 func (mem *CListMempool) invokeRemoveTxOnReactor(txKey types.TxKey) {
 	// Note that the callback is nil in the unit tests, where there are no
 	// reactors.
@@ -251,7 +309,10 @@ func (mem *CListMempool) CheckTx(tx types.Tx) (*abcicli.ReqRes, error) {
 
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
+	// With a fullCheck hook, only the transactions which cannot fit in the
+	// mempool even once emptied are rejected here.
+	if err := mem.isFull(txSize); err != nil &&
+		(mem.hooks.fullCheck == nil || int64(txSize) > mem.config.MaxTxsBytes) {
 		return nil, err
 	}
 
@@ -325,8 +386,22 @@ func (mem *CListMempool) globalCb(req *abci.Request, res *abci.Response) {
 func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(memTx.tx.Key(), e)
+	if memTx.sender != "" {
+		mem.senderTxsMtx.Lock()
+		seqs, ok := mem.senderTxs[memTx.sender]
+		if !ok {
+			seqs = make(map[uint64]*clist.CElement)
+			mem.senderTxs[memTx.sender] = seqs
+		}
+		seqs[memTx.sequence] = e
+		mem.senderTxsMtx.Unlock()
+	}
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
+	if mem.hooks.txAdded != nil {
+		mem.hooks.txAdded(memTx)
+	}
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	mem.txEvents.publish(TxEvent{Type: TxAdded, Tx: memTx.tx})
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
@@ -341,13 +416,119 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		mem.txs.Remove(elem)
 		elem.DetachPrev()
 		mem.txsMap.Delete(txKey)
-		tx := elem.Value.(*mempoolTx).tx
-		atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+		memTx := elem.Value.(*mempoolTx)
+		mem.removeSenderTx(memTx.sender, memTx.sequence, elem)
+		atomic.AddInt64(&mem.txsBytes, int64(-len(memTx.tx)))
+		if mem.hooks.txRemoved != nil {
+			mem.hooks.txRemoved(memTx)
+		}
+		mem.txEvents.publish(TxEvent{Type: TxRemoved, Tx: memTx.tx})
 		return nil
 	}
 	return errors.New("transaction not found in mempool")
 }
 
+// removeSenderTx removes elem from the sender index, if it is the element
+// indexed under the given sender and sequence.
+func (mem *CListMempool) removeSenderTx(sender string, sequence uint64, elem *clist.CElement) {
+	if sender == "" {
+		return
+	}
+
+	mem.senderTxsMtx.Lock()
+	defer mem.senderTxsMtx.Unlock()
+
+	seqs, ok := mem.senderTxs[sender]
+	if !ok || seqs[sequence] != elem {
+		return
+	}
+	delete(seqs, sequence)
+	if len(seqs) == 0 {
+		delete(mem.senderTxs, sender)
+	}
+}
+
+// getSenderTx returns the tx in the mempool with the given sender and
+// sequence, if any.
+func (mem *CListMempool) getSenderTx(sender string, sequence uint64) (*mempoolTx, bool) {
+	mem.senderTxsMtx.Lock()
+	defer mem.senderTxsMtx.Unlock()
+
+	if e, ok := mem.senderTxs[sender][sequence]; ok {
+		return e.Value.(*mempoolTx), true
+	}
+	return nil, false
+}
+
+// replaceSenderTx makes room for a new valid tx with the same sender and
+// sequence as a tx already in the mempool. The existing tx is removed if the
+// new one has a strictly higher priority (replace-by-fee). It returns false if
+// the new tx must be rejected because its priority is not high enough.
+//
+// Called from:
+//   - resCbFirstTime (lock not held) if tx is valid
+func (mem *CListMempool) replaceSenderTx(tx types.Tx, res *abci.ResponseCheckTx) bool {
+	if res.Sender == "" {
+		return true
+	}
+
+	existing, ok := mem.getSenderTx(res.Sender, res.Sequence)
+	if !ok {
+		return true
+	}
+	if res.Priority <= existing.Priority() {
+		mem.logger.Debug(
+			"rejected transaction with the same sender and sequence as an existing one",
+			"tx", tx.Hash(),
+			"existing", existing.tx.Hash(),
+			"sender", res.Sender,
+			"sequence", res.Sequence,
+			"priority", res.Priority,
+		)
+		return false
+	}
+
+	if err := mem.RemoveTxByKey(existing.tx.Key()); err != nil {
+		mem.logger.Debug("Transaction could not be replaced in mempool", "err", err)
+		return true
+	}
+	// The replaced tx is still valid, so allow it back if it is resubmitted
+	// after the new one is gone.
+	mem.forceRemoveFromCache(existing.tx)
+	mem.metrics.ReplacedTxs.Add(1)
+	mem.logger.Debug(
+		"replaced transaction with the same sender and sequence",
+		"tx", tx.Hash(),
+		"replaced", existing.tx.Hash(),
+		"sender", res.Sender,
+		"sequence", res.Sequence,
+	)
+	return true
+}
+
+// pruneSenderTxs removes from the mempool the txs of the given sender with a
+// sequence lower than or equal to the given committed sequence. They can no
+// longer be executed.
+//
+// Called from:
+//   - Update (lock held)
+func (mem *CListMempool) pruneSenderTxs(sender string, committed uint64) {
+	mem.senderTxsMtx.Lock()
+	var stale []types.TxKey
+	for seq, e := range mem.senderTxs[sender] {
+		if seq <= committed {
+			stale = append(stale, e.Value.(*mempoolTx).tx.Key())
+		}
+	}
+	mem.senderTxsMtx.Unlock()
+
+	for _, txKey := range stale {
+		if err := mem.RemoveTxByKey(txKey); err != nil {
+			mem.logger.Debug("Stale transaction could not be removed from mempool", "err", err)
+		}
+	}
+}
+
 func (mem *CListMempool) isFull(txSize int) error {
 	var (
 		memSize  = mem.Size()
@@ -382,14 +563,6 @@ func (mem *CListMempool) resCbFirstTime(
 		}
 		txKey := types.Tx(tx).Key()
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			// Check mempool isn't full again to reduce the chance of exceeding the
-			// limits.
-			if err := mem.isFull(len(tx)); err != nil {
-				mem.forceRemoveFromCache(tx) // mempool might have space later
-				mem.logger.Error(err.Error())
-				return
-			}
-
 			// Check transaction not already in the mempool
 			if mem.InMempool(txKey) {
 				mem.logger.Debug(
@@ -402,14 +575,39 @@ func (mem *CListMempool) resCbFirstTime(
 				return
 			}
 
+			if !mem.replaceSenderTx(tx, r.CheckTx) {
+				mem.forceRemoveFromCache(tx) // might be accepted once the other tx is gone
+				mem.metrics.RejectedTxs.Add(1)
+				return
+			}
+
+			// Check mempool isn't full again to reduce the chance of exceeding the
+			// limits, unless the fullCheck hook makes room for the tx.
+			if err := mem.isFull(len(tx)); err != nil &&
+				(mem.hooks.fullCheck == nil || !mem.hooks.fullCheck(tx, r.CheckTx)) {
+				mem.forceRemoveFromCache(tx) // mempool might have space later
+				mem.logger.Debug(
+					"rejected valid transaction",
+					"tx", types.Tx(tx).Hash(),
+					"priority", r.CheckTx.Priority,
+					"err", err,
+				)
+				mem.metrics.RejectedTxs.Add(1)
+				return
+			}
+
 			mem.addTx(&mempoolTx{
 				height:    mem.height,
 				gasWanted: r.CheckTx.GasWanted,
+				priority:  r.CheckTx.Priority,
+				sender:    r.CheckTx.Sender,
+				sequence:  r.CheckTx.Sequence,
 				tx:        tx,
 			})
 			mem.logger.Debug(
 				"added valid transaction",
 				"tx", types.Tx(tx).Hash(),
+				"priority", r.CheckTx.Priority,
 				"res", r,
 				"height", mem.height,
 				"total", mem.Size(),
@@ -481,6 +679,8 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 			}
 			mem.tryRemoveFromCache(tx)
+		} else if mem.hooks.txRechecked != nil {
+			mem.hooks.txRechecked(memTx, r.CheckTx)
 		}
 		if mem.recheckCursor == mem.recheckEnd {
 			mem.recheckCursor = nil
@@ -533,10 +733,9 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// TODO: we will get a performance boost if we have a good estimate of avg
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, cmtmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
-	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
-
+	memTxs := orderBySequence(mem.allTxs())
+	txs := make([]types.Tx, 0, len(memTxs))
+	for _, memTx := range memTxs {
 		txs = append(txs, memTx.tx)
 
 		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
@@ -566,18 +765,30 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	mem.updateMtx.RLock()
 	defer mem.updateMtx.RUnlock()
 
+	memTxs := orderBySequence(mem.allTxs())
 	if max < 0 {
-		max = mem.txs.Len()
+		max = len(memTxs)
 	}
 
-	txs := make([]types.Tx, 0, cmtmath.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	txs := make([]types.Tx, 0, cmtmath.MinInt(len(memTxs), max))
+	for _, memTx := range memTxs {
+		if len(txs) >= max {
+			break
+		}
 		txs = append(txs, memTx.tx)
 	}
 	return txs
 }
 
+// allTxs returns all txs in the mempool in the order in which they were added.
+func (mem *CListMempool) allTxs() []*mempoolTx {
+	memTxs := make([]*mempoolTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTxs = append(memTxs, e.Value.(*mempoolTx))
+	}
+	return memTxs
+}
+
 // Lock() must be help by the caller during execution.
 // TODO: this function always returns nil; remove the return value
 func (mem *CListMempool) Update(
@@ -598,7 +809,20 @@ func (mem *CListMempool) Update(
 		mem.postCheck = postCheck
 	}
 
+	// Highest committed sequence of each sender with a tx in the block that we
+	// also had in the mempool. The sender and sequence of the other txs of the
+	// block are unknown, so the txs they made stale are only removed when
+	// rechecked, if the application rejects their sequence.
+	committedSeqs := make(map[string]uint64)
+
 	for i, tx := range txs {
+		if e, ok := mem.getCElement(tx.Key()); ok {
+			memTx := e.Value.(*mempoolTx)
+			if seq, ok := committedSeqs[memTx.sender]; memTx.sender != "" && (!ok || memTx.sequence > seq) {
+				committedSeqs[memTx.sender] = memTx.sequence
+			}
+		}
+
 		if txResults[i].Code == abci.CodeTypeOK {
 			// Add valid committed tx to the cache (if missing).
 			_ = mem.addToCache(tx)
@@ -623,6 +847,13 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// Remove the txs made stale by the committed sequences, e.g. txs that
+	// lost a replace-by-fee race against a tx of the mempool included in the
+	// block.
+	for sender, seq := range committedSeqs {
+		mem.pruneSenderTxs(sender, seq)
+	}
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -646,6 +646,44 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 10, mp.SizeBytes())
 }
 
+func TestMempoolTxEvents(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	events, cancel := mp.SubscribeTxEvents(10)
+
+	tx1, tx2 := types.Tx(kvstore.NewTxFromID(1)), types.Tx(kvstore.NewTxFromID(2))
+	callCheckTx(t, mp, types.Txs{tx1, tx2})
+
+	tx, ok := mp.GetTxByKey(tx1.Key())
+	require.True(t, ok)
+	require.Equal(t, tx1, tx)
+
+	require.NoError(t, mp.RemoveTxByKey(tx1.Key()))
+	_, ok = mp.GetTxByKey(tx1.Key())
+	require.False(t, ok)
+
+	mp.Flush()
+
+	expected := []TxEvent{
+		{Type: TxAdded, Tx: tx1},
+		{Type: TxAdded, Tx: tx2},
+		{Type: TxRemoved, Tx: tx1},
+		{Type: TxRemoved, Tx: tx2},
+	}
+	for _, e := range expected {
+		require.Equal(t, e, <-events)
+	}
+
+	// Cancelling the subscription closes the channel.
+	cancel()
+	_, ok = <-events
+	require.False(t, ok)
+	cancel()
+}
+
 func TestMempoolNoCacheOverflow(t *testing.T) {
 	sockPath := fmt.Sprintf("unix:///tmp/echo_%v.sock", cmtrand.Str(6))
 	app := kvstore.NewInMemoryApplication()
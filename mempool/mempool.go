@@ -91,6 +91,17 @@ type Mempool interface {
 	// the mempool.
 	SetTxRemovedCallback(cb func(types.TxKey))
 
+	// GetTxByKey returns the transaction with the given key and true if it is
+	// in the mempool, or nil and false otherwise.
+	GetTxByKey(txKey types.TxKey) (types.Tx, bool)
+
+	// SubscribeTxEvents returns a channel, buffered with the given capacity,
+	// on which an event is sent every time a transaction enters or leaves the
+	// mempool, and a function to cancel the subscription, which closes the
+	// channel. Sending never blocks the mempool: events that do not fit in the
+	// channel are dropped.
+	SubscribeTxEvents(capacity int) (<-chan TxEvent, func())
+
 	// Size returns the number of transactions in the mempool.
 	Size() int
 
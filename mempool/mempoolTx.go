@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"sort"
 	"sync/atomic"
 
 	"github.com/cometbft/cometbft/types"
@@ -10,6 +11,9 @@ import (
 type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
+	priority  int64    // priority assigned by the application in CheckTx
+	sender    string   // optional sender assigned by the application in CheckTx
+	sequence  uint64   // sequence of this tx for its sender
 	tx        types.Tx // validated by the application
 }
 
@@ -17,3 +21,52 @@ type mempoolTx struct {
 func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
+
+// Priority returns the priority assigned to this transaction by the
+// application.
+func (memTx *mempoolTx) Priority() int64 {
+	return atomic.LoadInt64(&memTx.priority)
+}
+
+// orderBySequence reorders the given txs so that the txs of each sender appear
+// in increasing order of sequence. The sender's txs take the positions that
+// were occupied by its txs in memTxs, so txs without a sender and the relative
+// order of the senders are unaffected. Since a tx cannot be executed before
+// the txs of the same sender with lower sequences, the txs following a gap in
+// the sequence of a sender are left out.
+func orderBySequence(memTxs []*mempoolTx) []*mempoolTx {
+	senderTxs := make(map[string][]*mempoolTx)
+	for _, memTx := range memTxs {
+		if memTx.sender != "" {
+			senderTxs[memTx.sender] = append(senderTxs[memTx.sender], memTx)
+		}
+	}
+	if len(senderTxs) == 0 {
+		return memTxs
+	}
+
+	for sender, txs := range senderTxs {
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].sequence < txs[j].sequence
+		})
+		for i := 1; i < len(txs); i++ {
+			if txs[i].sequence != txs[i-1].sequence+1 {
+				senderTxs[sender] = txs[:i]
+				break
+			}
+		}
+	}
+
+	ordered := make([]*mempoolTx, 0, len(memTxs))
+	for _, memTx := range memTxs {
+		if memTx.sender == "" {
+			ordered = append(ordered, memTx)
+			continue
+		}
+		if txs := senderTxs[memTx.sender]; len(txs) > 0 {
+			ordered = append(ordered, txs[0])
+			senderTxs[memTx.sender] = txs[1:]
+		}
+	}
+	return ordered
+}
@@ -40,6 +40,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rejected_txs",
 			Help:      "Number of rejected transactions.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of evicted transactions.",
+		}, labels).With(labelsAndValues...),
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of replaced transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -61,6 +73,8 @@ func NopMetrics() *Metrics {
 		TxSizeBytes:        discard.NewHistogram(),
 		FailedTxs:          discard.NewCounter(),
 		RejectedTxs:        discard.NewCounter(),
+		EvictedTxs:         discard.NewCounter(),
+		ReplacedTxs:        discard.NewCounter(),
 		RecheckTimes:       discard.NewCounter(),
 		AlreadyReceivedTxs: discard.NewCounter(),
 	}
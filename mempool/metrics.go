@@ -31,6 +31,18 @@ type Metrics struct {
 	//metrics:Number of rejected transactions.
 	RejectedTxs metrics.Counter
 
+	// EvictedTxs defines the number of evicted transactions. These are valid
+	// transactions that were removed from the mempool to make room for
+	// transactions with a higher priority.
+	//metrics:Number of evicted transactions.
+	EvictedTxs metrics.Counter
+
+	// ReplacedTxs defines the number of replaced transactions. These are valid
+	// transactions that were removed from the mempool because a transaction
+	// with the same sender and sequence and a higher priority was received.
+	//metrics:Number of replaced transactions.
+	ReplacedTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
@@ -68,6 +68,32 @@ func (_m *Mempool) FlushAppConn() error {
 	return r0
 }
 
+// GetTxByKey provides a mock function with given fields: txKey
+func (_m *Mempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	ret := _m.Called(txKey)
+
+	var r0 types.Tx
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.TxKey) (types.Tx, bool)); ok {
+		return rf(txKey)
+	}
+	if rf, ok := ret.Get(0).(func(types.TxKey) types.Tx); ok {
+		r0 = rf(txKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.TxKey) bool); ok {
+		r1 = rf(txKey)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // Lock provides a mock function with given fields:
 func (_m *Mempool) Lock() {
 	_m.Called()
@@ -152,6 +178,34 @@ func (_m *Mempool) SizeBytes() int64 {
 	return r0
 }
 
+// SubscribeTxEvents provides a mock function with given fields: capacity
+func (_m *Mempool) SubscribeTxEvents(capacity int) (<-chan mempool.TxEvent, func()) {
+	ret := _m.Called(capacity)
+
+	var r0 <-chan mempool.TxEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(int) (<-chan mempool.TxEvent, func())); ok {
+		return rf(capacity)
+	}
+	if rf, ok := ret.Get(0).(func(int) <-chan mempool.TxEvent); ok {
+		r0 = rf(capacity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan mempool.TxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) func()); ok {
+		r1 = rf(capacity)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
 // TxsAvailable provides a mock function with given fields:
 func (_m *Mempool) TxsAvailable() <-chan struct{} {
 	ret := _m.Called()
@@ -0,0 +1,283 @@
+package mempool
+
+import (
+	"sort"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// PriorityMempool is an in-memory pool for transactions that orders them by
+// the priority assigned by the application in CheckTx.
+//
+// Transactions are stored in the same concurrent list as in CListMempool,
+// which keeps them in the order in which they were added, so that the reactor
+// can gossip them to peers in that order. They are also indexed by priority:
+// transactions are reaped in descending order of priority, with ties broken by
+// arrival order. A full mempool does not reject transactions right away:
+// once the application has assigned a priority to a transaction, those with a
+// lower priority are evicted to make room for it.
+type PriorityMempool struct {
+	*CListMempool
+
+	index *priorityIndex
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application. It accepts the same options
+// as NewCListMempool.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		CListMempool: NewCListMempool(cfg, proxyAppConn, height, options...),
+		index:        newPriorityIndex(),
+	}
+	mp.hooks = mempoolHooks{
+		fullCheck:   mp.evictForTx,
+		txAdded:     mp.index.add,
+		txRemoved:   mp.index.remove,
+		txRechecked: mp.txRechecked,
+	}
+	return mp
+}
+
+// txRechecked updates the priority of a transaction that is still valid after
+// a new block, since the application may assign it a different one.
+func (mem *PriorityMempool) txRechecked(memTx *mempoolTx, res *abci.ResponseCheckTx) {
+	mem.index.setPriority(memTx, res.Priority)
+}
+
+// evictForTx tries to make room for a valid transaction by removing
+// transactions with a strictly lower priority, starting with the lowest one.
+// Among transactions with the same priority, the most recently added ones are
+// evicted first. It returns false, without removing anything, if the mempool
+// cannot be freed up enough.
+func (mem *PriorityMempool) evictForTx(tx types.Tx, res *abci.ResponseCheckTx) bool {
+	var (
+		txSize   = int64(len(tx))
+		numTxs   = mem.Size()
+		txsBytes = mem.SizeBytes()
+		toEvict  []*mempoolTx
+	)
+	fits := func() bool {
+		return numTxs < mem.config.Size && txSize+txsBytes <= mem.config.MaxTxsBytes
+	}
+	mem.index.forEachLower(res.Priority, func(memTx *mempoolTx) bool {
+		if fits() {
+			return false
+		}
+		toEvict = append(toEvict, memTx)
+		numTxs--
+		txsBytes -= int64(len(memTx.tx))
+		return true
+	})
+	if !fits() {
+		mem.logger.Debug(
+			"no lower priority transactions to evict",
+			"tx", tx.Hash(),
+			"priority", res.Priority,
+		)
+		return false
+	}
+
+	for _, memTx := range toEvict {
+		if err := mem.RemoveTxByKey(memTx.tx.Key()); err != nil {
+			mem.logger.Debug("Transaction could not be evicted from mempool", "err", err)
+			continue
+		}
+		// The evicted transaction is still valid, so it can be resubmitted
+		// once there is room for it.
+		mem.forceRemoveFromCache(memTx.tx)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.logger.Debug(
+			"evicted valid transaction",
+			"tx", memTx.tx.Hash(),
+			"priority", memTx.Priority(),
+			"new_priority", res.Priority,
+		)
+	}
+	return true
+}
+
+// txsByPriority returns all transactions in the mempool in descending order of
+// priority. Transactions with the same priority keep their arrival order, and
+// the transactions of each sender are ordered by sequence.
+func (mem *PriorityMempool) txsByPriority() []*mempoolTx {
+	return orderBySequence(mem.index.ordered())
+}
+
+// ReapMaxBytesMaxGas reaps transactions in descending order of priority.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	var (
+		totalGas    int64
+		runningSize int64
+	)
+
+	memTxs := mem.txsByPriority()
+	txs := make([]types.Tx, 0, len(memTxs))
+	for _, memTx := range memTxs {
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			return txs
+		}
+
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return txs
+		}
+
+		runningSize += dataSize
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+// ReapMaxTxs reaps up to max transactions in descending order of priority.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	memTxs := mem.txsByPriority()
+	if max < 0 {
+		max = len(memTxs)
+	}
+
+	txs := make([]types.Tx, 0, cmtmath.MinInt(len(memTxs), max))
+	for _, memTx := range memTxs[:cmtmath.MinInt(len(memTxs), max)] {
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+//--------------------------------------------------------------------------------
+
+// priorityIndex keeps the transactions of a PriorityMempool in descending
+// order of priority, then in arrival order, so that they are neither sorted
+// when reaped nor when evicted. It is safe for concurrent use.
+type priorityIndex struct {
+	mtx     cmtsync.Mutex
+	txs     []*indexedTx // ordered by indexedTx.before
+	entries map[*mempoolTx]*indexedTx
+	nextSeq uint64
+}
+
+type indexedTx struct {
+	memTx    *mempoolTx
+	priority int64  // the priority the tx is indexed under
+	seq      uint64 // arrival order
+}
+
+// before returns whether the tx comes before the other one in the index.
+func (itx *indexedTx) before(other *indexedTx) bool {
+	if itx.priority != other.priority {
+		return itx.priority > other.priority
+	}
+	return itx.seq < other.seq
+}
+
+func newPriorityIndex() *priorityIndex {
+	return &priorityIndex{entries: make(map[*mempoolTx]*indexedTx)}
+}
+
+func (idx *priorityIndex) add(memTx *mempoolTx) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	itx := &indexedTx{memTx: memTx, priority: memTx.Priority(), seq: idx.nextSeq}
+	idx.nextSeq++
+	idx.entries[memTx] = itx
+	idx.insert(itx)
+}
+
+func (idx *priorityIndex) remove(memTx *mempoolTx) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if itx, ok := idx.entries[memTx]; ok {
+		delete(idx.entries, memTx)
+		idx.delete(itx)
+	}
+}
+
+// setPriority sets the priority of the tx, and moves it accordingly.
+func (idx *priorityIndex) setPriority(memTx *mempoolTx, priority int64) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	atomic.StoreInt64(&memTx.priority, priority)
+	itx, ok := idx.entries[memTx]
+	if !ok || itx.priority == priority {
+		return
+	}
+	idx.delete(itx)
+	itx.priority = priority
+	idx.insert(itx)
+}
+
+// ordered returns the txs in descending order of priority, then in arrival
+// order.
+func (idx *priorityIndex) ordered() []*mempoolTx {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	memTxs := make([]*mempoolTx, len(idx.txs))
+	for i, itx := range idx.txs {
+		memTxs[i] = itx.memTx
+	}
+	return memTxs
+}
+
+// forEachLower calls fn with the txs with a priority strictly lower than the
+// given one, starting with the lowest priority and, for the same priority, the
+// most recent tx, until fn returns false. fn must not modify the index.
+func (idx *priorityIndex) forEachLower(priority int64, fn func(*mempoolTx) bool) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	for i := len(idx.txs) - 1; i >= 0 && idx.txs[i].priority < priority; i-- {
+		if !fn(idx.txs[i].memTx) {
+			return
+		}
+	}
+}
+
+// search returns the position of the tx in the index, or where to insert it.
+func (idx *priorityIndex) search(itx *indexedTx) int {
+	return sort.Search(len(idx.txs), func(i int) bool { return !idx.txs[i].before(itx) })
+}
+
+func (idx *priorityIndex) insert(itx *indexedTx) {
+	i := idx.search(itx)
+	idx.txs = append(idx.txs, nil)
+	copy(idx.txs[i+1:], idx.txs[i:])
+	idx.txs[i] = itx
+}
+
+func (idx *priorityIndex) delete(itx *indexedTx) {
+	if i := idx.search(itx); i < len(idx.txs) && idx.txs[i] == itx {
+		idx.txs = append(idx.txs[:i], idx.txs[i+1:]...)
+	}
+}
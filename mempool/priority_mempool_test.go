@@ -0,0 +1,180 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// priorityApp is a kvstore application that assigns to each transaction of
+// the form "key=priority" the priority in its value.
+type priorityApp struct {
+	*kvstore.Application
+}
+
+func (app *priorityApp) CheckTx(ctx context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	res, err := app.Application.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	parts := strings.SplitN(string(req.Tx), "=", 2)
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return &abci.ResponseCheckTx{Code: kvstore.CodeTypeInvalidTxFormat}, nil
+	}
+	res.Priority = priority
+	return res, nil
+}
+
+func newPriorityMempool(t *testing.T, cfg *config.Config) *PriorityMempool {
+	t.Helper()
+
+	app := &priorityApp{kvstore.NewInMemoryApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func priorityTx(i int, priority int64) types.Tx {
+	return types.Tx(fmt.Sprintf("tx%d=%d", i, priority))
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	mp := newPriorityMempool(t, cfg)
+
+	txs := types.Txs{
+		priorityTx(0, 1),
+		priorityTx(1, 5),
+		priorityTx(2, 3),
+		priorityTx(3, 5),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	expected := types.Txs{txs[1], txs[3], txs[2], txs[0]}
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+
+	// Each kvstore tx wants 1 gas.
+	require.Equal(t, expected[:3], mp.ReapMaxBytesMaxGas(-1, 3))
+	// Each tx takes its own size plus 2 bytes of proto encoding overhead.
+	require.Equal(t, expected[:1], mp.ReapMaxBytesMaxGas(int64(len(txs[1])+2), -1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 2
+	mp := newPriorityMempool(t, cfg)
+
+	callCheckTx(t, mp, types.Txs{priorityTx(0, 2), priorityTx(1, 1)})
+	require.Equal(t, 2, mp.Size())
+
+	// A tx with a higher priority than all others evicts the lowest one.
+	callCheckTx(t, mp, types.Txs{priorityTx(2, 3)})
+	require.Equal(t, 2, mp.Size())
+	require.True(t, mp.InMempool(priorityTx(2, 3).Key()))
+	require.False(t, mp.InMempool(priorityTx(1, 1).Key()))
+
+	// A tx with a priority not higher than any other is rejected.
+	callCheckTx(t, mp, types.Txs{priorityTx(3, 2)})
+	require.Equal(t, 2, mp.Size())
+	require.False(t, mp.InMempool(priorityTx(3, 2).Key()))
+
+	// Evicted and rejected txs are removed from the cache, so they can be
+	// resubmitted later.
+	require.False(t, mp.cache.Has(priorityTx(1, 1)))
+	require.False(t, mp.cache.Has(priorityTx(3, 2)))
+
+	require.Equal(t, types.Txs{priorityTx(2, 3), priorityTx(0, 2)}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEvictionMaxTxsBytes(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	tx0, tx1, tx2 := priorityTx(0, 1), priorityTx(1, 2), priorityTx(2, 3)
+	cfg.Mempool.MaxTxsBytes = int64(len(tx0) + len(tx1))
+	mp := newPriorityMempool(t, cfg)
+
+	callCheckTx(t, mp, types.Txs{tx0, tx1})
+	require.Equal(t, 2, mp.Size())
+
+	callCheckTx(t, mp, types.Txs{tx2})
+	require.Equal(t, types.Txs{tx2, tx1}, mp.ReapMaxTxs(-1))
+	require.Equal(t, int64(len(tx1)+len(tx2)), mp.SizeBytes())
+}
+
+func TestPriorityMempoolUpdate(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	mp := newPriorityMempool(t, cfg)
+
+	txs := types.Txs{priorityTx(0, 1), priorityTx(1, 2), priorityTx(2, 3)}
+	callCheckTx(t, mp, txs)
+
+	mp.Lock()
+	err := mp.Update(1, txs[2:], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	require.NoError(t, mp.FlushAppConn())
+
+	require.Equal(t, types.Txs{txs[1], txs[0]}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityIndex(t *testing.T) {
+	idx := newPriorityIndex()
+	memTxs := make([]*mempoolTx, 5)
+	for i, priority := range []int64{2, 1, 3, 2, 1} {
+		memTxs[i] = &mempoolTx{priority: priority, tx: priorityTx(i, priority)}
+		idx.add(memTxs[i])
+	}
+	require.Equal(t, []*mempoolTx{memTxs[2], memTxs[0], memTxs[3], memTxs[1], memTxs[4]}, idx.ordered())
+
+	var lower []*mempoolTx
+	idx.forEachLower(3, func(memTx *mempoolTx) bool {
+		lower = append(lower, memTx)
+		return len(lower) < 3
+	})
+	require.Equal(t, []*mempoolTx{memTxs[4], memTxs[1], memTxs[3]}, lower)
+
+	// A tx whose priority changes keeps its arrival order.
+	idx.setPriority(memTxs[4], 2)
+	require.EqualValues(t, 2, memTxs[4].Priority())
+	idx.remove(memTxs[2])
+	require.Equal(t, []*mempoolTx{memTxs[0], memTxs[3], memTxs[4], memTxs[1]}, idx.ordered())
+}
+
+func TestPriorityMempoolFlush(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	mp := newPriorityMempool(t, cfg)
+
+	callCheckTx(t, mp, types.Txs{priorityTx(0, 1), priorityTx(1, 2)})
+	mp.Flush()
+	require.Empty(t, mp.ReapMaxTxs(-1))
+
+	tx := priorityTx(2, 1)
+	callCheckTx(t, mp, types.Txs{tx})
+	require.Equal(t, types.Txs{tx}, mp.ReapMaxTxs(-1))
+}
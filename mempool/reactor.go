@@ -137,7 +137,7 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+		memR.Switch.ReportPeerBehaviour(e.Src, p2p.BadMessage(fmt.Errorf("mempool cannot handle message of type: %T", e.Message)))
 		return
 	}
 
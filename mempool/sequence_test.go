@@ -0,0 +1,232 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// sequenceApp is a kvstore application that assigns to each transaction of
+// the form "sender.sequence.priority=value" the corresponding sender,
+// sequence and priority, and rejects the sequences already committed. Other
+// transactions have no sender.
+type sequenceApp struct {
+	*kvstore.Application
+
+	mtx sync.Mutex
+	// Last committed sequence of each sender.
+	committed map[string]uint64
+}
+
+func newSequenceApp() *sequenceApp {
+	return &sequenceApp{
+		Application: kvstore.NewInMemoryApplication(),
+		committed:   make(map[string]uint64),
+	}
+}
+
+func (app *sequenceApp) CheckTx(ctx context.Context, req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	res, err := app.Application.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	sender, sequence, priority, ok := parseSequenceTx(req.Tx)
+	if !ok {
+		return res, nil
+	}
+
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	if committed, ok := app.committed[sender]; ok && sequence <= committed {
+		return &abci.ResponseCheckTx{Code: kvstore.CodeTypeInvalidTxFormat, Log: "sequence already committed"}, nil
+	}
+	res.Sender = sender
+	res.Sequence = sequence
+	res.Priority = priority
+	return res, nil
+}
+
+func (app *sequenceApp) FinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	app.mtx.Lock()
+	for _, tx := range req.Txs {
+		if sender, sequence, _, ok := parseSequenceTx(tx); ok && sequence > app.committed[sender] {
+			app.committed[sender] = sequence
+		}
+	}
+	app.mtx.Unlock()
+	return app.Application.FinalizeBlock(ctx, req)
+}
+
+// parseSequenceTx returns the sender, sequence and priority of a transaction
+// of the form "sender.sequence.priority=value", or false if it is not of this
+// form.
+func parseSequenceTx(tx []byte) (sender string, sequence uint64, priority int64, ok bool) {
+	var value string
+	if _, err := fmt.Sscanf(string(tx), "%1s.%d.%d=%s", &sender, &sequence, &priority, &value); err != nil {
+		return "", 0, 0, false
+	}
+	return sender, sequence, priority, true
+}
+
+func newSequenceMempool(t *testing.T, app *sequenceApp) *CListMempool {
+	t.Helper()
+
+	cfg := test.ResetTestRoot("mempool_test")
+	cc := proxy.NewLocalClientCreator(app)
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := NewCListMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func sequenceTx(sender string, sequence uint64, priority int64, value string) types.Tx {
+	return types.Tx(fmt.Sprintf("%s.%d.%d=%s", sender, sequence, priority, value))
+}
+
+func TestOrderBySequence(t *testing.T) {
+	txs := []*mempoolTx{
+		{tx: types.Tx("a2"), sender: "a", sequence: 2},
+		{tx: types.Tx("x")},
+		{tx: types.Tx("b5"), sender: "b", sequence: 5},
+		{tx: types.Tx("a1"), sender: "a", sequence: 1},
+		{tx: types.Tx("b7"), sender: "b", sequence: 7},
+		{tx: types.Tx("a3"), sender: "a", sequence: 3},
+	}
+
+	ordered := orderBySequence(txs)
+	var got []string
+	for _, memTx := range ordered {
+		got = append(got, string(memTx.tx))
+	}
+	// b7 is left out because b6 is missing.
+	require.Equal(t, []string{"a1", "x", "b5", "a2", "a3"}, got)
+}
+
+func TestMempoolReapInSequenceOrder(t *testing.T) {
+	mp := newSequenceMempool(t, newSequenceApp())
+
+	txs := types.Txs{
+		sequenceTx("a", 2, 0, "x"),
+		sequenceTx("b", 1, 0, "x"),
+		sequenceTx("a", 1, 0, "x"),
+		types.Tx("k=v"),
+		sequenceTx("a", 4, 0, "x"),
+	}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	expected := types.Txs{txs[2], txs[1], txs[0], txs[3]}
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+}
+
+func TestMempoolReplaceBySequence(t *testing.T) {
+	mp := newSequenceMempool(t, newSequenceApp())
+
+	orig := sequenceTx("a", 1, 5, "x")
+	callCheckTx(t, mp, types.Txs{orig})
+
+	// Same sender and sequence, but not a higher priority: rejected.
+	lower := sequenceTx("a", 1, 5, "y")
+	callCheckTx(t, mp, types.Txs{lower})
+	require.Equal(t, types.Txs{orig}, mp.ReapMaxTxs(-1))
+	require.False(t, mp.cache.Has(lower))
+
+	// Higher priority: replaces the existing tx.
+	higher := sequenceTx("a", 1, 6, "z")
+	callCheckTx(t, mp, types.Txs{higher})
+	require.Equal(t, types.Txs{higher}, mp.ReapMaxTxs(-1))
+	require.False(t, mp.InMempool(orig.Key()))
+	require.False(t, mp.cache.Has(orig))
+}
+
+func TestMempoolUpdatePrunesCommittedSequences(t *testing.T) {
+	mp := newSequenceMempool(t, newSequenceApp())
+
+	txs := types.Txs{
+		sequenceTx("a", 1, 0, "x"),
+		sequenceTx("a", 2, 0, "x"),
+		sequenceTx("a", 3, 0, "x"),
+		sequenceTx("b", 1, 0, "x"),
+	}
+	callCheckTx(t, mp, txs)
+
+	// Simulate a block in which only a's tx with sequence 2 was included.
+	mp.Lock()
+	err := mp.Update(1, txs[1:2], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	require.NoError(t, mp.FlushAppConn())
+
+	require.Equal(t, types.Txs{txs[2], txs[3]}, mp.ReapMaxTxs(-1))
+	_, ok := mp.getSenderTx("a", 1)
+	require.False(t, ok)
+}
+
+func TestMempoolRecheckPrunesSequencesCommittedElsewhere(t *testing.T) {
+	app := newSequenceApp()
+	mp := newSequenceMempool(t, app)
+
+	stale := sequenceTx("a", 1, 5, "x")
+	next := sequenceTx("a", 2, 5, "x")
+	callCheckTx(t, mp, types.Txs{stale, next})
+
+	// A tx replacing the first one, which was never in the mempool, is
+	// committed instead.
+	winner := sequenceTx("a", 1, 9, "y")
+	_, err := app.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{winner}})
+	require.NoError(t, err)
+	mp.Lock()
+	err = mp.Update(1, types.Txs{winner}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+	require.NoError(t, mp.FlushAppConn())
+
+	require.Equal(t, types.Txs{next}, mp.ReapMaxTxs(-1))
+	_, ok := mp.getSenderTx("a", 1)
+	require.False(t, ok)
+}
+
+func TestPriorityMempoolReapInSequenceOrder(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cc := proxy.NewLocalClientCreator(newSequenceApp())
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+
+	txs := types.Txs{
+		sequenceTx("a", 1, 1, "x"),
+		sequenceTx("b", 1, 2, "x"),
+		sequenceTx("a", 2, 3, "x"),
+	}
+	callCheckTx(t, mp, txs)
+
+	// a's second tx has the highest priority, but must come after a's first.
+	require.Equal(t, types.Txs{txs[0], txs[1], txs[2]}, mp.ReapMaxTxs(-1))
+}
@@ -0,0 +1,75 @@
+package mempool
+
+import (
+	"sync"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxEventType is the type of a TxEvent.
+type TxEventType int
+
+const (
+	// TxAdded is the type of the event fired when a transaction enters the
+	// mempool.
+	TxAdded TxEventType = iota + 1
+	// TxRemoved is the type of the event fired when a transaction leaves the
+	// mempool, either because it was committed in a block, because it became
+	// invalid on recheck, or because it was evicted or replaced.
+	TxRemoved
+)
+
+// TxEvent describes a transaction entering or leaving the mempool.
+type TxEvent struct {
+	Type TxEventType
+	Tx   types.Tx
+}
+
+// txEventBroadcaster sends TxEvents to any number of subscribers. Sending
+// never blocks: events that do not fit in a subscriber's channel are dropped
+// for that subscriber. The zero value is ready to use.
+type txEventBroadcaster struct {
+	mtx    cmtsync.Mutex
+	nextID int
+	subs   map[int]chan TxEvent
+}
+
+// subscribe returns a channel with the given capacity on which events are
+// delivered, and a function that cancels the subscription and closes the
+// channel.
+func (b *txEventBroadcaster) subscribe(capacity int) (<-chan TxEvent, func()) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.subs == nil {
+		b.subs = make(map[int]chan TxEvent)
+	}
+	id := b.nextID
+	b.nextID++
+	ch := make(chan TxEvent, capacity)
+	b.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+			delete(b.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// publish sends the event to all subscribers whose channel has room for it.
+func (b *txEventBroadcaster) publish(event TxEvent) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
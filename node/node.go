@@ -20,6 +20,7 @@ import (
 	"github.com/cometbft/cometbft/evidence"
 	"github.com/cometbft/cometbft/light"
 
+	"github.com/cometbft/cometbft/libs/compress"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/libs/service"
@@ -55,7 +56,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -83,6 +84,7 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	reindexer         *sm.Reindexer
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
 }
@@ -177,7 +179,8 @@ func BootstrapState(ctx context.Context, config *cfg.Config, dbProvider cfg.DBPr
 	}
 
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DiscardABCIResponses:    config.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: config.Storage.KeepRecentABCIResponses,
 	})
 
 	defer func() {
@@ -276,8 +279,14 @@ func NewNode(ctx context.Context,
 		return nil, err
 	}
 
+	compression, err := compress.CodecFromName(config.Storage.Compression)
+	if err != nil {
+		return nil, err
+	}
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DiscardABCIResponses:    config.Storage.DiscardABCIResponses,
+		KeepRecentABCIResponses: config.Storage.KeepRecentABCIResponses,
+		Compression:             compression,
 	})
 
 	state, genDoc, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genesisDocProvider, config.Storage.GenesisHash)
@@ -387,6 +396,13 @@ func NewNode(ctx context.Context,
 		return nil, fmt.Errorf("failed to create pruner: %w", err)
 	}
 
+	// The reindexer is only needed by the privileged reindex service.
+	var reindexer *sm.Reindexer
+	if config.GRPC.Privileged.ReindexService.Enabled {
+		reindexer = sm.NewReindexer(blockStore, stateStore, indexerService, logger.With("module", "reindexer"),
+			sm.WithReindexerRateLimit(config.GRPC.Privileged.ReindexService.MaxHeightsPerSecond))
+	}
+
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
@@ -440,13 +456,24 @@ func NewNode(ctx context.Context,
 	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	peerAccessList, err := p2p.NewPeerAccessList(peerAccessRules(config.P2P), p2pMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("could not create peer access list: %w", err)
+	}
+	transport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, proxyApp, peerAccessList)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transport: %w", err)
+	}
 
 	// Setup Switch.
+	peerScores, err := p2p.NewPeerScores(config.P2P.PeerScoresFile(), config.P2P.PeerBanDuration)
+	if err != nil {
+		return nil, fmt.Errorf("could not load peer scores: %w", err)
+	}
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
+		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, peerScores, peerAccessList, p2pLogger,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -511,6 +538,7 @@ func NewNode(ctx context.Context,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		reindexer:        reindexer,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 	}
@@ -593,6 +621,12 @@ func (n *Node) OnStart() error {
 		return fmt.Errorf("failed to start background pruning routine: %w", err)
 	}
 
+	if n.reindexer != nil {
+		if err := n.reindexer.Start(); err != nil {
+			return fmt.Errorf("failed to start reindexer: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -606,6 +640,11 @@ func (n *Node) OnStop() {
 	if err := n.pruner.Stop(); err != nil {
 		n.Logger.Error("Error stopping the pruning service", "err", err)
 	}
+	if n.reindexer != nil {
+		if err := n.reindexer.Stop(); err != nil {
+			n.Logger.Error("Error stopping the reindexer", "err", err)
+		}
+	}
 	if err := n.eventBus.Stop(); err != nil {
 		n.Logger.Error("Error closing eventBus", "err", err)
 	}
@@ -699,6 +738,9 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 
 		Config: *n.config.RPC,
 	}
+	if n.config.P2P.SeedMode && n.pexReactor != nil {
+		rpcCoreEnv.P2PCrawler = n.pexReactor
+	}
 	if err := rpcCoreEnv.InitGenesisChunks(); err != nil {
 		return nil, err
 	}
@@ -811,6 +853,15 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			opts = append(opts, grpcserver.WithMempoolService(n.mempool, n.proxyApp.Mempool(), n.mempoolReactor, n.Logger))
+		}
+		if n.config.GRPC.EventService.Enabled {
+			opts = append(opts, grpcserver.WithEventService(n.eventBus, n.config.RPC, n.Logger))
+		}
+		if n.config.GRPC.TxService.Enabled {
+			opts = append(opts, grpcserver.WithTxService(n.txIndexer, n.blockStore, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -830,6 +881,21 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.Privileged.PruningService.Enabled {
 			opts = append(opts, grpcprivserver.WithPruningService(n.pruner, n.Logger))
 		}
+		if n.config.GRPC.Privileged.ConsensusControlService.Enabled {
+			// The log level can only be changed if the node was given a
+			// logger supporting it, e.g. by the cometbft command.
+			logLevelSetter, _ := n.Logger.(log.LevelSetter)
+			opts = append(opts, grpcprivserver.WithConsensusControlService(n.sw, n.consensusState, n.mempool, logLevelSetter, n.Logger))
+		}
+		if n.config.GRPC.Privileged.DataCompanionService.Enabled {
+			opts = append(opts, grpcprivserver.WithDataCompanionService(n.blockStore, n.stateStore, n.pruner, n.Logger))
+		}
+		if n.config.GRPC.Privileged.ReindexService.Enabled {
+			opts = append(opts, grpcprivserver.WithReindexService(n.reindexer, n.Logger))
+		}
+		if n.config.GRPC.Privileged.PeerFilterService.Enabled {
+			opts = append(opts, grpcprivserver.WithPeerFilterService(n.sw, n.Logger))
+		}
 		go func() {
 			if err := grpcprivserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting privileged gRPC server", "err", err)
@@ -1024,6 +1090,13 @@ func createPruner(
 	prunerOpts := []sm.PrunerOption{
 		sm.WithPrunerInterval(config.Storage.Pruning.Interval),
 		sm.WithPrunerMetrics(metrics),
+		sm.WithPrunerBlockRetainAge(config.Storage.Pruning.Retention.BlockRetainAge),
+		sm.WithPrunerBlockStoreMaxBytes(config.Storage.Pruning.Retention.BlockStoreMaxBytes),
+		sm.WithPrunerABCIResRetainBlocks(config.Storage.Pruning.Retention.ABCIResultsRetainBlocks),
+	}
+
+	if config.Storage.DiscardABCIResponses && config.Storage.KeepRecentABCIResponses > 0 {
+		prunerOpts = append(prunerOpts, sm.WithPrunerRecentABCIResponses(config.Storage.KeepRecentABCIResponses))
 	}
 
 	if config.Storage.Pruning.DataCompanion.Enabled {
@@ -485,6 +485,21 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	assert.Contains(t, channels, cr.Channels[0].ID)
 }
 
+func TestNodeNewNodeQUIC(t *testing.T) {
+	config := test.ResetTestRoot("node_new_node_quic_test")
+	defer os.RemoveAll(config.RootDir)
+	config.P2P.QUIC = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &p2p.QUICTransport{}, n.transport)
+
+	err = n.Start()
+	require.NoError(t, err)
+	err = n.Stop()
+	require.NoError(t, err)
+}
+
 // Simple test to confirm that an existing genesis file will be deleted from the DB
 // TODO Confirm that the deletion of a very big file does not crash the machine
 func TestNodeNewNodeDeleteGenesisFileFromDB(t *testing.T) {
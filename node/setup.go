@@ -23,6 +23,7 @@ import (
 	"github.com/cometbft/cometbft/evidence"
 	"github.com/cometbft/cometbft/statesync"
 
+	"github.com/cometbft/cometbft/libs/compress"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/light"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -125,12 +126,17 @@ type blockSyncReactor interface {
 //------------------------------------------------------------------------------
 
 func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
+	var compression compress.Codec
+	compression, err = compress.CodecFromName(config.Storage.Compression)
+	if err != nil {
+		return
+	}
 	var blockStoreDB dbm.DB
 	blockStoreDB, err = dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
 	if err != nil {
 		return
 	}
-	blockStore = store.NewBlockStore(blockStoreDB)
+	blockStore = store.NewBlockStore(blockStoreDB, store.WithCompression(compression))
 
 	stateDB, err = dbProvider(&cfg.DBContext{ID: "state", Config: config})
 	if err != nil {
@@ -249,23 +255,48 @@ func createMempoolAndMempoolReactor(
 	logger log.Logger,
 ) (mempl.Mempool, *mempl.Reactor) {
 	logger = logger.With("module", "mempool")
-	mp := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
+	options := []mempl.CListMempoolOption{
 		mempl.WithMetrics(memplMetrics),
 		mempl.WithPreCheck(sm.TxPreCheck(state)),
 		mempl.WithPostCheck(sm.TxPostCheck(state)),
+	}
+	// The reactor gossips the txs of the CListMempool, which the priority
+	// mempool embeds.
+	var (
+		mp      mempl.Mempool
+		clistMp *mempl.CListMempool
 	)
+	switch config.Mempool.Type {
+	case cfg.MempoolTypePriority:
+		priorityMp := mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			options...,
+		)
+		mp, clistMp = priorityMp, priorityMp.CListMempool
+	default:
+		clistMp = mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			options...,
+		)
+		mp = clistMp
+	}
 
-	mp.SetLogger(logger)
+	clistMp.SetLogger(logger)
 
 	reactor := mempl.NewReactor(
 		config.Mempool,
-		mp,
+		clistMp,
 		waitSync,
 	)
-	if config.Consensus.WaitForTxs() {
+	// The consensus control service can stop the creation of empty blocks at
+	// runtime, in which case the consensus needs to be notified of new txs.
+	consensusControlEnabled := config.GRPC.Privileged.ListenAddress != "" &&
+		config.GRPC.Privileged.ConsensusControlService.Enabled
+	if config.Consensus.WaitForTxs() || consensusControlEnabled {
 		mp.EnableTxsAvailable()
 	}
 	reactor.SetLogger(logger)
@@ -347,14 +378,25 @@ func createConsensusReactor(config *cfg.Config,
 	return consensusReactor, consensusState
 }
 
+// p2pTransport is the transport of the node, either a MultiplexTransport or a
+// QUICTransport.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+	AddChannel(chID byte)
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	peerAccessList *p2p.PeerAccessList,
 ) (
-	*p2p.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
@@ -367,6 +409,10 @@ func createTransport(
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
 
+	// Filter connections by IP with the access list. Peers are filtered by ID
+	// by the switch.
+	connFilters = append(connFilters, peerAccessList.ConnFilter())
+
 	// Filter peers by addr or pubkey with an ABCI query.
 	// If the query return code is OK, add peer.
 	if config.FilterPeers {
@@ -413,7 +459,15 @@ func createTransport(
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
-	return transport, peerFilters
+	if config.P2P.QUIC {
+		quicTransport, err := p2p.NewQUICTransport(transport)
+		if err != nil {
+			return nil, nil, err
+		}
+		return quicTransport, peerFilters, nil
+	}
+
+	return transport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -427,6 +481,8 @@ func createSwitch(config *cfg.Config,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
+	peerScores *p2p.PeerScores,
+	peerAccessList *p2p.PeerAccessList,
 	p2pLogger log.Logger,
 ) *p2p.Switch {
 	sw := p2p.NewSwitch(
@@ -434,6 +490,8 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.WithPeerScores(peerScores),
+		p2p.WithPeerAccessList(peerAccessList),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -641,6 +699,21 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+// peerAccessRules returns the peer access rules of the config.
+func peerAccessRules(config *cfg.P2PConfig) p2p.PeerAccessRules {
+	rules := p2p.PeerAccessRules{
+		AllowedCIDRs: splitAndTrimEmpty(config.AllowedPeerCIDRs, ",", " "),
+		DeniedCIDRs:  splitAndTrimEmpty(config.DeniedPeerCIDRs, ",", " "),
+	}
+	for _, id := range splitAndTrimEmpty(config.AllowedPeerIDs, ",", " ") {
+		rules.AllowedIDs = append(rules.AllowedIDs, p2p.ID(id))
+	}
+	for _, id := range splitAndTrimEmpty(config.DeniedPeerIDs, ",", " ") {
+		rules.DeniedIDs = append(rules.DeniedIDs, p2p.ID(id))
+	}
+	return rules
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
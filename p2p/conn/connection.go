@@ -62,7 +62,8 @@ several messages along a single channel of communication.
 Each `MConnection` handles message transmission on multiple abstract communication
 `Channel`s.  Each channel has a globally unique byte id.
 The byte id and the relative priorities of each `Channel` are configured upon
-initialization of the connection.
+initialization of the connection. A channel may also be given a send rate, above
+which it only sends when the other channels have nothing to send.
 
 There are two methods for sending messages:
 
@@ -138,6 +139,10 @@ type MConnConfig struct {
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
 
+	// Send rates of some channels by ID, within SendRate. A channel above its
+	// rate only sends when the other channels have nothing to send.
+	ChannelSendRates map[byte]int64 `mapstructure:"channel_send_rates"`
+
 	// Fuzz connection
 	TestFuzz       bool                   `mapstructure:"test_fuzz"`
 	TestFuzzConfig *config.FuzzConnConfig `mapstructure:"test_fuzz_config"`
@@ -529,16 +534,27 @@ func (c *MConnection) sendPacketMsg() bool {
 	// The chosen channel will be the one whose recentlySent/priority is the least.
 	var leastRatio float32 = math.MaxFloat32
 	var leastChannel *Channel
+	var leastThrottled bool
 	for _, channel := range c.channels {
 		// If nothing to send, skip this channel
 		if !channel.isSendPending() {
 			continue
 		}
+		// Channels above their send rate are only chosen if all the others
+		// are too.
+		throttled := channel.isThrottled()
+		if leastChannel != nil && throttled != leastThrottled {
+			if throttled {
+				continue
+			}
+			leastRatio = math.MaxFloat32
+		}
 		// Get ratio, and keep track of lowest ratio.
 		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
 		if ratio < leastRatio {
 			leastRatio = ratio
 			leastChannel = channel
+			leastThrottled = throttled
 		}
 	}
 
@@ -641,6 +657,7 @@ FOR_LOOP:
 				break FOR_LOOP
 			}
 
+			channel.recvMonitor.Update(_n)
 			msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
 			if err != nil {
 				if c.IsRunning() {
@@ -704,6 +721,11 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+	MaxSendRate       int64 // 0 if only limited by the connection's send rate
+	SendBytes         int64
+	RecvBytes         int64
+	SendRate          int64 // current rate, in bytes/second
+	RecvRate          int64 // current rate, in bytes/second
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -714,12 +736,18 @@ func (c *MConnection) Status() ConnectionStatus {
 	status.Channels = make([]ChannelStatus, len(c.channels))
 	for i, channel := range c.channels {
 		channel := channel
+		sendStatus, recvStatus := channel.sendMonitor.Status(), channel.recvMonitor.Status()
 		status.Channels[i] = ChannelStatus{
 			ID:                channel.desc.ID,
 			SendQueueCapacity: cap(channel.sendQueue),
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			MaxSendRate:       channel.sendRate,
+			SendBytes:         sendStatus.Bytes,
+			RecvBytes:         recvStatus.Bytes,
+			SendRate:          sendStatus.CurRate,
+			RecvRate:          recvStatus.CurRate,
 		}
 	}
 	return status
@@ -760,6 +788,9 @@ type Channel struct {
 	recving       []byte
 	sending       []byte
 	recentlySent  int64 // exponential moving average
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+	sendRate      int64 // 0 if only limited by the connection's send rate
 
 	maxPacketMsgPayloadSize int
 
@@ -776,6 +807,9 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
+		sendRate:                conn.config.ChannelSendRates[desc.ID],
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -834,6 +868,12 @@ func (ch *Channel) isSendPending() bool {
 	return true
 }
 
+// Returns true if the channel sent as many bytes as its send rate allows for
+// now.
+func (ch *Channel) isThrottled() bool {
+	return ch.sendRate > 0 && ch.sendMonitor.Limit(1, ch.sendRate, false) == 0
+}
+
 // Creates a new PacketMsg to send.
 // Not goroutine-safe
 func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
@@ -857,6 +897,7 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	ch.sendMonitor.Update(n)
 	return
 }
 
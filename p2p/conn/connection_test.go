@@ -3,6 +3,8 @@ package conn
 import (
 	"encoding/hex"
 	"net"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -163,6 +165,67 @@ func TestMConnectionStatus(t *testing.T) {
 	assert.Zero(t, status.Channels[0].SendQueueSize)
 }
 
+// sendOnChannels sends messages of the given sizes on their channel for d,
+// waiting for the send queues to have room.
+func sendOnChannels(d time.Duration, send func(chID byte, msgBytes []byte) bool, msgSizes map[byte]int) {
+	deadline := time.Now().Add(d)
+	var wg sync.WaitGroup
+	for chID, size := range msgSizes {
+		wg.Add(1)
+		go func(chID byte, msg []byte) {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				send(chID, msg)
+			}
+		}(chID, make([]byte, size))
+	}
+	wg.Wait()
+}
+
+func TestMConnectionChannelSendRate(t *testing.T) {
+	const rate = 20480
+	cfg := DefaultMConnConfig()
+	cfg.SendRate, cfg.RecvRate = 0, 0
+	cfg.ChannelSendRates = map[byte]int64{0x01: rate}
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10},
+	}
+
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	var received [3]int64
+	onReceive := func(chID byte, msgBytes []byte) {
+		atomic.AddInt64(&received[chID], int64(len(msgBytes)))
+	}
+	onError := func(r interface{}) {}
+	mconnServer := NewMConnectionWithConfig(server, chDescs, onReceive, onError, cfg)
+	mconnServer.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnServer.Start())
+	mconnClient := NewMConnectionWithConfig(client, chDescs, onReceive, onError, cfg)
+	mconnClient.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnClient.Start())
+	t.Cleanup(stopAll(t, mconnClient, mconnServer))
+
+	// The channel is kept within its rate while the other one is busy.
+	sendOnChannels(time.Second, mconnClient.Send, map[byte]int{0x01: 1024, 0x02: 65536})
+	throttled, other := atomic.LoadInt64(&received[0x01]), atomic.LoadInt64(&received[0x02])
+	assert.Positive(t, throttled)
+	assert.Less(t, throttled, other/10)
+
+	// It uses the bandwidth left unused by the other channels.
+	sendOnChannels(time.Second, mconnClient.Send, map[byte]int{0x01: 1024})
+	assert.Greater(t, atomic.LoadInt64(&received[0x01])-throttled, int64(4*rate))
+
+	status := mconnClient.Status()
+	assert.EqualValues(t, rate, status.Channels[0].MaxSendRate)
+	assert.Positive(t, status.Channels[0].SendBytes)
+	assert.Zero(t, status.Channels[1].MaxSendRate)
+	assert.Positive(t, mconnServer.Status().Channels[1].RecvBytes)
+}
+
 func TestMConnectionPongTimeoutResultsInError(t *testing.T) {
 	server, client := net.Pipe()
 	defer server.Close()
@@ -0,0 +1,546 @@
+package conn
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+const (
+	// How long FlushStop waits for the queued messages to be received by the
+	// peer.
+	quicFlushTimeout = 10 * time.Second
+
+	// How often a channel above its send rate checks whether the other
+	// channels have messages to send.
+	quicThrottleInterval = 10 * time.Millisecond
+)
+
+/*
+QUICConnection is the counterpart of MConnection for peers connected over QUIC.
+
+Instead of multiplexing the channels over a single stream, each channel is
+mapped to its own unidirectional QUIC stream in each direction, so that a slow
+channel does not delay the messages of the others. A stream starts with the ID
+of its channel, followed by the messages of the channel, each prefixed with its
+length as a uvarint.
+
+Keep-alives and timeouts are handled by QUIC, so there are no pings nor pongs.
+A channel above its send rate waits for the other channels to have nothing to
+send.
+The messages of a channel are received in order, but the messages of different
+channels are received concurrently.
+
+FlushStop closes the streams after sending the queued messages, and waits for
+the peer to close the connection once it has received all of them, as closing a
+QUIC connection discards the data in flight.
+
+It has the same API as MConnection.
+*/
+type QUICConnection struct {
+	service.BaseService
+
+	conn        quic.Connection
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	channels    []*quicChannel
+	channelsIdx map[byte]*quicChannel
+	onReceive   receiveCbFunc
+	onError     errorCbFunc
+	errored     uint32
+	config      MConnConfig
+
+	// The number of streams opened by the peer and not closed yet.
+	recvStreams int32 // atomic.
+
+	// Closing quit stops the send routines, which drain their queue first if
+	// flush is set. sendRoutines waits for them to return.
+	quit         chan struct{}
+	flush        bool
+	sendRoutines sync.WaitGroup
+
+	// used to ensure FlushStop and OnStop
+	// are safe to call concurrently.
+	stopMtx sync.Mutex
+
+	created time.Time // time of creation
+}
+
+type quicChannel struct {
+	desc          ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize int32 // atomic.
+	recentlySent  int64 // atomic.
+	receiving     int32 // atomic. 1 once the peer opened the stream of the channel
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+	sendRate      int64 // 0 if only limited by the connection's send rate
+}
+
+// NewQUICConnection wraps a QUIC connection, whose handshake is completed, and
+// creates a multiplex connection with a config.
+func NewQUICConnection(
+	conn quic.Connection,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *QUICConnection {
+	qconn := &QUICConnection{
+		conn:        conn,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		channelsIdx: make(map[byte]*quicChannel, len(chDescs)),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		created:     time.Now(),
+	}
+
+	for _, desc := range chDescs {
+		filled := desc.FillDefaults()
+		if filled.Priority <= 0 {
+			panic("Channel default priority must be a positive integer")
+		}
+		channel := &quicChannel{
+			desc:        filled,
+			sendQueue:   make(chan []byte, filled.SendQueueCapacity),
+			sendMonitor: flow.New(0, 0),
+			recvMonitor: flow.New(0, 0),
+			sendRate:    config.ChannelSendRates[filled.ID],
+		}
+		qconn.channelsIdx[channel.desc.ID] = channel
+		qconn.channels = append(qconn.channels, channel)
+	}
+
+	qconn.BaseService = *service.NewBaseService(nil, "QUICConnection", qconn)
+
+	return qconn
+}
+
+// OnStart implements BaseService
+func (c *QUICConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	c.quit = make(chan struct{})
+	for _, channel := range c.channels {
+		c.sendRoutines.Add(1)
+		go c.sendRoutine(channel)
+	}
+	go c.acceptRoutine()
+	go c.statsRoutine()
+	return nil
+}
+
+// stopServices stops the BaseService and the send routines. If they were
+// already stopped, it returns true, otherwise it returns false.
+func (c *QUICConnection) stopServices(flush bool) (alreadyStopped bool) {
+	c.stopMtx.Lock()
+	defer c.stopMtx.Unlock()
+
+	select {
+	case <-c.quit:
+		return true
+	default:
+	}
+
+	c.BaseService.OnStop()
+	c.flush = flush
+	close(c.quit)
+	return false
+}
+
+// FlushStop replicates the logic of OnStop. It additionally ensures that all
+// successful .Send() calls will get sent before closing the connection.
+func (c *QUICConnection) FlushStop() {
+	if c.stopServices(true) {
+		return
+	}
+
+	timeout := time.NewTimer(quicFlushTimeout)
+	defer timeout.Stop()
+	done := make(chan struct{})
+	go func() {
+		c.sendRoutines.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		select {
+		case <-c.conn.Context().Done():
+		case <-timeout.C:
+			c.Logger.Debug("Timed out waiting for the peer to close the QUIC connection", "conn", c)
+		}
+	case <-timeout.C:
+		c.Logger.Debug("Timed out flushing the QUIC connection", "conn", c)
+	}
+
+	_ = c.conn.CloseWithError(0, "")
+}
+
+// OnStop implements BaseService
+func (c *QUICConnection) OnStop() {
+	if c.stopServices(false) {
+		return
+	}
+
+	_ = c.conn.CloseWithError(0, "")
+}
+
+func (c *QUICConnection) String() string {
+	return fmt.Sprintf("QUICConn{%v}", c.conn.RemoteAddr())
+}
+
+// Catch panics, usually caused by the reactors.
+func (c *QUICConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("QUICConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *QUICConnection) stopForError(r interface{}) {
+	if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if atomic.CompareAndSwapUint32(&c.errored, 0, 1) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
+
+// stopped returns whether the connection is being stopped, in which case
+// errors are expected.
+func (c *QUICConnection) stopped() bool {
+	select {
+	case <-c.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// Queues a message to be sent to channel.
+func (c *QUICConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("Send", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	select {
+	case channel.sendQueue <- msgBytes:
+		atomic.AddInt32(&channel.sendQueueSize, 1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+		return false
+	}
+}
+
+// Queues a message to be sent to channel.
+// Nonblocking, returns true if successful.
+func (c *QUICConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("TrySend", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	select {
+	case channel.sendQueue <- msgBytes:
+		atomic.AddInt32(&channel.sendQueueSize, 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if you can send more data onto the chID, false
+// otherwise. Use only as a heuristic.
+func (c *QUICConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return atomic.LoadInt32(&channel.sendQueueSize) < defaultSendQueueCapacity
+}
+
+// sendRoutine opens the stream of a channel and writes the queued messages to
+// it, until the connection is stopped.
+func (c *QUICConnection) sendRoutine(channel *quicChannel) {
+	defer c.sendRoutines.Done()
+	defer c._recover()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-c.quit
+		cancel()
+	}()
+	stream, err := c.conn.OpenUniStreamSync(ctx)
+	if err != nil {
+		if !c.stopped() {
+			c.Logger.Debug("Connection failed @ sendRoutine (opening stream)", "conn", c, "err", err)
+			c.stopForError(err)
+		}
+		return
+	}
+	// Write the channel ID right away, for the peer to accept the stream.
+	w := bufio.NewWriterSize(stream, minWriteBufferSize)
+	if err := w.WriteByte(channel.desc.ID); err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		if !c.stopped() {
+			c.Logger.Debug("Connection failed @ sendRoutine (opening stream)", "conn", c, "err", err)
+			c.stopForError(err)
+		}
+		return
+	}
+
+	var header [binary.MaxVarintLen64]byte
+	for {
+		var msgBytes []byte
+		select {
+		case msgBytes = <-channel.sendQueue:
+		case <-c.quit:
+			if c.flush {
+				c.drain(channel, w)
+				_ = stream.Close()
+			}
+			return
+		}
+
+		c.throttle(channel, w)
+		c.sendMonitor.Limit(len(msgBytes), atomic.LoadInt64(&c.config.SendRate), true)
+		n := binary.PutUvarint(header[:], uint64(len(msgBytes)))
+		_, err := w.Write(header[:n])
+		if err == nil {
+			_, err = w.Write(msgBytes)
+		}
+		// Flush unless more messages are queued, to batch them.
+		if err == nil && len(channel.sendQueue) == 0 {
+			err = w.Flush()
+		}
+		atomic.AddInt32(&channel.sendQueueSize, -1)
+		if err != nil {
+			if !c.stopped() {
+				c.Logger.Debug("Connection failed @ sendRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		c.sendMonitor.Update(n + len(msgBytes))
+		channel.sendMonitor.Update(n + len(msgBytes))
+		atomic.AddInt64(&channel.recentlySent, int64(n+len(msgBytes)))
+	}
+}
+
+// throttle waits while the channel is above its send rate and other channels
+// have messages to send, after flushing the messages written to w.
+func (c *QUICConnection) throttle(channel *quicChannel, w *bufio.Writer) {
+	if channel.sendRate <= 0 {
+		return
+	}
+	for channel.sendMonitor.Limit(1, channel.sendRate, false) == 0 && c.otherSendPending(channel) {
+		// Errors are returned by the next write.
+		_ = w.Flush()
+		select {
+		case <-time.After(quicThrottleInterval):
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// otherSendPending returns whether channels other than the given one have
+// messages to send.
+func (c *QUICConnection) otherSendPending(channel *quicChannel) bool {
+	for _, other := range c.channels {
+		if other != channel && atomic.LoadInt32(&other.sendQueueSize) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// drain writes the messages left in the send queue of the channel.
+func (c *QUICConnection) drain(channel *quicChannel, w *bufio.Writer) {
+	var header [binary.MaxVarintLen64]byte
+	for {
+		select {
+		case msgBytes := <-channel.sendQueue:
+			n := binary.PutUvarint(header[:], uint64(len(msgBytes)))
+			if _, err := w.Write(header[:n]); err != nil {
+				return
+			}
+			if _, err := w.Write(msgBytes); err != nil {
+				return
+			}
+			atomic.AddInt32(&channel.sendQueueSize, -1)
+		default:
+			if err := w.Flush(); err != nil {
+				c.Logger.Debug("QUICConnection flush failed", "err", err)
+			}
+			return
+		}
+	}
+}
+
+// acceptRoutine accepts the streams opened by the peer, and starts receiving
+// the messages of their channel.
+func (c *QUICConnection) acceptRoutine() {
+	defer c._recover()
+
+	for {
+		stream, err := c.conn.AcceptUniStream(context.Background())
+		if err != nil {
+			if !c.stopped() {
+				c.Logger.Info("Connection is closed @ acceptRoutine", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		atomic.AddInt32(&c.recvStreams, 1)
+		go c.recvRoutine(stream)
+	}
+}
+
+// recvRoutine reads the messages of a stream and pushes them to onReceive().
+func (c *QUICConnection) recvRoutine(stream quic.ReceiveStream) {
+	defer c._recover()
+
+	r := bufio.NewReaderSize(stream, minReadBufferSize)
+	channelID, err := r.ReadByte()
+	if err != nil {
+		c.recvFailed(err)
+		return
+	}
+	channel, ok := c.channelsIdx[channelID]
+	if !ok {
+		err := fmt.Errorf("unknown channel %X", channelID)
+		c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+		c.stopForError(err)
+		return
+	}
+	// The peer opens a single stream per channel, otherwise it could bypass
+	// the message ordering of the channel.
+	if !atomic.CompareAndSwapInt32(&channel.receiving, 0, 1) {
+		err := fmt.Errorf("duplicate stream for channel %X", channelID)
+		c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+		c.stopForError(err)
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			c.recvFailed(err)
+			return
+		}
+		if recvCap := channel.desc.RecvMessageCapacity; uint64(recvCap) < size {
+			c.recvFailed(fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, size))
+			return
+		}
+		c.recvMonitor.Limit(int(size), atomic.LoadInt64(&c.config.RecvRate), true)
+		msgBytes := make([]byte, size)
+		if _, err := io.ReadFull(r, msgBytes); err != nil {
+			c.recvFailed(err)
+			return
+		}
+		c.recvMonitor.Update(int(size))
+		channel.recvMonitor.Update(int(size))
+
+		c.Logger.Debug("Received bytes", "chID", channelID, "msgBytes", msgBytes)
+		c.onReceive(channelID, msgBytes)
+	}
+}
+
+func (c *QUICConnection) recvFailed(err error) {
+	if c.stopped() || !c.IsRunning() {
+		return
+	}
+	// The peer closes its streams when it stops gracefully, after sending
+	// the queued messages, and the connection is closed once all of them are.
+	if err == io.EOF && atomic.AddInt32(&c.recvStreams, -1) > 0 {
+		return
+	}
+	if err == io.EOF {
+		c.Logger.Info("Connection is closed @ recvRoutine (likely by the other side)", "conn", c)
+	} else {
+		c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+	}
+	c.stopForError(err)
+}
+
+// statsRoutine periodically decays the stats of the channels.
+func (c *QUICConnection) statsRoutine() {
+	ticker := time.NewTicker(updateStats)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, channel := range c.channels {
+				atomic.StoreInt64(&channel.recentlySent, int64(float64(atomic.LoadInt64(&channel.recentlySent))*0.8))
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+func (c *QUICConnection) Status() ConnectionStatus {
+	var status ConnectionStatus
+	status.Duration = time.Since(c.created)
+	status.SendMonitor = c.sendMonitor.Status()
+	status.RecvMonitor = c.recvMonitor.Status()
+	status.Channels = make([]ChannelStatus, len(c.channels))
+	for i, channel := range c.channels {
+		sendStatus, recvStatus := channel.sendMonitor.Status(), channel.recvMonitor.Status()
+		status.Channels[i] = ChannelStatus{
+			ID:                channel.desc.ID,
+			SendQueueCapacity: cap(channel.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
+			Priority:          channel.desc.Priority,
+			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			MaxSendRate:       channel.sendRate,
+			SendBytes:         sendStatus.Bytes,
+			RecvBytes:         recvStatus.Bytes,
+			SendRate:          sendStatus.CurRate,
+			RecvRate:          recvStatus.CurRate,
+		}
+	}
+	return status
+}
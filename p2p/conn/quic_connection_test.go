@@ -0,0 +1,189 @@
+package conn
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+type quicMsg struct {
+	chID     byte
+	msgBytes []byte
+}
+
+// createQUICConnectionPair returns the server and client QUIC connections of
+// a connection over localhost with a config, passing the messages they receive to recvc and
+// their errors to errc.
+func createQUICConnectionPair(
+	t *testing.T,
+	chDescs []*ChannelDescriptor,
+	config MConnConfig,
+	recvc chan quicMsg,
+	errc chan interface{},
+) (*QUICConnection, *QUICConnection) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour)}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	tlsConfig := &tls.Config{
+		Certificates:       []tls.Certificate{{Certificate: [][]byte{certDER}, PrivateKey: key}},
+		InsecureSkipVerify: true, //nolint:gosec
+		NextProtos:         []string{"test"},
+	}
+
+	ln, err := quic.ListenAddr("127.0.0.1:0", tlsConfig, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client, err := quic.DialAddr(ctx, ln.Addr().String(), tlsConfig, nil)
+	require.NoError(t, err)
+	server, err := ln.Accept(ctx)
+	require.NoError(t, err)
+
+	onReceive := func(chID byte, msgBytes []byte) { recvc <- quicMsg{chID, msgBytes} }
+	onError := func(r interface{}) { errc <- r }
+	conns := make([]*QUICConnection, 2)
+	for i, qc := range []quic.Connection{server, client} {
+		conns[i] = NewQUICConnection(qc, chDescs, onReceive, onError, config)
+		conns[i].SetLogger(log.TestingLogger())
+		require.NoError(t, conns[i].Start())
+		c := conns[i]
+		t.Cleanup(func() { _ = c.Stop() })
+	}
+	return conns[0], conns[1]
+}
+
+func TestQUICConnectionSendReceive(t *testing.T) {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10},
+	}
+	recvc, errc := make(chan quicMsg, 10), make(chan interface{}, 2)
+	server, client := createQUICConnectionPair(t, chDescs, DefaultMConnConfig(), recvc, errc)
+
+	assert.True(t, client.Send(0x01, []byte("foo")))
+	assert.True(t, client.TrySend(0x02, []byte("bar")))
+	assert.True(t, server.Send(0x01, []byte("baz")))
+	assert.False(t, client.Send(0x05, []byte("unknown")))
+
+	channels := make(map[byte]bool)
+	for i := 0; i < 3; i++ {
+		select {
+		case msg := <-recvc:
+			channels[msg.chID] = true
+			assert.Contains(t, []string{"foo", "bar", "baz"}, string(msg.msgBytes))
+		case err := <-errc:
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the messages")
+		}
+	}
+	assert.Len(t, channels, 2)
+
+	status := client.Status()
+	require.Len(t, status.Channels, 2)
+	assert.EqualValues(t, 0x01, status.Channels[0].ID)
+	assert.EqualValues(t, 10, status.Channels[0].SendQueueCapacity)
+}
+
+func TestQUICConnectionFlushStop(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 10}}
+	recvc, errc := make(chan quicMsg, 10), make(chan interface{}, 2)
+	_, client := createQUICConnectionPair(t, chDescs, DefaultMConnConfig(), recvc, errc)
+
+	for i := 0; i < 5; i++ {
+		require.True(t, client.Send(0x01, []byte{byte(i)}))
+	}
+	client.FlushStop()
+
+	for i := 0; i < 5; i++ {
+		select {
+		case msg := <-recvc:
+			assert.Equal(t, []byte{byte(i)}, msg.msgBytes)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the messages")
+		}
+	}
+}
+
+func TestQUICConnectionRecvMessageCapacity(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, RecvMessageCapacity: 10}}
+	recvc, errc := make(chan quicMsg, 10), make(chan interface{}, 2)
+	server, client := createQUICConnectionPair(t, chDescs, DefaultMConnConfig(), recvc, errc)
+
+	require.True(t, client.Send(0x01, make([]byte, 11)))
+	select {
+	case err := <-errc:
+		assert.ErrorContains(t, err.(error), "exceeds available capacity")
+	case <-recvc:
+		t.Fatal("received a message over the capacity")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the error")
+	}
+	assert.False(t, server.IsRunning())
+}
+
+func TestQUICConnectionChannelSendRate(t *testing.T) {
+	const rate = 20480
+	cfg := DefaultMConnConfig()
+	cfg.SendRate, cfg.RecvRate = 0, 0
+	cfg.ChannelSendRates = map[byte]int64{0x01: rate}
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10},
+	}
+	recvc, errc := make(chan quicMsg, 100), make(chan interface{}, 2)
+	server, client := createQUICConnectionPair(t, chDescs, cfg, recvc, errc)
+	go func() {
+		for range recvc { //nolint:revive
+		}
+	}()
+
+	// The channel is kept within its rate while the other one is busy.
+	sendOnChannels(time.Second, client.Send, map[byte]int{0x01: 1024, 0x02: 65536})
+	status := server.Status()
+	throttled, other := status.Channels[0].RecvBytes, status.Channels[1].RecvBytes
+	assert.Positive(t, throttled)
+	assert.Less(t, throttled, other/10)
+
+	// It uses the bandwidth left unused by the other channels.
+	sendOnChannels(time.Second, client.Send, map[byte]int{0x01: 1024})
+	assert.Greater(t, server.Status().Channels[0].RecvBytes-throttled, int64(4*rate))
+	assert.EqualValues(t, rate, client.Status().Channels[0].MaxSendRate)
+	assert.Empty(t, errc)
+}
+
+func TestQUICConnectionDuplicateStream(t *testing.T) {
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 10}}
+	recvc, errc := make(chan quicMsg, 10), make(chan interface{}, 2)
+	server, client := createQUICConnectionPair(t, chDescs, DefaultMConnConfig(), recvc, errc)
+
+	for i := 0; i < 2; i++ {
+		stream, err := client.conn.OpenUniStream()
+		require.NoError(t, err)
+		_, err = stream.Write([]byte{0x01})
+		require.NoError(t, err)
+	}
+	select {
+	case err := <-errc:
+		assert.ErrorContains(t, err.(error), "duplicate stream for channel 1")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the error")
+	}
+	assert.False(t, server.IsRunning())
+}
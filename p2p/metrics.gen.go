@@ -38,6 +38,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_pending_send_bytes",
 			Help:      "Pending bytes to be sent to a given peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerChannelSendRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_rate",
+			Help:      "Current rate at which bytes are sent to a given peer on a channel, in bytes/second.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_rate",
+			Help:      "Current rate at which bytes are received from a given peer on a channel, in bytes/second.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		NumTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -56,6 +68,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type")).With(labelsAndValues...),
+		PeerAccessRejections: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_access_rejections",
+			Help:      "Number of connections and peers rejected by the peer access list, by reason: denied_ip, ip_not_allowed, denied_id or id_not_allowed.",
+		}, append(labels, "reason")).With(labelsAndValues...),
 	}
 }
 
@@ -65,8 +83,11 @@ func NopMetrics() *Metrics {
 		PeerReceiveBytesTotal:    discard.NewCounter(),
 		PeerSendBytesTotal:       discard.NewCounter(),
 		PeerPendingSendBytes:     discard.NewGauge(),
+		PeerChannelSendRate:      discard.NewGauge(),
+		PeerChannelRecvRate:      discard.NewGauge(),
 		NumTxs:                   discard.NewGauge(),
 		MessageReceiveBytesTotal: discard.NewCounter(),
 		MessageSendBytesTotal:    discard.NewCounter(),
+		PeerAccessRejections:     discard.NewCounter(),
 	}
 }
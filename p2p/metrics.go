@@ -34,12 +34,21 @@ type Metrics struct {
 	PeerSendBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge `metrics_labels:"peer_id"`
+	// Current rate at which bytes are sent to a given peer on a channel, in
+	// bytes/second.
+	PeerChannelSendRate metrics.Gauge `metrics_labels:"peer_id,chID"`
+	// Current rate at which bytes are received from a given peer on a channel,
+	// in bytes/second.
+	PeerChannelRecvRate metrics.Gauge `metrics_labels:"peer_id,chID"`
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge `metrics_labels:"peer_id"`
 	// Number of bytes of each message type received.
 	MessageReceiveBytesTotal metrics.Counter `metrics_labels:"message_type"`
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter `metrics_labels:"message_type"`
+	// Number of connections and peers rejected by the peer access list, by
+	// reason: denied_ip, ip_not_allowed, denied_id or id_not_allowed.
+	PeerAccessRejections metrics.Counter `metrics_labels:"reason"`
 }
 
 type metricsLabelCache struct {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/quic-go/quic-go"
 
 	"github.com/cometbft/cometbft/libs/cmap"
 	"github.com/cometbft/cometbft/libs/log"
@@ -102,6 +103,17 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// multiplexConn is the connection multiplexing the channels of a peer, either
+// a MConnection or a QUICConnection.
+type multiplexConn interface {
+	service.Service
+	FlushStop()
+	Status() cmtconn.ConnectionStatus
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+}
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -110,7 +122,7 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *cmtconn.MConnection
+	mconn multiplexConn
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -142,16 +154,7 @@ func newPeer(
 	mlc *metricsLabelCache,
 	options ...PeerOption,
 ) *peer {
-	p := &peer{
-		peerConn:      pc,
-		nodeInfo:      nodeInfo,
-		channels:      nodeInfo.(DefaultNodeInfo).Channels,
-		Data:          cmap.NewCMap(),
-		metricsTicker: time.NewTicker(metricsTickerDuration),
-		metrics:       NopMetrics(),
-		mlc:           mlc,
-	}
-
+	p := makePeer(pc, nodeInfo, mlc)
 	p.mconn = createMConnection(
 		pc.conn,
 		p,
@@ -161,7 +164,6 @@ func newPeer(
 		onPeerError,
 		mConfig,
 	)
-	p.BaseService = *service.NewBaseService(nil, "Peer", p)
 	for _, option := range options {
 		option(p)
 	}
@@ -169,6 +171,49 @@ func newPeer(
 	return p
 }
 
+// newQUICPeer returns a peer connected over QUIC, whose channels are mapped to
+// QUIC streams instead of being multiplexed by a MConnection.
+func newQUICPeer(
+	pc peerConn,
+	qconn quic.Connection,
+	mConfig cmtconn.MConnConfig,
+	nodeInfo NodeInfo,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	mlc *metricsLabelCache,
+	options ...PeerOption,
+) *peer {
+	p := makePeer(pc, nodeInfo, mlc)
+	p.mconn = cmtconn.NewQUICConnection(
+		qconn,
+		chDescs,
+		createReceiveCb(p, reactorsByCh, msgTypeByChID),
+		func(r interface{}) { onPeerError(p, r) },
+		mConfig,
+	)
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+func makePeer(pc peerConn, nodeInfo NodeInfo, mlc *metricsLabelCache) *peer {
+	p := &peer{
+		peerConn:      pc,
+		nodeInfo:      nodeInfo,
+		channels:      nodeInfo.(DefaultNodeInfo).Channels,
+		Data:          cmap.NewCMap(),
+		metricsTicker: time.NewTicker(metricsTickerDuration),
+		metrics:       NopMetrics(),
+		mlc:           mlc,
+	}
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+	return p
+}
+
 // String representation.
 func (p *peer) String() string {
 	if p.outbound {
@@ -375,6 +420,12 @@ func (p *peer) metricsReporter() {
 			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
+				labels := []string{
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chStatus.ID),
+				}
+				p.metrics.PeerChannelSendRate.With(labels...).Set(float64(chStatus.SendRate))
+				p.metrics.PeerChannelRecvRate.With(labels...).Set(float64(chStatus.RecvRate))
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
@@ -396,8 +447,27 @@ func createMConnection(
 	onPeerError func(Peer, interface{}),
 	config cmtconn.MConnConfig,
 ) *cmtconn.MConnection {
+	onError := func(r interface{}) {
+		onPeerError(p, r)
+	}
 
-	onReceive := func(chID byte, msgBytes []byte) {
+	return cmtconn.NewMConnectionWithConfig(
+		conn,
+		chDescs,
+		createReceiveCb(p, reactorsByCh, msgTypeByChID),
+		onError,
+		config,
+	)
+}
+
+// createReceiveCb returns the callback of the connection of a peer, passing
+// the messages it receives to the reactors.
+func createReceiveCb(
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+) func(chID byte, msgBytes []byte) {
+	return func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
 			// Note that its ok to panic here as it's caught in the conn._recover,
@@ -428,16 +498,4 @@ func createMConnection(
 			Message:   msg,
 		})
 	}
-
-	onError := func(r interface{}) {
-		onPeerError(p, r)
-	}
-
-	return cmtconn.NewMConnectionWithConfig(
-		conn,
-		chDescs,
-		onReceive,
-		onError,
-		config,
-	)
 }
@@ -0,0 +1,174 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// PeerAccessRules are the IP ranges and node IDs which peers are accepted
+// from, e.g. to only let the sentries of a validator connect to it.
+type PeerAccessRules struct {
+	// IPs and CIDR ranges, e.g. "10.0.0.0/8", of the peers accepted. If empty,
+	// peers with any IP but the denied ones are accepted.
+	AllowedCIDRs []string
+	// IPs and CIDR ranges of the peers rejected, even if allowed.
+	DeniedCIDRs []string
+	// IDs of the peers accepted. If empty, all peers but the denied ones are
+	// accepted.
+	AllowedIDs []ID
+	// IDs of the peers rejected, even if allowed.
+	DeniedIDs []ID
+}
+
+// PeerAccessList rejects the connections and peers which the access rules do
+// not accept. The rules can be changed while the node is running. It is safe
+// for concurrent use.
+type PeerAccessList struct {
+	mtx         sync.RWMutex
+	rules       PeerAccessRules
+	allowedNets []*net.IPNet
+	deniedNets  []*net.IPNet
+	allowedIDs  map[ID]struct{}
+	deniedIDs   map[ID]struct{}
+
+	metrics *Metrics
+}
+
+// NewPeerAccessList returns an access list applying the given rules, counting
+// the rejections in the metrics.
+func NewPeerAccessList(rules PeerAccessRules, metrics *Metrics) (*PeerAccessList, error) {
+	l := &PeerAccessList{metrics: metrics}
+	if err := l.SetRules(rules); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// SetRules replaces the access rules. It only applies to the connections and
+// peers filtered afterwards.
+func (l *PeerAccessList) SetRules(rules PeerAccessRules) error {
+	allowedNets, err := parseCIDRs(rules.AllowedCIDRs)
+	if err != nil {
+		return err
+	}
+	deniedNets, err := parseCIDRs(rules.DeniedCIDRs)
+	if err != nil {
+		return err
+	}
+	allowedIDs, err := idSet(rules.AllowedIDs)
+	if err != nil {
+		return err
+	}
+	deniedIDs, err := idSet(rules.DeniedIDs)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.rules = rules
+	l.allowedNets, l.deniedNets = allowedNets, deniedNets
+	l.allowedIDs, l.deniedIDs = allowedIDs, deniedIDs
+	return nil
+}
+
+// Rules returns the access rules.
+func (l *PeerAccessList) Rules() PeerAccessRules {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return l.rules
+}
+
+// ConnFilter returns a filter rejecting the connections from IPs which the
+// access rules do not accept.
+func (l *PeerAccessList) ConnFilter() ConnFilterFunc {
+	return func(_ ConnSet, _ net.Conn, ips []net.IP) error {
+		l.mtx.RLock()
+		defer l.mtx.RUnlock()
+		for _, ip := range ips {
+			if err := l.filterIP(ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// FilterPeer returns an error if the access rules do not accept the ID or the
+// IP of the peer.
+func (l *PeerAccessList) FilterPeer(p Peer) error {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	if _, ok := l.deniedIDs[p.ID()]; ok {
+		l.metrics.PeerAccessRejections.With("reason", "denied_id").Add(1)
+		return fmt.Errorf("peer %v is denied", p.ID())
+	}
+	if _, ok := l.allowedIDs[p.ID()]; len(l.allowedIDs) > 0 && !ok {
+		l.metrics.PeerAccessRejections.With("reason", "id_not_allowed").Add(1)
+		return fmt.Errorf("peer %v is not allowed", p.ID())
+	}
+	if len(l.allowedNets) == 0 && len(l.deniedNets) == 0 {
+		return nil
+	}
+	return l.filterIP(p.RemoteIP())
+}
+
+func (l *PeerAccessList) filterIP(ip net.IP) error {
+	if containsIP(l.deniedNets, ip) {
+		l.metrics.PeerAccessRejections.With("reason", "denied_ip").Add(1)
+		return fmt.Errorf("ip %v is denied", ip)
+	}
+	if len(l.allowedNets) > 0 && !containsIP(l.allowedNets, ip) {
+		l.metrics.PeerAccessRejections.With("reason", "ip_not_allowed").Add(1)
+		return fmt.Errorf("ip %v is not allowed", ip)
+	}
+	return nil
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCIDRs parses IPs and CIDR ranges, an IP standing for the range of this
+// IP only.
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", cidr)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q: %w", cidr, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+func idSet(ids []ID) (map[ID]struct{}, error) {
+	set := make(map[ID]struct{}, len(ids))
+	for _, id := range ids {
+		if err := validateID(id); err != nil {
+			return nil, fmt.Errorf("invalid peer ID %q: %w", id, err)
+		}
+		set[id] = struct{}{}
+	}
+	return set, nil
+}
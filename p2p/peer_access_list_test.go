@@ -0,0 +1,55 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerAccessList(t *testing.T) {
+	l, err := NewPeerAccessList(PeerAccessRules{}, NopMetrics())
+	require.NoError(t, err)
+	filterConn := l.ConnFilter()
+	assert.NoError(t, filterConn(nil, nil, []net.IP{net.ParseIP("1.2.3.4")}))
+	assert.NoError(t, l.FilterPeer(newMockPeer(nil)))
+
+	allowed, denied := newMockPeer(net.ParseIP("10.1.2.3")), newMockPeer(net.ParseIP("10.1.2.4"))
+	err = l.SetRules(PeerAccessRules{
+		AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+		DeniedCIDRs:  []string{"10.1.2.4", "10.2.0.0/16"},
+		DeniedIDs:    []ID{newMockPeer(nil).ID()},
+	})
+	require.NoError(t, err)
+	for ip, ok := range map[string]bool{
+		"10.1.2.3":    true,
+		"10.1.2.4":    false,
+		"10.2.3.4":    false,
+		"1.2.3.4":     false,
+		"2001:db8::1": true,
+		"2001:db9::1": false,
+	} {
+		err := filterConn(nil, nil, []net.IP{net.ParseIP(ip)})
+		assert.Equal(t, ok, err == nil, ip)
+	}
+	assert.NoError(t, l.FilterPeer(allowed))
+	assert.Error(t, l.FilterPeer(denied))
+
+	rules := PeerAccessRules{AllowedIDs: []ID{allowed.ID()}, DeniedIDs: []ID{denied.ID()}}
+	require.NoError(t, l.SetRules(rules))
+	assert.Equal(t, rules, l.Rules())
+	assert.NoError(t, l.FilterPeer(allowed))
+	assert.Error(t, l.FilterPeer(denied))
+	assert.Error(t, l.FilterPeer(newMockPeer(nil)))
+
+	// Invalid rules are rejected, and the previous ones kept.
+	for _, invalid := range []PeerAccessRules{
+		{AllowedCIDRs: []string{"10.0.0.0/33"}},
+		{DeniedCIDRs: []string{"not an ip"}},
+		{AllowedIDs: []ID{"not an id"}},
+	} {
+		assert.Error(t, l.SetRules(invalid))
+	}
+	assert.Equal(t, rules, l.Rules())
+}
@@ -0,0 +1,290 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+// Bounds of the peer scores. A peer whose score drops to MinPeerScore is
+// banned. Peers start with a score of 0.
+const (
+	MaxPeerScore = 100
+	MinPeerScore = -100
+)
+
+const (
+	// The scores of the peers which are not banned move by 1 toward 0 every
+	// peerScoreDecayInterval, so that old behaviours are forgotten.
+	peerScoreDecayInterval = 10 * time.Minute
+	// The maximum number of peers whose score is kept. Beyond it, the least
+	// significant scores are dropped.
+	maxTrackedPeers = 10000
+)
+
+// PeerBehaviour is a behaviour of a peer reported by a reactor to the Switch,
+// which changes the score of the peer.
+type PeerBehaviour struct {
+	// Why the behaviour was reported, e.g. the error of an invalid message.
+	Reason interface{}
+	// Added to the score of the peer.
+	ScoreDelta int64
+	// Whether to disconnect from the peer, whatever its score.
+	Disconnect bool
+}
+
+// BadMessage is the behaviour of a peer which sent an invalid message, or one
+// it should not have sent. The peer is disconnected, and banned if it keeps
+// misbehaving.
+func BadMessage(reason interface{}) PeerBehaviour {
+	return PeerBehaviour{Reason: reason, ScoreDelta: -25, Disconnect: true}
+}
+
+// Unresponsive is the behaviour of a peer which did not respond to a request
+// in time, e.g. for a block. The peer is disconnected.
+func Unresponsive(reason interface{}) PeerBehaviour {
+	return PeerBehaviour{Reason: reason, ScoreDelta: -10, Disconnect: true}
+}
+
+// UsefulMessage is the behaviour of a peer which sent a message contributing
+// to the node, e.g. a vote or a block.
+func UsefulMessage(reason interface{}) PeerBehaviour {
+	return PeerBehaviour{Reason: reason, ScoreDelta: 1}
+}
+
+// BannedPeer is a peer banned because its score dropped to MinPeerScore.
+type BannedPeer struct {
+	ID          ID        `json:"id"`
+	BannedUntil time.Time `json:"banned_until"`
+}
+
+type peerScore struct {
+	Score       int64     `json:"score"`
+	BannedUntil time.Time `json:"banned_until"`
+	// When the score was last decayed.
+	DecayedAt time.Time `json:"decayed_at"`
+}
+
+// decay moves the score toward 0 by 1 for each peerScoreDecayInterval elapsed
+// since it was last decayed. The score of a banned peer does not decay.
+func (p *peerScore) decay(now time.Time) {
+	if p.DecayedAt.IsZero() || !p.BannedUntil.IsZero() {
+		p.DecayedAt = now
+		return
+	}
+	steps := int64(now.Sub(p.DecayedAt) / peerScoreDecayInterval)
+	if steps <= 0 {
+		return
+	}
+	p.DecayedAt = p.DecayedAt.Add(time.Duration(steps) * peerScoreDecayInterval)
+	switch {
+	case p.Score > steps:
+		p.Score -= steps
+	case p.Score < -steps:
+		p.Score += steps
+	default:
+		p.Score = 0
+	}
+}
+
+// lessSignificant returns whether the score is less significant than the other
+// one, so that it is dropped first when too many peers are tracked. Banned
+// peers are the most significant, and the sooner their ban expires, the less.
+// Otherwise, the closer to 0 a score, the less significant.
+func (p *peerScore) lessSignificant(other *peerScore) bool {
+	if p.BannedUntil.IsZero() != other.BannedUntil.IsZero() {
+		return p.BannedUntil.IsZero()
+	}
+	if !p.BannedUntil.IsZero() {
+		return p.BannedUntil.Before(other.BannedUntil)
+	}
+	return abs(p.Score) < abs(other.Score)
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// PeerScores keeps the scores of the peers reported by the reactors, and bans
+// the peers whose score drops to MinPeerScore for some time. The scores decay
+// toward 0 over time, and at most maxTrackedPeers are kept. They are saved to a
+// file, so that they survive restarts. It is safe for concurrent use.
+type PeerScores struct {
+	mtx         sync.Mutex
+	filePath    string
+	banDuration time.Duration
+	// The peers with a non-zero score or banned.
+	peers map[ID]*peerScore
+}
+
+// NewPeerScores returns the peer scores saved in the file at filePath, if it
+// exists. If filePath is empty, the scores are not saved. Peers are banned for
+// banDuration, or never if it is 0.
+func NewPeerScores(filePath string, banDuration time.Duration) (*PeerScores, error) {
+	ps := &PeerScores{
+		filePath:    filePath,
+		banDuration: banDuration,
+		peers:       make(map[ID]*peerScore),
+	}
+	if filePath == "" {
+		return ps, nil
+	}
+	bz, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return ps, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading peer scores: %w", err)
+	}
+	if err := json.Unmarshal(bz, &ps.peers); err != nil {
+		return nil, fmt.Errorf("parsing peer scores file %s: %w", filePath, err)
+	}
+	ps.prune()
+	return ps, nil
+}
+
+// Report applies a behaviour of the peer to its score, and returns the new
+// score and whether the peer got banned. Unless canBan is set, the peer is not
+// banned whatever its score.
+func (ps *PeerScores) Report(id ID, behaviour PeerBehaviour, canBan bool) (score int64, banned bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	p := ps.get(id)
+	if p == nil {
+		if len(ps.peers) >= maxTrackedPeers {
+			ps.prune()
+		}
+		if len(ps.peers) >= maxTrackedPeers {
+			ps.evict()
+		}
+		p = &peerScore{DecayedAt: time.Now()}
+		ps.peers[id] = p
+	}
+	if !p.BannedUntil.IsZero() {
+		return p.Score, false
+	}
+	p.Score += behaviour.ScoreDelta
+	if p.Score > MaxPeerScore {
+		p.Score = MaxPeerScore
+	}
+	if p.Score <= MinPeerScore {
+		p.Score = MinPeerScore
+		if canBan && ps.banDuration > 0 {
+			p.BannedUntil = time.Now().Add(ps.banDuration)
+			banned = true
+		}
+	}
+	score = p.Score
+	if score == 0 && !banned {
+		delete(ps.peers, id)
+	}
+	return score, banned
+}
+
+// Score returns the score of the peer.
+func (ps *PeerScores) Score(id ID) int64 {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if p := ps.get(id); p != nil {
+		return p.Score
+	}
+	return 0
+}
+
+// IsBanned returns whether the peer is banned.
+func (ps *PeerScores) IsBanned(id ID) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	p := ps.get(id)
+	return p != nil && !p.BannedUntil.IsZero()
+}
+
+// Banned returns the peers currently banned, ordered by ID.
+func (ps *PeerScores) Banned() []BannedPeer {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	banned := make([]BannedPeer, 0)
+	for id := range ps.peers {
+		if p := ps.get(id); p != nil && !p.BannedUntil.IsZero() {
+			banned = append(banned, BannedPeer{ID: id, BannedUntil: p.BannedUntil})
+		}
+	}
+	sort.Slice(banned, func(i, j int) bool { return banned[i].ID < banned[j].ID })
+	return banned
+}
+
+// get returns the score of the peer, or nil if it is 0. The score is decayed,
+// and the ban of the peer is lifted, along with its score, if it expired.
+func (ps *PeerScores) get(id ID) *peerScore {
+	p, ok := ps.peers[id]
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	if !p.BannedUntil.IsZero() && !now.Before(p.BannedUntil) {
+		delete(ps.peers, id)
+		return nil
+	}
+	p.decay(now)
+	if p.Score == 0 && p.BannedUntil.IsZero() {
+		delete(ps.peers, id)
+		return nil
+	}
+	return p
+}
+
+// prune drops the scores which decayed to 0 and the expired bans, then the
+// least significant scores beyond maxTrackedPeers.
+func (ps *PeerScores) prune() {
+	for id := range ps.peers {
+		ps.get(id)
+	}
+	if len(ps.peers) <= maxTrackedPeers {
+		return
+	}
+	ids := make([]ID, 0, len(ps.peers))
+	for id := range ps.peers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ps.peers[ids[i]].lessSignificant(ps.peers[ids[j]]) })
+	for _, id := range ids[:len(ids)-maxTrackedPeers] {
+		delete(ps.peers, id)
+	}
+}
+
+// evict drops the least significant score.
+func (ps *PeerScores) evict() {
+	var (
+		evictID ID
+		evicted *peerScore
+	)
+	for id, p := range ps.peers {
+		if evicted == nil || p.lessSignificant(evicted) {
+			evictID, evicted = id, p
+		}
+	}
+	delete(ps.peers, evictID)
+}
+
+// Save writes the scores to the file, if any, once decayed.
+func (ps *PeerScores) Save() error {
+	if ps.filePath == "" {
+		return nil
+	}
+	ps.mtx.Lock()
+	ps.prune()
+	bz, err := json.MarshalIndent(ps.peers, "", "\t")
+	ps.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(ps.filePath, bz, 0o644)
+}
@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScores(t *testing.T) {
+	ps, err := NewPeerScores("", time.Hour)
+	require.NoError(t, err)
+
+	good, bad, protected := ID("good"), ID("bad"), ID("protected")
+	for i := 0; i < MaxPeerScore+10; i++ {
+		ps.Report(good, UsefulMessage("vote"), true)
+	}
+	assert.EqualValues(t, MaxPeerScore, ps.Score(good))
+
+	score, banned := ps.Report(bad, Unresponsive(errors.New("timeout")), true)
+	assert.EqualValues(t, -10, score)
+	assert.False(t, banned)
+	score, _ = ps.Report(bad, UsefulMessage("vote"), true)
+	assert.EqualValues(t, -9, score)
+	for i := 0; i < 3; i++ {
+		_, banned = ps.Report(bad, BadMessage(errors.New("invalid")), true)
+		assert.False(t, banned)
+	}
+	score, banned = ps.Report(bad, BadMessage(errors.New("invalid")), true)
+	assert.EqualValues(t, MinPeerScore, score)
+	assert.True(t, banned)
+	assert.True(t, ps.IsBanned(bad))
+
+	// A banned peer stays at the minimum score until the ban expires.
+	_, banned = ps.Report(bad, BadMessage(errors.New("invalid")), true)
+	assert.False(t, banned)
+	ps.Report(bad, UsefulMessage("vote"), true)
+	assert.EqualValues(t, MinPeerScore, ps.Score(bad))
+
+	for i := 0; i < 5; i++ {
+		_, banned = ps.Report(protected, BadMessage(errors.New("invalid")), false)
+		assert.False(t, banned)
+	}
+	assert.EqualValues(t, MinPeerScore, ps.Score(protected))
+	assert.False(t, ps.IsBanned(protected))
+
+	bannedPeers := ps.Banned()
+	require.Len(t, bannedPeers, 1)
+	assert.Equal(t, bad, bannedPeers[0].ID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), bannedPeers[0].BannedUntil, time.Minute)
+	assert.EqualValues(t, 0, ps.Score("unknown"))
+}
+
+func TestPeerScoresBanExpiry(t *testing.T) {
+	ps, err := NewPeerScores("", 50*time.Millisecond)
+	require.NoError(t, err)
+
+	ps.Report("bad", PeerBehaviour{ScoreDelta: MinPeerScore}, true)
+	require.True(t, ps.IsBanned("bad"))
+	require.Eventually(t, func() bool { return !ps.IsBanned("bad") }, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 0, ps.Score("bad"))
+	assert.Empty(t, ps.Banned())
+
+	// Bans are disabled with a zero duration.
+	ps, err = NewPeerScores("", 0)
+	require.NoError(t, err)
+	_, banned := ps.Report("bad", PeerBehaviour{ScoreDelta: MinPeerScore}, true)
+	assert.False(t, banned)
+}
+
+func TestPeerScoresSave(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "peer_scores.json")
+	ps, err := NewPeerScores(filePath, time.Hour)
+	require.NoError(t, err)
+	ps.Report("good", UsefulMessage("vote"), true)
+	ps.Report("bad", PeerBehaviour{ScoreDelta: MinPeerScore}, true)
+	require.NoError(t, ps.Save())
+
+	ps, err = NewPeerScores(filePath, time.Hour)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, ps.Score("good"))
+	assert.True(t, ps.IsBanned("bad"))
+}
+
+func TestPeerScoresDecay(t *testing.T) {
+	ps, err := NewPeerScores("", time.Hour)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		ps.Report("good", UsefulMessage("vote"), true)
+	}
+	ps.Report("bad", Unresponsive(errors.New("timeout")), true)
+	ps.Report("banned", PeerBehaviour{ScoreDelta: MinPeerScore}, true)
+
+	// Move the last decay of the scores back in time.
+	for _, p := range ps.peers {
+		p.DecayedAt = p.DecayedAt.Add(-3*peerScoreDecayInterval - time.Second)
+	}
+	assert.EqualValues(t, 7, ps.Score("good"))
+	assert.EqualValues(t, -7, ps.Score("bad"))
+	assert.EqualValues(t, MinPeerScore, ps.Score("banned"))
+	assert.True(t, ps.IsBanned("banned"))
+
+	// The scores which decayed to 0 are dropped.
+	ps.peers["good"].DecayedAt = time.Now().Add(-10 * peerScoreDecayInterval)
+	assert.EqualValues(t, 0, ps.Score("good"))
+	assert.NotContains(t, ps.peers, ID("good"))
+}
+
+func TestPeerScoresMaxTrackedPeers(t *testing.T) {
+	ps, err := NewPeerScores("", time.Hour)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < maxTrackedPeers-2; i++ {
+		ps.peers[ID(fmt.Sprintf("peer%d", i))] = &peerScore{Score: 50, DecayedAt: now}
+	}
+	ps.peers["weak"] = &peerScore{Score: -2, DecayedAt: now}
+	ps.Report("banned", PeerBehaviour{ScoreDelta: MinPeerScore}, true)
+	require.Len(t, ps.peers, maxTrackedPeers)
+
+	// The least significant score is dropped for a new peer.
+	ps.Report("new", BadMessage(errors.New("invalid")), true)
+	assert.Len(t, ps.peers, maxTrackedPeers)
+	assert.EqualValues(t, 0, ps.Score("weak"))
+	assert.EqualValues(t, -25, ps.Score("new"))
+	assert.True(t, ps.IsBanned("banned"))
+
+	// Then the scores closest to 0, but not the banned peers.
+	ps.Report("new2", PeerBehaviour{ScoreDelta: 60}, true)
+	assert.Len(t, ps.peers, maxTrackedPeers)
+	assert.EqualValues(t, 0, ps.Score("new"))
+	assert.EqualValues(t, 60, ps.Score("new2"))
+	assert.True(t, ps.IsBanned("banned"))
+}
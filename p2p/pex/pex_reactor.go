@@ -3,9 +3,11 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/cmap"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -41,6 +43,10 @@ const (
 	// check some peers every this
 	crawlPeerPeriod = 30 * time.Second
 
+	// a seed only hands out the addresses it connected to this recently, and
+	// which it did not fail to connect to since.
+	maxHealthyAddrAge = 3 * time.Hour
+
 	maxAttemptsToDial = 16 // ~ 35h in total (last attempt - 18h)
 
 	// if node connects to seed, it does not have any trusted peers.
@@ -94,7 +100,8 @@ type Reactor struct {
 	attemptsToDial sync.Map // address (string) -> {number of attempts (int), last time dialed (time.Time)}
 
 	// seed/crawled mode fields
-	crawlPeerInfos map[p2p.ID]crawlPeerInfo
+	crawlMtx       sync.Mutex
+	crawlPeerInfos map[p2p.ID]*CrawledPeer
 }
 
 func (r *Reactor) minReceiveRequestInterval() time.Duration {
@@ -134,7 +141,7 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 		ensurePeersPeriod:    defaultEnsurePeersPeriod,
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
-		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
+		crawlPeerInfos:       make(map[p2p.ID]*CrawledPeer),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
 	return r
@@ -258,7 +265,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.lastReceivedRequests.Set(id, time.Now())
 
 			// Send addrs and disconnect
-			r.SendAddrs(e.Src, r.book.GetSelectionWithBias(biasToSelectNewPeers))
+			r.SendAddrs(e.Src, r.seedSelection())
 			go func() {
 				// In a go-routine so it doesn't block .Receive.
 				e.Src.FlushStop()
@@ -471,25 +478,38 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := cmtmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
-	maxAttempts := numToDial * 3
+	// Pick twice as many candidates as there are addresses to dial, so that
+	// the peers with the best scores are dialed first.
+	numCandidates := numToDial * 2
+	candidates := make([]*p2p.NetAddress, 0, numCandidates)
+	picked := make(map[p2p.ID]struct{})
+	// Try maxAttempts times to pick numCandidates addresses to dial
+	maxAttempts := numCandidates * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts && len(candidates) < numCandidates; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if _, selected := picked[try.ID]; selected {
 			continue
 		}
-		if r.Switch.IsDialingOrExistingAddress(try) {
+		if r.Switch.IsDialingOrExistingAddress(try) || r.Switch.IsPeerBanned(try.ID) {
 			continue
 		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialing again, or have dialed too many times already
-		toDial[try.ID] = try
+		picked[try.ID] = struct{}{}
+		candidates = append(candidates, try)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return r.Switch.PeerScore(candidates[i].ID) > r.Switch.PeerScore(candidates[j].ID)
+	})
+	toDial := make(map[p2p.ID]*p2p.NetAddress)
+	for _, addr := range candidates[:cmtmath.MinInt(numToDial, len(candidates))] {
+		toDial[addr.ID] = addr
 	}
 
 	// Dial picked addresses
@@ -682,51 +702,167 @@ func (r *Reactor) nodeHasSomePeersOrDialingAny() bool {
 	return out+in+dial > 0
 }
 
-// crawlPeerInfo handles temporary data needed for the network crawling
-// performed during seed/crawler mode.
-type crawlPeerInfo struct {
+// CrawledPeer is what a seed learned about an address while crawling the
+// network.
+type CrawledPeer struct {
 	Addr *p2p.NetAddress `json:"addr"`
 	// The last time we crawled the peer or attempted to do so.
 	LastCrawled time.Time `json:"last_crawled"`
+	// The last time we connected to the peer, or found it already connected.
+	LastSuccess time.Time `json:"last_success"`
+	// The number of failed attempts to connect to the peer since LastSuccess.
+	Failures int `json:"failures"`
+	// How long dialing the peer and completing the handshake took the last
+	// time we connected to it.
+	Latency time.Duration `json:"latency"`
+	// The version and the channels advertised by the peer.
+	Version  string            `json:"version"`
+	Channels cmtbytes.HexBytes `json:"channels"`
+	// Whether the seed hands out the address.
+	Healthy bool `json:"healthy"`
+}
+
+// healthy returns whether we connected to the peer recently, and did not fail
+// to since.
+func (cp *CrawledPeer) healthy(now time.Time) bool {
+	return !cp.LastSuccess.IsZero() && cp.Failures == 0 && now.Sub(cp.LastSuccess) < maxHealthyAddrAge
 }
 
 // crawlPeers will crawl the network looking for new peer addresses.
 func (r *Reactor) crawlPeers(addrs []*p2p.NetAddress) {
-	now := time.Now()
-
 	for _, addr := range addrs {
-		peerInfo, ok := r.crawlPeerInfos[addr.ID]
-
-		// Do not attempt to connect with peers we recently crawled.
-		if ok && now.Sub(peerInfo.LastCrawled) < minTimeBetweenCrawls {
+		if !r.recordCrawlAttempt(addr) {
 			continue
 		}
 
-		// Record crawling attempt.
-		r.crawlPeerInfos[addr.ID] = crawlPeerInfo{
-			Addr:        addr,
-			LastCrawled: now,
-		}
-
+		start := time.Now()
 		err := r.dialPeer(addr)
+		latency := time.Since(start)
 		if err != nil {
 			switch err.(type) {
-			case errMaxAttemptsToDial, errTooEarlyToDial, p2p.ErrCurrentlyDialingOrExistingAddress:
+			case errMaxAttemptsToDial, errTooEarlyToDial:
+				r.Logger.Debug(err.Error(), "addr", addr)
+			case p2p.ErrCurrentlyDialingOrExistingAddress:
 				r.Logger.Debug(err.Error(), "addr", addr)
+				if peer := r.Switch.Peers().Get(addr.ID); peer != nil {
+					r.recordCrawlSuccess(addr, peer, 0)
+				}
 			default:
 				r.Logger.Debug(err.Error(), "addr", addr)
+				r.recordCrawlFailure(addr)
 			}
 			continue
 		}
 
 		peer := r.Switch.Peers().Get(addr.ID)
 		if peer != nil {
+			r.recordCrawlSuccess(addr, peer, latency)
 			r.RequestAddrs(peer)
 		}
 	}
 }
 
+// recordCrawlAttempt records an attempt to crawl the address, unless it was
+// crawled recently, in which case it returns false.
+func (r *Reactor) recordCrawlAttempt(addr *p2p.NetAddress) bool {
+	r.crawlMtx.Lock()
+	defer r.crawlMtx.Unlock()
+
+	now := time.Now()
+	peerInfo, ok := r.crawlPeerInfos[addr.ID]
+
+	// Do not attempt to connect with peers we recently crawled.
+	if ok && now.Sub(peerInfo.LastCrawled) < minTimeBetweenCrawls {
+		return false
+	}
+
+	if !ok {
+		peerInfo = &CrawledPeer{}
+		r.crawlPeerInfos[addr.ID] = peerInfo
+	}
+	peerInfo.Addr = addr
+	peerInfo.LastCrawled = now
+	return true
+}
+
+// recordCrawlSuccess records that the peer at addr is connected. A latency of
+// 0 keeps the previous one, e.g. if we did not dial the peer.
+func (r *Reactor) recordCrawlSuccess(addr *p2p.NetAddress, peer Peer, latency time.Duration) {
+	r.crawlMtx.Lock()
+	defer r.crawlMtx.Unlock()
+
+	peerInfo, ok := r.crawlPeerInfos[addr.ID]
+	if !ok {
+		return
+	}
+	peerInfo.LastSuccess = time.Now()
+	peerInfo.Failures = 0
+	if latency > 0 {
+		peerInfo.Latency = latency
+	}
+	if nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok {
+		peerInfo.Version = nodeInfo.Version
+		peerInfo.Channels = nodeInfo.Channels
+	}
+}
+
+// recordCrawlFailure records a failed attempt to connect to the peer at addr.
+func (r *Reactor) recordCrawlFailure(addr *p2p.NetAddress) {
+	r.crawlMtx.Lock()
+	defer r.crawlMtx.Unlock()
+
+	if peerInfo, ok := r.crawlPeerInfos[addr.ID]; ok {
+		peerInfo.Failures++
+	}
+}
+
+// CrawledPeers returns what the seed learned about the addresses it crawled,
+// ordered by ID. It is empty unless the node runs in seed mode.
+func (r *Reactor) CrawledPeers() []CrawledPeer {
+	r.crawlMtx.Lock()
+	defer r.crawlMtx.Unlock()
+
+	now := time.Now()
+	peers := make([]CrawledPeer, 0, len(r.crawlPeerInfos))
+	for _, peerInfo := range r.crawlPeerInfos {
+		cp := *peerInfo
+		cp.Healthy = peerInfo.healthy(now)
+		peers = append(peers, cp)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].Addr.ID < peers[j].Addr.ID })
+	return peers
+}
+
+// seedSelection returns the addresses a seed hands out: a random selection of
+// the healthy addresses it crawled, or of the address book if there are none
+// yet, e.g. right after the seed started.
+func (r *Reactor) seedSelection() []*p2p.NetAddress {
+	r.crawlMtx.Lock()
+	now := time.Now()
+	healthy := make([]*p2p.NetAddress, 0)
+	for _, peerInfo := range r.crawlPeerInfos {
+		if peerInfo.healthy(now) {
+			healthy = append(healthy, peerInfo.Addr)
+		}
+	}
+	r.crawlMtx.Unlock()
+
+	if len(healthy) == 0 {
+		return r.book.GetSelectionWithBias(biasToSelectNewPeers)
+	}
+
+	perm := cmtrand.Perm(len(healthy))
+	selection := make([]*p2p.NetAddress, 0, cmtmath.MinInt(len(healthy), maxGetSelection))
+	for _, i := range perm[:cap(selection)] {
+		selection = append(selection, healthy[i])
+	}
+	return selection
+}
+
 func (r *Reactor) cleanupCrawlPeerInfos() {
+	r.crawlMtx.Lock()
+	defer r.crawlMtx.Unlock()
+
 	for id, info := range r.crawlPeerInfos {
 		// If we did not crawl a peer for 24 hours, it means the peer was removed
 		// from the addrbook => remove
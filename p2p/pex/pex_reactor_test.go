@@ -320,6 +320,16 @@ func TestPEXReactorSeedMode(t *testing.T) {
 	assert.Equal(t, 1, sw.Peers().Size())
 	assert.True(t, sw.Peers().Has(peerSwitch.NodeInfo().ID()))
 
+	crawled := pexR.CrawledPeers()
+	require.Len(t, crawled, 1)
+	assert.Equal(t, peerSwitch.NetAddress(), crawled[0].Addr)
+	assert.False(t, crawled[0].LastSuccess.IsZero())
+	assert.Zero(t, crawled[0].Failures)
+	assert.Positive(t, crawled[0].Latency)
+	assert.Equal(t, peerSwitch.NodeInfo().(p2p.DefaultNodeInfo).Version, crawled[0].Version)
+	assert.Equal(t, peerSwitch.NodeInfo().(p2p.DefaultNodeInfo).Channels, crawled[0].Channels)
+	assert.True(t, crawled[0].Healthy)
+
 	// 2. attemptDisconnects should not disconnect because of wait period
 	pexR.attemptDisconnects()
 	assert.Equal(t, 1, sw.Peers().Size())
@@ -398,6 +408,46 @@ func TestPEXReactorDialsPeerUpToMaxAttemptsInSeedMode(t *testing.T) {
 	assert.False(t, book.HasAddress(addr))
 }
 
+func TestPEXReactorSeedModeHandsOutHealthyAddrs(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{SeedMode: true})
+	defer teardownReactor(book)
+
+	size := 10
+	addrs := make([]*p2p.NetAddress, 0, size)
+	for i := 0; i < size; i++ {
+		_, addr := p2p.CreateRoutableAddr()
+		require.NoError(t, book.AddAddress(addr, addr))
+		addrs = append(addrs, addr)
+	}
+
+	// Without crawled addresses, the seed hands out the address book.
+	assert.NotEmpty(t, pexR.seedSelection())
+
+	// imitate failed attempts to crawl the peers
+	for _, addr := range addrs {
+		require.True(t, pexR.recordCrawlAttempt(addr))
+		require.False(t, pexR.recordCrawlAttempt(addr))
+		pexR.recordCrawlFailure(addr)
+	}
+	crawled := pexR.CrawledPeers()
+	require.Len(t, crawled, size)
+	for _, cp := range crawled {
+		assert.Equal(t, 1, cp.Failures)
+		assert.False(t, cp.Healthy)
+	}
+
+	// Only the peers which the seed connected to are handed out.
+	healthy := addrs[:3]
+	for _, addr := range healthy {
+		pexR.recordCrawlSuccess(addr, mock.NewPeer(nil), time.Millisecond)
+	}
+	assert.ElementsMatch(t, healthy, pexR.seedSelection())
+
+	// A failure makes the address unhealthy until the next success.
+	pexR.recordCrawlFailure(healthy[0])
+	assert.ElementsMatch(t, healthy[1:], pexR.seedSelection())
+}
+
 // connect a peer to a seed, wait a bit, then stop it.
 // this should give it time to request addrs and for the seed
 // to call FlushStop, and allows us to test calling Stop concurrently
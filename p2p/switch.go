@@ -39,6 +39,8 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	// Invalid rates are reported by cfg.ValidateBasic.
+	mConfig.ChannelSendRates, _ = cfg.ChannelSendRatesByID()
 	mConfig.TestFuzz = cfg.TestFuzz
 	mConfig.TestFuzzConfig = cfg.TestFuzzConfig
 	return mConfig
@@ -96,6 +98,9 @@ type Switch struct {
 
 	metrics *Metrics
 	mlc     *metricsLabelCache
+
+	peerScores     *PeerScores
+	peerAccessList *PeerAccessList
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -129,6 +134,8 @@ func NewSwitch(
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
 		mlc:                  newMetricsLabelCache(),
+		peerScores:           &PeerScores{peers: make(map[ID]*peerScore)},
+		peerAccessList:       &PeerAccessList{metrics: NopMetrics()},
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -158,6 +165,18 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// WithPeerScores sets the scores of the peers, which are saved when the switch
+// stops. By default, the scores are kept in memory and no peer is banned.
+func WithPeerScores(peerScores *PeerScores) SwitchOption {
+	return func(sw *Switch) { sw.peerScores = peerScores }
+}
+
+// WithPeerAccessList sets the access list rejecting the peers by ID or IP. By
+// default, all peers are accepted.
+func WithPeerAccessList(peerAccessList *PeerAccessList) SwitchOption {
+	return func(sw *Switch) { sw.peerAccessList = peerAccessList }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -260,6 +279,10 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if err := sw.peerScores.Save(); err != nil {
+		sw.Logger.Error("Failed to save peer scores", "err", err)
+	}
 }
 
 //---------------------------------------------------------------------
@@ -450,11 +473,69 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 }
 
 // MarkPeerAsGood marks the given peer as good when it did something useful
-// like contributed to consensus.
+// like contributed to consensus, which raises its score.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
+	sw.ReportPeerBehaviour(peer, UsefulMessage("marked as good"))
+}
+
+// ReportPeerBehaviour applies a behaviour of the peer, reported by a reactor,
+// to its score. The peer is disconnected if the behaviour requires it, and
+// banned for a while if its score drops to MinPeerScore. Persistent and
+// unconditional peers are never banned.
+func (sw *Switch) ReportPeerBehaviour(peer Peer, behaviour PeerBehaviour) {
+	canBan := !peer.IsPersistent() && !sw.IsPeerUnconditional(peer.ID())
+	score, banned := sw.peerScores.Report(peer.ID(), behaviour, canBan)
+	if behaviour.ScoreDelta < 0 {
+		sw.Logger.Debug("Peer misbehaved", "peer", peer.ID(), "score", score, "reason", behaviour.Reason)
+	}
+
+	if banned {
+		sw.Logger.Error("Banning peer", "peer", peer, "score", score, "reason", behaviour.Reason)
+		if err := sw.peerScores.Save(); err != nil {
+			sw.Logger.Error("Failed to save peer scores", "err", err)
+		}
+		sw.StopPeerForError(peer, behaviour.Reason)
+	} else if behaviour.Disconnect {
+		sw.StopPeerForError(peer, behaviour.Reason)
+	}
+}
+
+// PeerScore returns the score of the peer with the given ID.
+func (sw *Switch) PeerScore(id ID) int64 {
+	return sw.peerScores.Score(id)
+}
+
+// IsPeerBanned returns whether the peer with the given ID is banned.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	return sw.peerScores.IsBanned(id)
+}
+
+// BannedPeers returns the peers currently banned.
+func (sw *Switch) BannedPeers() []BannedPeer {
+	return sw.peerScores.Banned()
+}
+
+// PeerAccessRules returns the rules of the peer access list.
+func (sw *Switch) PeerAccessRules() PeerAccessRules {
+	return sw.peerAccessList.Rules()
+}
+
+// SetPeerAccessRules replaces the rules of the peer access list, and
+// disconnects from the peers which they do not accept.
+func (sw *Switch) SetPeerAccessRules(rules PeerAccessRules) error {
+	if err := sw.peerAccessList.SetRules(rules); err != nil {
+		return err
+	}
+	for _, peer := range sw.peers.List() {
+		if err := sw.peerAccessList.FilterPeer(peer); err != nil {
+			sw.Logger.Info("Disconnecting from peer rejected by the access rules", "peer", peer, "err", err)
+			sw.StopPeerForError(peer, err)
+		}
+	}
+	return nil
 }
 
 //---------------------------------------------------------------------
@@ -552,6 +633,9 @@ func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if sw.IsPeerBanned(addr.ID) {
+		return ErrRejected{id: addr.ID, isBanned: true}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -783,6 +867,12 @@ func (sw *Switch) filterPeer(p Peer) error {
 	if sw.peers.Has(p.ID()) {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
+	if sw.IsPeerBanned(p.ID()) {
+		return ErrRejected{id: p.ID(), isBanned: true}
+	}
+	if err := sw.peerAccessList.FilterPeer(p); err != nil {
+		return ErrRejected{id: p.ID(), err: err, isFiltered: true}
+	}
 
 	errc := make(chan error, len(sw.peerFilters))
 
@@ -465,6 +465,93 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchReportPeerBehaviour(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc, WithPeerScores(&PeerScores{
+		banDuration: time.Hour,
+		peers:       make(map[ID]*peerScore),
+	}))
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+	p := sw.Peers().Get(rp.ID())
+	require.NotNil(t, p)
+
+	sw.ReportPeerBehaviour(p, UsefulMessage("vote"))
+	assert.EqualValues(t, 1, sw.PeerScore(p.ID()))
+	assert.NotNil(t, sw.Peers().Get(rp.ID()))
+
+	// Bad messages disconnect the peer, until it is banned.
+	for i := 0; i < 5; i++ {
+		sw.ReportPeerBehaviour(p, BadMessage(errors.New("invalid")))
+		assert.Nil(t, sw.Peers().Get(rp.ID()))
+		if i < 4 {
+			assert.False(t, sw.IsPeerBanned(p.ID()))
+			err = sw.DialPeerWithAddress(rp.Addr())
+			require.NoError(t, err)
+			p = sw.Peers().Get(rp.ID())
+			require.NotNil(t, p)
+		}
+	}
+	assert.True(t, sw.IsPeerBanned(p.ID()))
+	assert.Len(t, sw.BannedPeers(), 1)
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.Error(t, err)
+	var rejected ErrRejected
+	require.ErrorAs(t, err, &rejected)
+	assert.True(t, rejected.IsBanned())
+}
+
+func TestSwitchPeerAccessRules(t *testing.T) {
+	peerAccessList, err := NewPeerAccessList(PeerAccessRules{}, NopMetrics())
+	require.NoError(t, err)
+	sw := MakeSwitch(cfg, 1, initSwitchFunc, WithPeerAccessList(peerAccessList))
+	err = sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+	require.NotNil(t, sw.Peers().Get(rp.ID()))
+
+	// The peers rejected by the new rules are disconnected.
+	rules := PeerAccessRules{DeniedIDs: []ID{rp.ID()}}
+	require.NoError(t, sw.SetPeerAccessRules(rules))
+	assert.Equal(t, rules, sw.PeerAccessRules())
+	assert.Nil(t, sw.Peers().Get(rp.ID()))
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	var rejected ErrRejected
+	require.ErrorAs(t, err, &rejected)
+	assert.True(t, rejected.IsFiltered())
+
+	require.Error(t, sw.SetPeerAccessRules(PeerAccessRules{DeniedCIDRs: []string{"invalid"}}))
+	assert.Equal(t, rules, sw.PeerAccessRules())
+
+	require.NoError(t, sw.SetPeerAccessRules(PeerAccessRules{AllowedCIDRs: []string{"127.0.0.0/8"}}))
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.NoError(t, err)
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, initSwitchFunc)
 	err := sw.Start()
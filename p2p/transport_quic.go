@@ -0,0 +1,377 @@
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+const (
+	// quicProtocol is the ALPN protocol negotiated by the QUIC connections.
+	quicProtocol = "cometbft-p2p"
+
+	// How long a peer which did not accept a QUIC connection is dialed over
+	// TCP, before QUIC is tried again.
+	defaultTCPFallbackDuration = 10 * time.Minute
+
+	// The maximum number of streams a peer can open, one per channel.
+	maxQUICStreams = 256
+)
+
+// QUICTransport accepts and dials QUIC connections over UDP, in addition to
+// the TCP connections of the MultiplexTransport it wraps, on the same port.
+// Peers are dialed over QUIC first, and over TCP if they do not accept QUIC
+// connections, so that nodes with and without QUIC can connect to each other.
+//
+// Peers authenticate with a self-signed TLS certificate of their node key,
+// which must be an ed25519 key. Each channel is mapped to its own QUIC stream,
+// so that a slow channel does not delay the others, see conn.QUICConnection.
+type QUICTransport struct {
+	*MultiplexTransport
+
+	listener   *quic.Listener
+	tlsConfig  *tls.Config
+	acceptc    chan accept
+	numInbound int32 // atomic.
+
+	tcpFallbackDuration time.Duration
+
+	mtx sync.Mutex
+	// The peers which did not accept a QUIC connection, dialed over TCP until
+	// the time they are mapped to.
+	tcpOnly map[ID]time.Time
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a transport accepting and dialing QUIC connections,
+// as well as the TCP connections of mt. The options of mt, such as its
+// connection filters, apply to both.
+func NewQUICTransport(mt *MultiplexTransport) (*QUICTransport, error) {
+	tlsConfig, err := quicTLSConfig(mt.nodeKey.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+	return &QUICTransport{
+		MultiplexTransport:  mt,
+		tlsConfig:           tlsConfig,
+		acceptc:             make(chan accept),
+		tcpFallbackDuration: defaultTCPFallbackDuration,
+		tcpOnly:             make(map[ID]time.Time),
+	}, nil
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	var a accept
+	select {
+	case a = <-qt.acceptc:
+	case a = <-qt.MultiplexTransport.acceptc:
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	cfg.outbound = false
+
+	return qt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+}
+
+// Dial implements Transport. The peer is dialed over TCP if it does not accept
+// QUIC connections.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	if qt.isTCPOnly(addr.ID) {
+		return qt.MultiplexTransport.Dial(addr, cfg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+	defer cancel()
+	qc, err := quic.DialAddr(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	if err != nil {
+		p, tcpErr := qt.MultiplexTransport.Dial(addr, cfg)
+		if tcpErr == nil {
+			qt.setTCPOnly(addr.ID)
+		}
+		return p, tcpErr
+	}
+
+	c, netAddr, nodeInfo, err := qt.upgrade(qc, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, netAddr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	err := qt.MultiplexTransport.Close()
+	if qt.listener != nil {
+		if qerr := qt.listener.Close(); err == nil {
+			err = qerr
+		}
+	}
+	return err
+}
+
+// Listen implements transportLifecycle. The QUIC listener is bound to the UDP
+// port of the TCP one.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	if err := qt.MultiplexTransport.Listen(addr); err != nil {
+		return err
+	}
+
+	ln, err := quic.ListenAddr(addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	if err != nil {
+		return fmt.Errorf("failed to listen for QUIC connections: %w", err)
+	}
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			select {
+			case qt.acceptc <- accept{err: err}:
+			case <-qt.closec:
+			}
+			return
+		}
+
+		if max := qt.maxIncomingConnections; max > 0 && atomic.LoadInt32(&qt.numInbound) >= int32(max) {
+			_ = qc.CloseWithError(0, "too many connections")
+			continue
+		}
+		atomic.AddInt32(&qt.numInbound, 1)
+		go func(qc quic.Connection) {
+			<-qc.Context().Done()
+			atomic.AddInt32(&qt.numInbound, -1)
+		}(qc)
+
+		// Connection upgrade and filtering should be asynchronous to avoid
+		// Head-of-line blocking, as for TCP connections.
+		go func(qc quic.Connection) {
+			c, netAddr, nodeInfo, err := qt.upgrade(qc, nil)
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = qc.CloseWithError(0, "")
+			}
+		}(qc)
+	}
+}
+
+// upgrade filters a QUIC connection, whose TLS handshake is completed, and
+// exchanges NodeInfo with the peer over the first stream of the connection.
+func (qt *QUICTransport) upgrade(
+	qc quic.Connection,
+	dialedAddr *NetAddress,
+) (*quicConn, *NetAddress, NodeInfo, error) {
+	c := &quicConn{conn: qc}
+	if err := qt.filterConn(c); err != nil {
+		return nil, nil, nil, err
+	}
+
+	netAddr, nodeInfo, err := qt.handshake(c, dialedAddr)
+	if err != nil {
+		_ = qt.cleanup(c)
+		return nil, nil, nil, err
+	}
+	return c, netAddr, nodeInfo, nil
+}
+
+func (qt *QUICTransport) handshake(c *quicConn, dialedAddr *NetAddress) (*NetAddress, NodeInfo, error) {
+	connID, err := quicPeerID(c.conn)
+	if err != nil {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("QUIC handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+	defer cancel()
+	if dialedAddr != nil {
+		c.stream, err = c.conn.OpenStreamSync(ctx)
+	} else {
+		c.stream, err = c.conn.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	nodeInfo, err := qt.authenticate(c, c, connID, dialedAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if dialedAddr != nil {
+		return dialedAddr, nodeInfo, nil
+	}
+	udpAddr, ok := c.RemoteAddr().(*net.UDPAddr)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected QUIC address %v", c.RemoteAddr())
+	}
+	netAddr := NewNetAddressIPPort(udpAddr.IP, uint16(udpAddr.Port))
+	netAddr.ID = connID
+	return netAddr, nodeInfo, nil
+}
+
+func (qt *QUICTransport) quicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout:  qt.handshakeTimeout,
+		KeepAlivePeriod:       qt.mConfig.PingInterval,
+		MaxIdleTimeout:        qt.mConfig.PingInterval + qt.mConfig.PongTimeout,
+		MaxIncomingUniStreams: maxQUICStreams,
+	}
+}
+
+func (qt *QUICTransport) isTCPOnly(id ID) bool {
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+	until, ok := qt.tcpOnly[id]
+	if ok && time.Now().After(until) {
+		delete(qt.tcpOnly, id)
+		return false
+	}
+	return ok
+}
+
+func (qt *QUICTransport) setTCPOnly(id ID) {
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+	now := time.Now()
+	for id, until := range qt.tcpOnly {
+		if now.After(until) {
+			delete(qt.tcpOnly, id)
+		}
+	}
+	qt.tcpOnly[id] = now.Add(qt.tcpFallbackDuration)
+}
+
+// quicConn is the net.Conn of a QUIC connection, reading and writing its
+// first stream, over which the peers exchange their NodeInfo. Closing it
+// closes the connection.
+type quicConn struct {
+	conn   quic.Connection
+	stream quic.Stream
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+func (c *quicConn) Read(b []byte) (int, error)  { return c.stream.Read(b) }
+func (c *quicConn) Write(b []byte) (int, error) { return c.stream.Write(b) }
+func (c *quicConn) Close() error                { return c.conn.CloseWithError(0, "") }
+func (c *quicConn) LocalAddr() net.Addr         { return c.conn.LocalAddr() }
+func (c *quicConn) RemoteAddr() net.Addr        { return c.conn.RemoteAddr() }
+
+func (c *quicConn) SetDeadline(t time.Time) error      { return c.stream.SetDeadline(t) }
+func (c *quicConn) SetReadDeadline(t time.Time) error  { return c.stream.SetReadDeadline(t) }
+func (c *quicConn) SetWriteDeadline(t time.Time) error { return c.stream.SetWriteDeadline(t) }
+
+// quicTLSConfig returns the TLS config of the QUIC connections, with a
+// self-signed certificate of the node key.
+func quicTLSConfig(privKey crypto.PrivKey) (*tls.Config, error) {
+	edKey, ok := privKey.(ed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("QUIC requires an %s node key, got %s", ed25519.KeyType, privKey.Type())
+	}
+	key := stded25519.PrivateKey(edKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the QUIC certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certDER}, PrivateKey: key}},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// The certificates are self-signed, and checked by
+		// verifyQUICCertificate instead.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: verifyQUICCertificate,
+		NextProtos:            []string{quicProtocol},
+		MinVersion:            tls.VersionTLS13,
+	}, nil
+}
+
+// verifyQUICCertificate checks that the peer presented a single certificate of
+// an ed25519 key, signed by this key. The ID of the key is checked against the
+// dialed one after the handshake.
+func verifyQUICCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return fmt.Errorf("expected 1 certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(stded25519.PublicKey); !ok {
+		return fmt.Errorf("expected an %s certificate, got %v", ed25519.KeyType, cert.PublicKeyAlgorithm)
+	}
+	return cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+}
+
+// quicPeerID returns the ID of the key of the peer certificate.
+func quicPeerID(qc quic.Connection) (ID, error) {
+	certs := qc.ConnectionState().TLS.PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	pubKey, ok := certs[0].PublicKey.(stded25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected an %s certificate, got %v", ed25519.KeyType, certs[0].PublicKeyAlgorithm)
+	}
+	return PubKeyToID(ed25519.PubKey(pubKey)), nil
+}
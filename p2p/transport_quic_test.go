@@ -0,0 +1,136 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+// makeQUICSwitch starts a switch like MakeSwitch does, whose transport accepts
+// and dials QUIC connections if useQUIC is set.
+func makeQUICSwitch(t *testing.T, i int, useQUIC bool) *Switch {
+	t.Helper()
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	nodeInfo := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i))
+	addr, err := NewNetAddressString(
+		IDAddressString(nodeKey.ID(), nodeInfo.(DefaultNodeInfo).ListenAddr),
+	)
+	require.NoError(t, err)
+
+	mt := NewMultiplexTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+	var transport interface {
+		Transport
+		transportLifecycle
+	} = mt
+	if useQUIC {
+		transport, err = NewQUICTransport(mt)
+		require.NoError(t, err)
+	}
+	require.NoError(t, transport.Listen(*addr))
+	t.Cleanup(func() { _ = transport.Close() })
+
+	sw := initSwitchFunc(i, NewSwitch(cfg, transport))
+	sw.SetLogger(log.TestingLogger().With("switch", i))
+	sw.SetNodeKey(&nodeKey)
+	ni := nodeInfo.(DefaultNodeInfo)
+	ni.Channels = nil
+	for ch := range sw.reactorsByCh {
+		ni.Channels = append(ni.Channels, ch)
+	}
+	mt.nodeInfo = ni
+	sw.SetNodeInfo(ni)
+
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return sw
+}
+
+// assertConnected checks that the switches are connected to each other, over
+// QUIC if overQUIC is set.
+func assertConnected(t *testing.T, s1, s2 *Switch, overQUIC bool) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return s1.Peers().Has(s2.NodeInfo().ID()) && s2.Peers().Has(s1.NodeInfo().ID())
+	}, 5*time.Second, 10*time.Millisecond)
+	for _, p := range []Peer{s1.Peers().Get(s2.NodeInfo().ID()), s2.Peers().Get(s1.NodeInfo().ID())} {
+		_, isQUIC := p.(*peer).mconn.(*conn.QUICConnection)
+		assert.Equal(t, overQUIC, isQUIC)
+	}
+
+	msg := &p2pproto.PexAddrs{Addrs: []p2pproto.NetAddress{{ID: "1"}}}
+	s1.Broadcast(Envelope{ChannelID: byte(0x00), Message: msg})
+	s2.Broadcast(Envelope{ChannelID: byte(0x02), Message: msg})
+	assertMsgReceived(t, msg, byte(0x00), s2.Reactor("foo").(*TestReactor))
+	assertMsgReceived(t, msg, byte(0x02), s1.Reactor("bar").(*TestReactor))
+}
+
+// assertMsgReceived waits until the reactor received a message on the channel,
+// and checks that it is the given one.
+func assertMsgReceived(t *testing.T, msg proto.Message, chID byte, reactor *TestReactor) {
+	t.Helper()
+	require.Eventually(t, func() bool { return len(reactor.getMsgs(chID)) > 0 },
+		5*time.Second, 10*time.Millisecond, "no message received on channel %X", chID)
+	assert.True(t, proto.Equal(msg, reactor.getMsgs(chID)[0].Contents))
+}
+
+func TestQUICTransport(t *testing.T) {
+	s1, s2 := makeQUICSwitch(t, 1, true), makeQUICSwitch(t, 2, true)
+
+	require.NoError(t, s1.DialPeerWithAddress(s2.NetAddress()))
+	assertConnected(t, s1, s2, true)
+
+	s1.StopPeerGracefully(s1.Peers().Get(s2.NodeInfo().ID()))
+	require.Eventually(t, func() bool { return s2.Peers().Size() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestQUICTransportTCPFallback(t *testing.T) {
+	s1, s2, s3 := makeQUICSwitch(t, 1, true), makeQUICSwitch(t, 2, false), makeQUICSwitch(t, 3, false)
+	qt := s1.transport.(*QUICTransport)
+
+	// s2 does not accept QUIC connections, so s1 dials it over TCP.
+	require.NoError(t, s1.DialPeerWithAddress(s2.NetAddress()))
+	assertConnected(t, s1, s2, false)
+	assert.True(t, qt.isTCPOnly(s2.NodeInfo().ID()))
+
+	// s1 accepts TCP connections.
+	require.NoError(t, s3.DialPeerWithAddress(s1.NetAddress()))
+	assertConnected(t, s3, s1, false)
+
+	qt.tcpFallbackDuration = 0
+	qt.setTCPOnly(s3.NodeInfo().ID())
+	assert.False(t, qt.isTCPOnly(s3.NodeInfo().ID()))
+}
+
+func TestQUICTransportRejectWrongID(t *testing.T) {
+	s1, s2 := makeQUICSwitch(t, 1, true), makeQUICSwitch(t, 2, true)
+
+	addr := s2.NetAddress()
+	addr.ID = PubKeyToID(ed25519.GenPrivKey().PubKey())
+	_, err := s1.transport.Dial(*addr, peerConfig{})
+	require.Error(t, err)
+	var rejected ErrRejected
+	require.ErrorAs(t, err, &rejected)
+	assert.True(t, rejected.IsAuthFailure())
+	assert.False(t, s1.transport.(*QUICTransport).isTCPOnly(addr.ID))
+}
+
+func TestQUICTransportRequiresEd25519(t *testing.T) {
+	pv := secp256k1.GenPrivKey()
+	mt := newMultiplexTransport(testNodeInfo(PubKeyToID(pv.PubKey()), "transport"), NodeKey{PrivKey: pv})
+	_, err := NewQUICTransport(mt)
+	require.Error(t, err)
+}
@@ -0,0 +1,2549 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/consensus_control/v1/consensus_control.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DialSeedsRequest struct {
+	// The seeds to dial, in the form "id@host:port".
+	Seeds []string `protobuf:"bytes,1,rep,name=seeds,proto3" json:"seeds,omitempty"`
+}
+
+func (m *DialSeedsRequest) Reset()         { *m = DialSeedsRequest{} }
+func (m *DialSeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*DialSeedsRequest) ProtoMessage()    {}
+func (*DialSeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{0}
+}
+func (m *DialSeedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DialSeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DialSeedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DialSeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialSeedsRequest.Merge(m, src)
+}
+func (m *DialSeedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DialSeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialSeedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialSeedsRequest proto.InternalMessageInfo
+
+func (m *DialSeedsRequest) GetSeeds() []string {
+	if m != nil {
+		return m.Seeds
+	}
+	return nil
+}
+
+type DialSeedsResponse struct {
+}
+
+func (m *DialSeedsResponse) Reset()         { *m = DialSeedsResponse{} }
+func (m *DialSeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*DialSeedsResponse) ProtoMessage()    {}
+func (*DialSeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{1}
+}
+func (m *DialSeedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DialSeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DialSeedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DialSeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialSeedsResponse.Merge(m, src)
+}
+func (m *DialSeedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DialSeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialSeedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialSeedsResponse proto.InternalMessageInfo
+
+type DialPeersRequest struct {
+	// The peers to dial, in the form "id@host:port".
+	Peers []string `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// Whether to add the peers to the set of persistent peers.
+	Persistent bool `protobuf:"varint,2,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	// Whether to add the peers to the set of unconditional peers.
+	Unconditional bool `protobuf:"varint,3,opt,name=unconditional,proto3" json:"unconditional,omitempty"`
+	// Whether to add the peers to the set of private peers.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (m *DialPeersRequest) Reset()         { *m = DialPeersRequest{} }
+func (m *DialPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*DialPeersRequest) ProtoMessage()    {}
+func (*DialPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{2}
+}
+func (m *DialPeersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DialPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DialPeersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DialPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialPeersRequest.Merge(m, src)
+}
+func (m *DialPeersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DialPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialPeersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialPeersRequest proto.InternalMessageInfo
+
+func (m *DialPeersRequest) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *DialPeersRequest) GetPersistent() bool {
+	if m != nil {
+		return m.Persistent
+	}
+	return false
+}
+
+func (m *DialPeersRequest) GetUnconditional() bool {
+	if m != nil {
+		return m.Unconditional
+	}
+	return false
+}
+
+func (m *DialPeersRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+type DialPeersResponse struct {
+}
+
+func (m *DialPeersResponse) Reset()         { *m = DialPeersResponse{} }
+func (m *DialPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*DialPeersResponse) ProtoMessage()    {}
+func (*DialPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{3}
+}
+func (m *DialPeersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DialPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DialPeersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DialPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DialPeersResponse.Merge(m, src)
+}
+func (m *DialPeersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DialPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DialPeersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DialPeersResponse proto.InternalMessageInfo
+
+type FlushMempoolRequest struct {
+}
+
+func (m *FlushMempoolRequest) Reset()         { *m = FlushMempoolRequest{} }
+func (m *FlushMempoolRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushMempoolRequest) ProtoMessage()    {}
+func (*FlushMempoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{4}
+}
+func (m *FlushMempoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushMempoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlushMempoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlushMempoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushMempoolRequest.Merge(m, src)
+}
+func (m *FlushMempoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushMempoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushMempoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushMempoolRequest proto.InternalMessageInfo
+
+type FlushMempoolResponse struct {
+}
+
+func (m *FlushMempoolResponse) Reset()         { *m = FlushMempoolResponse{} }
+func (m *FlushMempoolResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushMempoolResponse) ProtoMessage()    {}
+func (*FlushMempoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{5}
+}
+func (m *FlushMempoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlushMempoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlushMempoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlushMempoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushMempoolResponse.Merge(m, src)
+}
+func (m *FlushMempoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlushMempoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushMempoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlushMempoolResponse proto.InternalMessageInfo
+
+type DumpConsensusStateRequest struct {
+}
+
+func (m *DumpConsensusStateRequest) Reset()         { *m = DumpConsensusStateRequest{} }
+func (m *DumpConsensusStateRequest) String() string { return proto.CompactTextString(m) }
+func (*DumpConsensusStateRequest) ProtoMessage()    {}
+func (*DumpConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{6}
+}
+func (m *DumpConsensusStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DumpConsensusStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DumpConsensusStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DumpConsensusStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpConsensusStateRequest.Merge(m, src)
+}
+func (m *DumpConsensusStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DumpConsensusStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpConsensusStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DumpConsensusStateRequest proto.InternalMessageInfo
+
+type DumpConsensusStateResponse struct {
+	// The JSON-encoded round state of the node.
+	RoundState []byte `protobuf:"bytes,1,opt,name=round_state,json=roundState,proto3" json:"round_state,omitempty"`
+	// The consensus state of each of the node's peers.
+	Peers []*PeerConsensusState `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (m *DumpConsensusStateResponse) Reset()         { *m = DumpConsensusStateResponse{} }
+func (m *DumpConsensusStateResponse) String() string { return proto.CompactTextString(m) }
+func (*DumpConsensusStateResponse) ProtoMessage()    {}
+func (*DumpConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{7}
+}
+func (m *DumpConsensusStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DumpConsensusStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DumpConsensusStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DumpConsensusStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpConsensusStateResponse.Merge(m, src)
+}
+func (m *DumpConsensusStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DumpConsensusStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpConsensusStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DumpConsensusStateResponse proto.InternalMessageInfo
+
+func (m *DumpConsensusStateResponse) GetRoundState() []byte {
+	if m != nil {
+		return m.RoundState
+	}
+	return nil
+}
+
+func (m *DumpConsensusStateResponse) GetPeers() []*PeerConsensusState {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerConsensusState struct {
+	// The network address of the peer.
+	NodeAddress string `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	// The JSON-encoded consensus state of the peer.
+	PeerState []byte `protobuf:"bytes,2,opt,name=peer_state,json=peerState,proto3" json:"peer_state,omitempty"`
+}
+
+func (m *PeerConsensusState) Reset()         { *m = PeerConsensusState{} }
+func (m *PeerConsensusState) String() string { return proto.CompactTextString(m) }
+func (*PeerConsensusState) ProtoMessage()    {}
+func (*PeerConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{8}
+}
+func (m *PeerConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerConsensusState.Merge(m, src)
+}
+func (m *PeerConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerConsensusState proto.InternalMessageInfo
+
+func (m *PeerConsensusState) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return ""
+}
+
+func (m *PeerConsensusState) GetPeerState() []byte {
+	if m != nil {
+		return m.PeerState
+	}
+	return nil
+}
+
+type SetCreateEmptyBlocksRequest struct {
+	CreateEmptyBlocks bool `protobuf:"varint,1,opt,name=create_empty_blocks,json=createEmptyBlocks,proto3" json:"create_empty_blocks,omitempty"`
+}
+
+func (m *SetCreateEmptyBlocksRequest) Reset()         { *m = SetCreateEmptyBlocksRequest{} }
+func (m *SetCreateEmptyBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCreateEmptyBlocksRequest) ProtoMessage()    {}
+func (*SetCreateEmptyBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{9}
+}
+func (m *SetCreateEmptyBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCreateEmptyBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCreateEmptyBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCreateEmptyBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCreateEmptyBlocksRequest.Merge(m, src)
+}
+func (m *SetCreateEmptyBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCreateEmptyBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCreateEmptyBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCreateEmptyBlocksRequest proto.InternalMessageInfo
+
+func (m *SetCreateEmptyBlocksRequest) GetCreateEmptyBlocks() bool {
+	if m != nil {
+		return m.CreateEmptyBlocks
+	}
+	return false
+}
+
+type SetCreateEmptyBlocksResponse struct {
+}
+
+func (m *SetCreateEmptyBlocksResponse) Reset()         { *m = SetCreateEmptyBlocksResponse{} }
+func (m *SetCreateEmptyBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*SetCreateEmptyBlocksResponse) ProtoMessage()    {}
+func (*SetCreateEmptyBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{10}
+}
+func (m *SetCreateEmptyBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCreateEmptyBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetCreateEmptyBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetCreateEmptyBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCreateEmptyBlocksResponse.Merge(m, src)
+}
+func (m *SetCreateEmptyBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCreateEmptyBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCreateEmptyBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCreateEmptyBlocksResponse proto.InternalMessageInfo
+
+type GetCreateEmptyBlocksRequest struct {
+}
+
+func (m *GetCreateEmptyBlocksRequest) Reset()         { *m = GetCreateEmptyBlocksRequest{} }
+func (m *GetCreateEmptyBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCreateEmptyBlocksRequest) ProtoMessage()    {}
+func (*GetCreateEmptyBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{11}
+}
+func (m *GetCreateEmptyBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCreateEmptyBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCreateEmptyBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCreateEmptyBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreateEmptyBlocksRequest.Merge(m, src)
+}
+func (m *GetCreateEmptyBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCreateEmptyBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreateEmptyBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCreateEmptyBlocksRequest proto.InternalMessageInfo
+
+type GetCreateEmptyBlocksResponse struct {
+	CreateEmptyBlocks bool `protobuf:"varint,1,opt,name=create_empty_blocks,json=createEmptyBlocks,proto3" json:"create_empty_blocks,omitempty"`
+}
+
+func (m *GetCreateEmptyBlocksResponse) Reset()         { *m = GetCreateEmptyBlocksResponse{} }
+func (m *GetCreateEmptyBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCreateEmptyBlocksResponse) ProtoMessage()    {}
+func (*GetCreateEmptyBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{12}
+}
+func (m *GetCreateEmptyBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCreateEmptyBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCreateEmptyBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCreateEmptyBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreateEmptyBlocksResponse.Merge(m, src)
+}
+func (m *GetCreateEmptyBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCreateEmptyBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreateEmptyBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCreateEmptyBlocksResponse proto.InternalMessageInfo
+
+func (m *GetCreateEmptyBlocksResponse) GetCreateEmptyBlocks() bool {
+	if m != nil {
+		return m.CreateEmptyBlocks
+	}
+	return false
+}
+
+type SetLogLevelRequest struct {
+	// The log level, in the same format as the log_level configuration
+	// parameter, e.g. "info" or "consensus:debug,*:error".
+	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{13}
+}
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetLogLevel() string {
+	if m != nil {
+		return m.LogLevel
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
+}
+
+func (m *SetLogLevelResponse) Reset()         { *m = SetLogLevelResponse{} }
+func (m *SetLogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelResponse) ProtoMessage()    {}
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8bb283e896a2ee, []int{14}
+}
+func (m *SetLogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelResponse.Merge(m, src)
+}
+func (m *SetLogLevelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DialSeedsRequest)(nil), "tendermint.services.consensus_control.v1.DialSeedsRequest")
+	proto.RegisterType((*DialSeedsResponse)(nil), "tendermint.services.consensus_control.v1.DialSeedsResponse")
+	proto.RegisterType((*DialPeersRequest)(nil), "tendermint.services.consensus_control.v1.DialPeersRequest")
+	proto.RegisterType((*DialPeersResponse)(nil), "tendermint.services.consensus_control.v1.DialPeersResponse")
+	proto.RegisterType((*FlushMempoolRequest)(nil), "tendermint.services.consensus_control.v1.FlushMempoolRequest")
+	proto.RegisterType((*FlushMempoolResponse)(nil), "tendermint.services.consensus_control.v1.FlushMempoolResponse")
+	proto.RegisterType((*DumpConsensusStateRequest)(nil), "tendermint.services.consensus_control.v1.DumpConsensusStateRequest")
+	proto.RegisterType((*DumpConsensusStateResponse)(nil), "tendermint.services.consensus_control.v1.DumpConsensusStateResponse")
+	proto.RegisterType((*PeerConsensusState)(nil), "tendermint.services.consensus_control.v1.PeerConsensusState")
+	proto.RegisterType((*SetCreateEmptyBlocksRequest)(nil), "tendermint.services.consensus_control.v1.SetCreateEmptyBlocksRequest")
+	proto.RegisterType((*SetCreateEmptyBlocksResponse)(nil), "tendermint.services.consensus_control.v1.SetCreateEmptyBlocksResponse")
+	proto.RegisterType((*GetCreateEmptyBlocksRequest)(nil), "tendermint.services.consensus_control.v1.GetCreateEmptyBlocksRequest")
+	proto.RegisterType((*GetCreateEmptyBlocksResponse)(nil), "tendermint.services.consensus_control.v1.GetCreateEmptyBlocksResponse")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "tendermint.services.consensus_control.v1.SetLogLevelRequest")
+	proto.RegisterType((*SetLogLevelResponse)(nil), "tendermint.services.consensus_control.v1.SetLogLevelResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/consensus_control/v1/consensus_control.proto", fileDescriptor_3e8bb283e896a2ee)
+}
+
+var fileDescriptor_3e8bb283e896a2ee = []byte{
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0x13, 0x3e, 0x92, 0x49, 0x91, 0xe8, 0xa6, 0x20, 0x43, 0x5a, 0x13, 0x2c, 0x0e, 0x3e,
+	0xd9, 0x0a, 0x5c, 0x39, 0x40, 0x5b, 0xe8, 0x81, 0x16, 0x21, 0x47, 0xe2, 0xc0, 0xc5, 0x72, 0xec,
+	0x21, 0xb5, 0x58, 0xef, 0x9a, 0xdd, 0xb5, 0x25, 0xfe, 0x01, 0x47, 0xf8, 0x57, 0x1c, 0x7b, 0xe4,
+	0x88, 0x92, 0x3f, 0x82, 0x76, 0x6d, 0xd3, 0xa4, 0x29, 0xa8, 0xdc, 0x3c, 0x6f, 0xde, 0x7b, 0xf3,
+	0x3c, 0xbb, 0x0b, 0x2f, 0x14, 0xb2, 0x14, 0x45, 0x9e, 0x31, 0x15, 0x48, 0x14, 0x55, 0x96, 0xa0,
+	0x0c, 0x12, 0xce, 0x24, 0x32, 0x59, 0xca, 0x28, 0xe1, 0x4c, 0x09, 0x4e, 0x83, 0x6a, 0xb2, 0x09,
+	0xfa, 0x85, 0xe0, 0x8a, 0x13, 0xef, 0xc2, 0xc1, 0x6f, 0x1d, 0xfc, 0x4d, 0x72, 0x35, 0x71, 0x3d,
+	0xb8, 0x7b, 0x94, 0xc5, 0x74, 0x8a, 0x98, 0xca, 0x10, 0x3f, 0x97, 0x28, 0x15, 0xd9, 0x85, 0x9b,
+	0x52, 0xd7, 0xb6, 0x35, 0xee, 0x7a, 0xfd, 0xb0, 0x2e, 0xdc, 0x21, 0xec, 0xac, 0x30, 0x65, 0xa1,
+	0xdd, 0xdc, 0xaf, 0x56, 0xad, 0x7f, 0x87, 0x28, 0x56, 0xf5, 0x85, 0xae, 0x5b, 0xbd, 0x29, 0x88,
+	0x03, 0x50, 0xa0, 0x90, 0x99, 0x54, 0xc8, 0x94, 0xdd, 0x19, 0x5b, 0x5e, 0x2f, 0x5c, 0x41, 0xc8,
+	0x13, 0xb8, 0x53, 0xb2, 0x84, 0xb3, 0x34, 0x53, 0x19, 0x67, 0x31, 0xb5, 0xbb, 0x86, 0xb2, 0x0e,
+	0x12, 0x1b, 0x6e, 0x17, 0x22, 0xab, 0x62, 0x85, 0xf6, 0x0d, 0xd3, 0x6f, 0xcb, 0x36, 0x5f, 0x93,
+	0xa4, 0xc9, 0x77, 0x0f, 0x86, 0xaf, 0x69, 0x29, 0xcf, 0x4e, 0x31, 0x2f, 0x38, 0xa7, 0x4d, 0x42,
+	0xf7, 0x3e, 0xec, 0xae, 0xc3, 0x0d, 0x7d, 0x04, 0x0f, 0x8e, 0xca, 0xbc, 0x38, 0x6c, 0x37, 0x35,
+	0x55, 0xb1, 0xc2, 0x56, 0xf4, 0xdd, 0x82, 0x87, 0x57, 0x75, 0x6b, 0x2d, 0x79, 0x04, 0x03, 0xc1,
+	0x4b, 0x96, 0x46, 0x52, 0xc3, 0xb6, 0x35, 0xb6, 0xbc, 0xed, 0x10, 0x0c, 0x64, 0x88, 0x24, 0x6c,
+	0xd7, 0xd2, 0x19, 0x77, 0xbd, 0xc1, 0xd3, 0xe7, 0xfe, 0x75, 0x0f, 0xc9, 0xd7, 0xff, 0x74, 0x69,
+	0x6a, 0x6d, 0xe5, 0xbe, 0x07, 0xb2, 0xd9, 0x24, 0x8f, 0x61, 0x9b, 0xf1, 0x14, 0xa3, 0x38, 0x4d,
+	0x05, 0x4a, 0x69, 0xb2, 0xf4, 0xc3, 0x81, 0xc6, 0x5e, 0xd6, 0x10, 0xd9, 0xd7, 0xa7, 0x81, 0xa2,
+	0x09, 0xdb, 0x31, 0x61, 0xfb, 0x1a, 0x31, 0x0e, 0xee, 0x29, 0x8c, 0xa6, 0xa8, 0x0e, 0x05, 0xc6,
+	0x0a, 0x5f, 0xe5, 0x85, 0xfa, 0x72, 0x40, 0x79, 0xf2, 0xe9, 0xcf, 0x09, 0xfb, 0x30, 0x4c, 0x4c,
+	0x2f, 0x42, 0xdd, 0x8c, 0x66, 0xa6, 0x6b, 0xe6, 0xf4, 0xc2, 0x9d, 0xe4, 0xb2, 0xcc, 0x75, 0x60,
+	0xef, 0x6a, 0xbb, 0x66, 0xef, 0xfb, 0x30, 0x3a, 0xfe, 0xfb, 0x38, 0xf7, 0x2d, 0xec, 0x1d, 0xff,
+	0x43, 0xfe, 0xdf, 0x71, 0x26, 0x40, 0xa6, 0xa8, 0x4e, 0xf8, 0xfc, 0x04, 0x2b, 0x6c, 0x2f, 0x05,
+	0x19, 0x41, 0x9f, 0xf2, 0x79, 0x44, 0x35, 0xd6, 0xac, 0xac, 0x47, 0x1b, 0x8e, 0xbe, 0x48, 0x6b,
+	0x92, 0x7a, 0xf2, 0x01, 0xfe, 0x58, 0x38, 0xd6, 0xf9, 0xc2, 0xb1, 0x7e, 0x2d, 0x1c, 0xeb, 0xdb,
+	0xd2, 0xd9, 0x3a, 0x5f, 0x3a, 0x5b, 0x3f, 0x97, 0xce, 0xd6, 0x87, 0x37, 0xf3, 0x4c, 0x9d, 0x95,
+	0x33, 0x3f, 0xe1, 0x79, 0x90, 0xf0, 0x1c, 0xd5, 0xec, 0xa3, 0xba, 0xf8, 0x30, 0xcf, 0x34, 0xb8,
+	0xee, 0x3b, 0x9f, 0xdd, 0x32, 0xfc, 0x67, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x40, 0xa4, 0x35,
+	0x1e, 0x1a, 0x04, 0x00, 0x00,
+}
+
+func (m *DialSeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DialSeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DialSeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seeds) > 0 {
+		for iNdEx := len(m.Seeds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Seeds[iNdEx])
+			copy(dAtA[i:], m.Seeds[iNdEx])
+			i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.Seeds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DialSeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DialSeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DialSeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DialPeersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DialPeersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DialPeersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Private {
+		i--
+		if m.Private {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unconditional {
+		i--
+		if m.Unconditional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Persistent {
+		i--
+		if m.Persistent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peers[iNdEx])
+			copy(dAtA[i:], m.Peers[iNdEx])
+			i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.Peers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DialPeersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DialPeersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DialPeersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushMempoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushMempoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushMempoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushMempoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushMempoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushMempoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DumpConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DumpConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DumpConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DumpConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DumpConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DumpConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsensusControl(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RoundState) > 0 {
+		i -= len(m.RoundState)
+		copy(dAtA[i:], m.RoundState)
+		i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.RoundState)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerState) > 0 {
+		i -= len(m.PeerState)
+		copy(dAtA[i:], m.PeerState)
+		i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.PeerState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCreateEmptyBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCreateEmptyBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCreateEmptyBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreateEmptyBlocks {
+		i--
+		if m.CreateEmptyBlocks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCreateEmptyBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCreateEmptyBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCreateEmptyBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCreateEmptyBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreateEmptyBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreateEmptyBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCreateEmptyBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreateEmptyBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreateEmptyBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreateEmptyBlocks {
+		i--
+		if m.CreateEmptyBlocks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogLevel) > 0 {
+		i -= len(m.LogLevel)
+		copy(dAtA[i:], m.LogLevel)
+		i = encodeVarintConsensusControl(dAtA, i, uint64(len(m.LogLevel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsensusControl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsensusControl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DialSeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Seeds) > 0 {
+		for _, s := range m.Seeds {
+			l = len(s)
+			n += 1 + l + sovConsensusControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DialSeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DialPeersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, s := range m.Peers {
+			l = len(s)
+			n += 1 + l + sovConsensusControl(uint64(l))
+		}
+	}
+	if m.Persistent {
+		n += 2
+	}
+	if m.Unconditional {
+		n += 2
+	}
+	if m.Private {
+		n += 2
+	}
+	return n
+}
+
+func (m *DialPeersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FlushMempoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FlushMempoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DumpConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DumpConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoundState)
+	if l > 0 {
+		n += 1 + l + sovConsensusControl(uint64(l))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovConsensusControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeerConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovConsensusControl(uint64(l))
+	}
+	l = len(m.PeerState)
+	if l > 0 {
+		n += 1 + l + sovConsensusControl(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCreateEmptyBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateEmptyBlocks {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetCreateEmptyBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCreateEmptyBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCreateEmptyBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateEmptyBlocks {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogLevel)
+	if l > 0 {
+		n += 1 + l + sovConsensusControl(uint64(l))
+	}
+	return n
+}
+
+func (m *SetLogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovConsensusControl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsensusControl(x uint64) (n int) {
+	return sovConsensusControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DialSeedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DialSeedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DialSeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seeds = append(m.Seeds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DialSeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DialSeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DialSeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DialPeersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DialPeersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DialPeersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Persistent = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unconditional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unconditional = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Private", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Private = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DialPeersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DialPeersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DialPeersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushMempoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushMempoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushMempoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushMempoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushMempoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushMempoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DumpConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DumpConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DumpConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DumpConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DumpConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DumpConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundState = append(m.RoundState[:0], dAtA[iNdEx:postIndex]...)
+			if m.RoundState == nil {
+				m.RoundState = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerConsensusState{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerConsensusState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerConsensusState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerState = append(m.PeerState[:0], dAtA[iNdEx:postIndex]...)
+			if m.PeerState == nil {
+				m.PeerState = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCreateEmptyBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCreateEmptyBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCreateEmptyBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateEmptyBlocks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateEmptyBlocks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCreateEmptyBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCreateEmptyBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCreateEmptyBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreateEmptyBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreateEmptyBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreateEmptyBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreateEmptyBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreateEmptyBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreateEmptyBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateEmptyBlocks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateEmptyBlocks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConsensusControl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConsensusControl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensusControl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConsensusControl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConsensusControl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConsensusControl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConsensusControl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConsensusControl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConsensusControl = fmt.Errorf("proto: unexpected end of group")
+)
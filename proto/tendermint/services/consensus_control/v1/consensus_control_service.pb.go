@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/consensus_control/v1/consensus_control_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/consensus_control/v1/consensus_control_service.proto", fileDescriptor_d4cde132b5e563a5)
+}
+
+var fileDescriptor_d4cde132b5e563a5 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0x87, 0xbb, 0x17, 0xd1, 0xd5, 0xd3, 0x22, 0x08, 0x3d, 0xe4, 0xec, 0x69, 0x43, 0xf5, 0xa6,
+	0x56, 0xa4, 0xe9, 0x1f, 0xc1, 0x0a, 0x62, 0x6e, 0x5e, 0x4a, 0x9b, 0x8e, 0x6d, 0x30, 0x9b, 0x8d,
+	0xbb, 0x93, 0x80, 0x77, 0x4f, 0x82, 0xe0, 0x1b, 0x78, 0xf2, 0x15, 0x7c, 0x06, 0x8f, 0x3d, 0x7a,
+	0x94, 0xf6, 0x45, 0xc4, 0x26, 0xdb, 0x16, 0x2a, 0x92, 0xa6, 0xde, 0x42, 0x66, 0xbe, 0xdf, 0x7c,
+	0xc3, 0xc2, 0xd0, 0x73, 0x84, 0xb0, 0x0f, 0x4a, 0xf8, 0x21, 0xda, 0x1a, 0x54, 0xe2, 0x7b, 0xa0,
+	0x6d, 0x4f, 0x86, 0x1a, 0x42, 0x1d, 0xeb, 0x8e, 0x27, 0x43, 0x54, 0x32, 0xb0, 0x93, 0xca, 0xf2,
+	0xcf, 0x4e, 0xd6, 0xcf, 0x23, 0x25, 0x51, 0xb2, 0xfd, 0x79, 0x12, 0x37, 0x49, 0x7c, 0x09, 0xe2,
+	0x49, 0xa5, 0x7c, 0x56, 0x7c, 0x66, 0x3a, 0xeb, 0xe0, 0x7d, 0x93, 0xee, 0x39, 0xa6, 0xe6, 0xa4,
+	0x25, 0x37, 0x4d, 0x62, 0x8f, 0x84, 0x6e, 0xd5, 0xfd, 0x6e, 0xe0, 0x02, 0xf4, 0x35, 0x3b, 0xe2,
+	0x79, 0xb5, 0xf8, 0x0c, 0xba, 0x86, 0xfb, 0x18, 0x34, 0x96, 0x8f, 0x0b, 0xb1, 0x3a, 0xfa, 0x69,
+	0x98, 0x69, 0x5c, 0x01, 0xa8, 0x95, 0x35, 0xa6, 0x50, 0x41, 0x8d, 0x8c, 0xcd, 0x34, 0x9e, 0x09,
+	0xdd, 0x69, 0x06, 0xb1, 0x1e, 0x5e, 0x82, 0x88, 0xa4, 0x0c, 0x58, 0x35, 0x7f, 0xda, 0x22, 0x67,
+	0x64, 0x4e, 0x8b, 0xe2, 0x99, 0xcf, 0x2b, 0xa1, 0xac, 0x1e, 0x8b, 0x68, 0xf6, 0x7a, 0x2e, 0x76,
+	0x11, 0x98, 0xb3, 0xc2, 0x8e, 0x4b, 0xb4, 0x71, 0xab, 0xaf, 0x17, 0x92, 0x19, 0xbe, 0x11, 0xba,
+	0xeb, 0x02, 0x3a, 0x0a, 0xba, 0x08, 0x0d, 0x11, 0xe1, 0x43, 0x2d, 0x90, 0xde, 0x9d, 0x66, 0x8d,
+	0xfc, 0xf1, 0xbf, 0xf1, 0xc6, 0xb2, 0xb9, 0x6e, 0xcc, 0x82, 0x67, 0x6b, 0x4d, 0xcf, 0xd6, 0xff,
+	0x78, 0xb6, 0xfe, 0xf2, 0x7c, 0x22, 0x74, 0xdb, 0x05, 0x6c, 0xcb, 0x41, 0x1b, 0x12, 0x08, 0xd8,
+	0xc9, 0x4a, 0xfb, 0x1b, 0xcc, 0x58, 0x55, 0x0b, 0xd2, 0xa9, 0x4c, 0x0d, 0x3e, 0xc6, 0x16, 0x19,
+	0x8d, 0x2d, 0xf2, 0x35, 0xb6, 0xc8, 0xcb, 0xc4, 0x2a, 0x8d, 0x26, 0x56, 0xe9, 0x73, 0x62, 0x95,
+	0x6e, 0x2e, 0x06, 0x3e, 0x0e, 0xe3, 0x1e, 0xf7, 0xa4, 0xb0, 0x3d, 0x29, 0x00, 0x7b, 0xb7, 0x38,
+	0xff, 0x98, 0x9e, 0x1d, 0x3b, 0xef, 0xdd, 0xea, 0x6d, 0x4c, 0xfb, 0x0f, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x9e, 0xdf, 0xc1, 0x31, 0x5e, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ConsensusControlServiceClient is the client API for ConsensusControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ConsensusControlServiceClient interface {
+	// DialSeeds dials the given seeds asynchronously.
+	DialSeeds(ctx context.Context, in *DialSeedsRequest, opts ...grpc.CallOption) (*DialSeedsResponse, error)
+	// DialPeers dials the given peers asynchronously, optionally adding them to
+	// the sets of persistent, unconditional or private peers first.
+	DialPeers(ctx context.Context, in *DialPeersRequest, opts ...grpc.CallOption) (*DialPeersResponse, error)
+	// FlushMempool removes all the transactions from the mempool.
+	FlushMempool(ctx context.Context, in *FlushMempoolRequest, opts ...grpc.CallOption) (*FlushMempoolResponse, error)
+	// DumpConsensusState returns the full consensus state of the node and of
+	// its peers.
+	DumpConsensusState(ctx context.Context, in *DumpConsensusStateRequest, opts ...grpc.CallOption) (*DumpConsensusStateResponse, error)
+	// SetCreateEmptyBlocks changes whether the node creates empty blocks,
+	// overriding the create_empty_blocks configuration parameter until the
+	// node is restarted.
+	SetCreateEmptyBlocks(ctx context.Context, in *SetCreateEmptyBlocksRequest, opts ...grpc.CallOption) (*SetCreateEmptyBlocksResponse, error)
+	// GetCreateEmptyBlocks returns whether the node currently creates empty
+	// blocks.
+	GetCreateEmptyBlocks(ctx context.Context, in *GetCreateEmptyBlocksRequest, opts ...grpc.CallOption) (*GetCreateEmptyBlocksResponse, error)
+	// SetLogLevel changes the log level of the node, overriding the log_level
+	// configuration parameter until the node is restarted.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+}
+
+type consensusControlServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewConsensusControlServiceClient(cc grpc1.ClientConn) ConsensusControlServiceClient {
+	return &consensusControlServiceClient{cc}
+}
+
+func (c *consensusControlServiceClient) DialSeeds(ctx context.Context, in *DialSeedsRequest, opts ...grpc.CallOption) (*DialSeedsResponse, error) {
+	out := new(DialSeedsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/DialSeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) DialPeers(ctx context.Context, in *DialPeersRequest, opts ...grpc.CallOption) (*DialPeersResponse, error) {
+	out := new(DialPeersResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/DialPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) FlushMempool(ctx context.Context, in *FlushMempoolRequest, opts ...grpc.CallOption) (*FlushMempoolResponse, error) {
+	out := new(FlushMempoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/FlushMempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) DumpConsensusState(ctx context.Context, in *DumpConsensusStateRequest, opts ...grpc.CallOption) (*DumpConsensusStateResponse, error) {
+	out := new(DumpConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/DumpConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) SetCreateEmptyBlocks(ctx context.Context, in *SetCreateEmptyBlocksRequest, opts ...grpc.CallOption) (*SetCreateEmptyBlocksResponse, error) {
+	out := new(SetCreateEmptyBlocksResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/SetCreateEmptyBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) GetCreateEmptyBlocks(ctx context.Context, in *GetCreateEmptyBlocksRequest, opts ...grpc.CallOption) (*GetCreateEmptyBlocksResponse, error) {
+	out := new(GetCreateEmptyBlocksResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/GetCreateEmptyBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusControlServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.consensus_control.v1.ConsensusControlService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsensusControlServiceServer is the server API for ConsensusControlService service.
+type ConsensusControlServiceServer interface {
+	// DialSeeds dials the given seeds asynchronously.
+	DialSeeds(context.Context, *DialSeedsRequest) (*DialSeedsResponse, error)
+	// DialPeers dials the given peers asynchronously, optionally adding them to
+	// the sets of persistent, unconditional or private peers first.
+	DialPeers(context.Context, *DialPeersRequest) (*DialPeersResponse, error)
+	// FlushMempool removes all the transactions from the mempool.
+	FlushMempool(context.Context, *FlushMempoolRequest) (*FlushMempoolResponse, error)
+	// DumpConsensusState returns the full consensus state of the node and of
+	// its peers.
+	DumpConsensusState(context.Context, *DumpConsensusStateRequest) (*DumpConsensusStateResponse, error)
+	// SetCreateEmptyBlocks changes whether the node creates empty blocks,
+	// overriding the create_empty_blocks configuration parameter until the
+	// node is restarted.
+	SetCreateEmptyBlocks(context.Context, *SetCreateEmptyBlocksRequest) (*SetCreateEmptyBlocksResponse, error)
+	// GetCreateEmptyBlocks returns whether the node currently creates empty
+	// blocks.
+	GetCreateEmptyBlocks(context.Context, *GetCreateEmptyBlocksRequest) (*GetCreateEmptyBlocksResponse, error)
+	// SetLogLevel changes the log level of the node, overriding the log_level
+	// configuration parameter until the node is restarted.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+}
+
+// UnimplementedConsensusControlServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedConsensusControlServiceServer struct {
+}
+
+func (*UnimplementedConsensusControlServiceServer) DialSeeds(ctx context.Context, req *DialSeedsRequest) (*DialSeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DialSeeds not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) DialPeers(ctx context.Context, req *DialPeersRequest) (*DialPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DialPeers not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) FlushMempool(ctx context.Context, req *FlushMempoolRequest) (*FlushMempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushMempool not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) DumpConsensusState(ctx context.Context, req *DumpConsensusStateRequest) (*DumpConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpConsensusState not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) SetCreateEmptyBlocks(ctx context.Context, req *SetCreateEmptyBlocksRequest) (*SetCreateEmptyBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCreateEmptyBlocks not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) GetCreateEmptyBlocks(ctx context.Context, req *GetCreateEmptyBlocksRequest) (*GetCreateEmptyBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreateEmptyBlocks not implemented")
+}
+func (*UnimplementedConsensusControlServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+
+func RegisterConsensusControlServiceServer(s grpc1.Server, srv ConsensusControlServiceServer) {
+	s.RegisterService(&_ConsensusControlService_serviceDesc, srv)
+}
+
+func _ConsensusControlService_DialSeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DialSeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).DialSeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/DialSeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).DialSeeds(ctx, req.(*DialSeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_DialPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DialPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).DialPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/DialPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).DialPeers(ctx, req.(*DialPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_FlushMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushMempoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).FlushMempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/FlushMempool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).FlushMempool(ctx, req.(*FlushMempoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_DumpConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).DumpConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/DumpConsensusState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).DumpConsensusState(ctx, req.(*DumpConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_SetCreateEmptyBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCreateEmptyBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).SetCreateEmptyBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/SetCreateEmptyBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).SetCreateEmptyBlocks(ctx, req.(*SetCreateEmptyBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_GetCreateEmptyBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreateEmptyBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).GetCreateEmptyBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/GetCreateEmptyBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).GetCreateEmptyBlocks(ctx, req.(*GetCreateEmptyBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusControlService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusControlServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.consensus_control.v1.ConsensusControlService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusControlServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ConsensusControlService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.consensus_control.v1.ConsensusControlService",
+	HandlerType: (*ConsensusControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DialSeeds",
+			Handler:    _ConsensusControlService_DialSeeds_Handler,
+		},
+		{
+			MethodName: "DialPeers",
+			Handler:    _ConsensusControlService_DialPeers_Handler,
+		},
+		{
+			MethodName: "FlushMempool",
+			Handler:    _ConsensusControlService_FlushMempool_Handler,
+		},
+		{
+			MethodName: "DumpConsensusState",
+			Handler:    _ConsensusControlService_DumpConsensusState_Handler,
+		},
+		{
+			MethodName: "SetCreateEmptyBlocks",
+			Handler:    _ConsensusControlService_SetCreateEmptyBlocks_Handler,
+		},
+		{
+			MethodName: "GetCreateEmptyBlocks",
+			Handler:    _ConsensusControlService_GetCreateEmptyBlocks_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _ConsensusControlService_SetLogLevel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/services/consensus_control/v1/consensus_control_service.proto",
+}
@@ -0,0 +1,1417 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/data_companion/v1/data_companion.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamBlocksRequest is sent by the data companion on a StreamBlocks stream.
+// The first request must be a start request, and all the following ones acks.
+type StreamBlocksRequest struct {
+	// Types that are valid to be assigned to Request:
+	//	*StreamBlocksRequest_Start
+	//	*StreamBlocksRequest_Ack
+	Request isStreamBlocksRequest_Request `protobuf_oneof:"request"`
+}
+
+func (m *StreamBlocksRequest) Reset()         { *m = StreamBlocksRequest{} }
+func (m *StreamBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksRequest) ProtoMessage()    {}
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{0}
+}
+func (m *StreamBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksRequest.Merge(m, src)
+}
+func (m *StreamBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksRequest proto.InternalMessageInfo
+
+type isStreamBlocksRequest_Request interface {
+	isStreamBlocksRequest_Request()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamBlocksRequest_Start struct {
+	Start *StreamBlocksStart `protobuf:"bytes,1,opt,name=start,proto3,oneof" json:"start,omitempty"`
+}
+type StreamBlocksRequest_Ack struct {
+	Ack *StreamBlocksAck `protobuf:"bytes,2,opt,name=ack,proto3,oneof" json:"ack,omitempty"`
+}
+
+func (*StreamBlocksRequest_Start) isStreamBlocksRequest_Request() {}
+func (*StreamBlocksRequest_Ack) isStreamBlocksRequest_Request()   {}
+
+func (m *StreamBlocksRequest) GetRequest() isStreamBlocksRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *StreamBlocksRequest) GetStart() *StreamBlocksStart {
+	if x, ok := m.GetRequest().(*StreamBlocksRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (m *StreamBlocksRequest) GetAck() *StreamBlocksAck {
+	if x, ok := m.GetRequest().(*StreamBlocksRequest_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamBlocksRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamBlocksRequest_Start)(nil),
+		(*StreamBlocksRequest_Ack)(nil),
+	}
+}
+
+type StreamBlocksStart struct {
+	// The height of the first block to stream. If 0, the stream resumes after
+	// the last acknowledged block, or starts at the lowest available block if
+	// no block has been acknowledged yet.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Whether to advance the data companion block and block results retain
+	// heights to the height of the acknowledged blocks. Requires data companion
+	// pruning to be enabled on the node.
+	AdvanceRetainHeights bool `protobuf:"varint,2,opt,name=advance_retain_heights,json=advanceRetainHeights,proto3" json:"advance_retain_heights,omitempty"`
+}
+
+func (m *StreamBlocksStart) Reset()         { *m = StreamBlocksStart{} }
+func (m *StreamBlocksStart) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksStart) ProtoMessage()    {}
+func (*StreamBlocksStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{1}
+}
+func (m *StreamBlocksStart) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlocksStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlocksStart.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlocksStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksStart.Merge(m, src)
+}
+func (m *StreamBlocksStart) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlocksStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksStart proto.InternalMessageInfo
+
+func (m *StreamBlocksStart) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *StreamBlocksStart) GetAdvanceRetainHeights() bool {
+	if m != nil {
+		return m.AdvanceRetainHeights
+	}
+	return false
+}
+
+type StreamBlocksAck struct {
+	// The height of the last block processed by the data companion. All the
+	// blocks up to and including this height are acknowledged.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StreamBlocksAck) Reset()         { *m = StreamBlocksAck{} }
+func (m *StreamBlocksAck) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksAck) ProtoMessage()    {}
+func (*StreamBlocksAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{2}
+}
+func (m *StreamBlocksAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlocksAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlocksAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlocksAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksAck.Merge(m, src)
+}
+func (m *StreamBlocksAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlocksAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksAck proto.InternalMessageInfo
+
+func (m *StreamBlocksAck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StreamBlocksResponse contains a committed block, along with the response of
+// the application to its execution.
+type StreamBlocksResponse struct {
+	BlockId               *types.BlockID                `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block                 *types.Block                  `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	FinalizeBlockResponse *types1.ResponseFinalizeBlock `protobuf:"bytes,3,opt,name=finalize_block_response,json=finalizeBlockResponse,proto3" json:"finalize_block_response,omitempty"`
+}
+
+func (m *StreamBlocksResponse) Reset()         { *m = StreamBlocksResponse{} }
+func (m *StreamBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksResponse) ProtoMessage()    {}
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{3}
+}
+func (m *StreamBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksResponse.Merge(m, src)
+}
+func (m *StreamBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksResponse proto.InternalMessageInfo
+
+func (m *StreamBlocksResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *StreamBlocksResponse) GetBlock() *types.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *StreamBlocksResponse) GetFinalizeBlockResponse() *types1.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlockResponse
+	}
+	return nil
+}
+
+type GetCursorRequest struct {
+}
+
+func (m *GetCursorRequest) Reset()         { *m = GetCursorRequest{} }
+func (m *GetCursorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCursorRequest) ProtoMessage()    {}
+func (*GetCursorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{4}
+}
+func (m *GetCursorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCursorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCursorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCursorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCursorRequest.Merge(m, src)
+}
+func (m *GetCursorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCursorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCursorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCursorRequest proto.InternalMessageInfo
+
+type GetCursorResponse struct {
+	// The height of the last block acknowledged by the data companion, or 0 if
+	// no block has been acknowledged yet.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetCursorResponse) Reset()         { *m = GetCursorResponse{} }
+func (m *GetCursorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCursorResponse) ProtoMessage()    {}
+func (*GetCursorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c8180275a1308d9, []int{5}
+}
+func (m *GetCursorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCursorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCursorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCursorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCursorResponse.Merge(m, src)
+}
+func (m *GetCursorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCursorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCursorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCursorResponse proto.InternalMessageInfo
+
+func (m *GetCursorResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StreamBlocksRequest)(nil), "tendermint.services.data_companion.v1.StreamBlocksRequest")
+	proto.RegisterType((*StreamBlocksStart)(nil), "tendermint.services.data_companion.v1.StreamBlocksStart")
+	proto.RegisterType((*StreamBlocksAck)(nil), "tendermint.services.data_companion.v1.StreamBlocksAck")
+	proto.RegisterType((*StreamBlocksResponse)(nil), "tendermint.services.data_companion.v1.StreamBlocksResponse")
+	proto.RegisterType((*GetCursorRequest)(nil), "tendermint.services.data_companion.v1.GetCursorRequest")
+	proto.RegisterType((*GetCursorResponse)(nil), "tendermint.services.data_companion.v1.GetCursorResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/data_companion/v1/data_companion.proto", fileDescriptor_6c8180275a1308d9)
+}
+
+var fileDescriptor_6c8180275a1308d9 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x8b, 0xd4, 0x30,
+	0x14, 0x6f, 0x1d, 0xf6, 0x8f, 0x6f, 0x05, 0xdd, 0xb8, 0xee, 0x8e, 0xab, 0x14, 0x2d, 0x28, 0x8a,
+	0xd8, 0xb2, 0xba, 0x88, 0x78, 0x73, 0x14, 0xed, 0x7a, 0x92, 0xec, 0xcd, 0x83, 0x25, 0x4d, 0x33,
+	0x3b, 0x61, 0xa6, 0x49, 0x4d, 0x32, 0x05, 0xfd, 0x14, 0x7e, 0x22, 0xcf, 0x1e, 0xf7, 0x28, 0x78,
+	0x91, 0x99, 0x2f, 0x22, 0x4d, 0xb2, 0x6c, 0x67, 0x64, 0x60, 0xf0, 0xd6, 0xbc, 0xf7, 0xfb, 0xf3,
+	0xf2, 0xcb, 0x2b, 0xbc, 0x32, 0x4c, 0x94, 0x4c, 0x55, 0x5c, 0x98, 0x54, 0x33, 0xd5, 0x70, 0xca,
+	0x74, 0x5a, 0x12, 0x43, 0x72, 0x2a, 0xab, 0x9a, 0x08, 0x2e, 0x45, 0xda, 0x1c, 0x2d, 0x55, 0x92,
+	0x5a, 0x49, 0x23, 0xd1, 0x83, 0x4b, 0x6e, 0x72, 0xc1, 0x4d, 0x96, 0x90, 0xcd, 0xd1, 0xe1, 0x9d,
+	0x8e, 0x05, 0x29, 0x28, 0x4f, 0xcd, 0xd7, 0x9a, 0x69, 0xa7, 0x71, 0x78, 0xb7, 0xd3, 0xb4, 0xf5,
+	0xb4, 0x98, 0x48, 0x3a, 0x5e, 0xd9, 0xed, 0x70, 0xe3, 0x1f, 0x21, 0xdc, 0x3c, 0x35, 0x8a, 0x91,
+	0x6a, 0xd0, 0x72, 0x34, 0x66, 0x5f, 0xa6, 0x4c, 0x1b, 0xf4, 0x11, 0x36, 0xb4, 0x21, 0xca, 0xf4,
+	0xc3, 0x7b, 0xe1, 0xa3, 0x9d, 0x67, 0x2f, 0x93, 0xb5, 0xe6, 0x4c, 0xba, 0x52, 0xa7, 0x2d, 0x3f,
+	0x0b, 0xb0, 0x13, 0x42, 0x1f, 0xa0, 0x47, 0xe8, 0xb8, 0x7f, 0xc5, 0xea, 0xbd, 0xf8, 0x0f, 0xbd,
+	0xd7, 0x74, 0x9c, 0x05, 0xb8, 0x15, 0x19, 0x5c, 0x85, 0x2d, 0xe5, 0x06, 0x8d, 0x27, 0xb0, 0xfb,
+	0x8f, 0x29, 0xba, 0x0f, 0xd7, 0xac, 0x69, 0x3e, 0x62, 0xfc, 0x6c, 0xe4, 0x2e, 0xd1, 0xc3, 0x3b,
+	0xb6, 0x96, 0xd9, 0x12, 0x3a, 0x86, 0x7d, 0x52, 0x36, 0x44, 0x50, 0x96, 0x2b, 0x66, 0x08, 0x17,
+	0x1e, 0xab, 0xed, 0x84, 0xdb, 0x78, 0xcf, 0x77, 0xb1, 0x6d, 0x3a, 0x92, 0x8e, 0x1f, 0xc3, 0xf5,
+	0xa5, 0x91, 0xd0, 0x3e, 0x6c, 0x2e, 0xb8, 0xf8, 0x53, 0xfc, 0x3b, 0x84, 0xbd, 0xc5, 0x64, 0x75,
+	0x2d, 0x85, 0x66, 0xe8, 0x18, 0xb6, 0xed, 0xfb, 0xe4, 0xbc, 0xf4, 0xe9, 0xde, 0xee, 0xa6, 0xe1,
+	0x5e, 0xc7, 0x72, 0x4e, 0xde, 0xe2, 0x2d, 0x0b, 0x3d, 0x29, 0xd1, 0x53, 0xd8, 0xb0, 0x9f, 0x3e,
+	0xc0, 0x83, 0x15, 0x14, 0xec, 0x50, 0xe8, 0x33, 0x1c, 0x0c, 0xb9, 0x20, 0x13, 0xfe, 0x8d, 0xe5,
+	0xce, 0x4d, 0x79, 0xff, 0x7e, 0xcf, 0x0a, 0x3c, 0xec, 0x0a, 0xb4, 0x2b, 0x95, 0x5c, 0x0c, 0xf8,
+	0xce, 0xf3, 0x9c, 0xde, 0xad, 0xe1, 0xc2, 0xd1, 0x63, 0x62, 0x04, 0x37, 0xde, 0x33, 0xf3, 0x66,
+	0xaa, 0xb4, 0x54, 0x7e, 0x67, 0xe2, 0x27, 0xb0, 0xdb, 0xa9, 0xf9, 0xdb, 0xae, 0x88, 0x67, 0x50,
+	0xfc, 0x9c, 0x45, 0xe1, 0xf9, 0x2c, 0x0a, 0xff, 0xcc, 0xa2, 0xf0, 0xfb, 0x3c, 0x0a, 0xce, 0xe7,
+	0x51, 0xf0, 0x6b, 0x1e, 0x05, 0x9f, 0xb2, 0x33, 0x6e, 0x46, 0xd3, 0x22, 0xa1, 0xb2, 0x4a, 0xa9,
+	0xac, 0x98, 0x29, 0x86, 0xe6, 0xf2, 0xc3, 0xae, 0x6d, 0xba, 0xd6, 0x1f, 0x57, 0x6c, 0x5a, 0xf0,
+	0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc7, 0xf6, 0xe4, 0xa1, 0x03, 0x00, 0x00,
+}
+
+func (m *StreamBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size := m.Request.Size()
+			i -= size
+			if _, err := m.Request.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBlocksRequest_Start) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksRequest_Start) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataCompanion(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamBlocksRequest_Ack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksRequest_Ack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ack != nil {
+		{
+			size, err := m.Ack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataCompanion(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamBlocksStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlocksStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdvanceRetainHeights {
+		i--
+		if m.AdvanceRetainHeights {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDataCompanion(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBlocksAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlocksAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDataCompanion(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizeBlockResponse != nil {
+		{
+			size, err := m.FinalizeBlockResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataCompanion(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataCompanion(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDataCompanion(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCursorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCursorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCursorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCursorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCursorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCursorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDataCompanion(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDataCompanion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDataCompanion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		n += m.Request.Size()
+	}
+	return n
+}
+
+func (m *StreamBlocksRequest_Start) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovDataCompanion(uint64(l))
+	}
+	return n
+}
+func (m *StreamBlocksRequest_Ack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ack != nil {
+		l = m.Ack.Size()
+		n += 1 + l + sovDataCompanion(uint64(l))
+	}
+	return n
+}
+func (m *StreamBlocksStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovDataCompanion(uint64(m.StartHeight))
+	}
+	if m.AdvanceRetainHeights {
+		n += 2
+	}
+	return n
+}
+
+func (m *StreamBlocksAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDataCompanion(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *StreamBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovDataCompanion(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovDataCompanion(uint64(l))
+	}
+	if m.FinalizeBlockResponse != nil {
+		l = m.FinalizeBlockResponse.Size()
+		n += 1 + l + sovDataCompanion(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCursorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCursorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDataCompanion(uint64(m.Height))
+	}
+	return n
+}
+
+func sovDataCompanion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDataCompanion(x uint64) (n int) {
+	return sovDataCompanion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamBlocksStart{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &StreamBlocksRequest_Start{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StreamBlocksAck{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &StreamBlocksRequest_Ack{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBlocksStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlocksStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlocksStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvanceRetainHeights", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdvanceRetainHeights = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBlocksAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlocksAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlocksAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockResponse == nil {
+				m.FinalizeBlockResponse = &types1.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlockResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCursorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCursorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCursorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCursorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCursorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCursorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDataCompanion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDataCompanion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDataCompanion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDataCompanion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDataCompanion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDataCompanion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDataCompanion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDataCompanion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDataCompanion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDataCompanion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDataCompanion = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/data_companion/v1/data_companion_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/data_companion/v1/data_companion_service.proto", fileDescriptor_f80d6e527d0b2bf0)
+}
+
+var fileDescriptor_f80d6e527d0b2bf0 = []byte{
+	// 245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x2a, 0x49, 0xcd, 0x4b,
+	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6,
+	0x4f, 0x49, 0x2c, 0x49, 0x8c, 0x4f, 0xce, 0xcf, 0x2d, 0x48, 0xcc, 0xcb, 0xcc, 0xcf, 0xd3, 0x2f,
+	0x33, 0x44, 0x13, 0x89, 0x87, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x45, 0x98,
+	0xa1, 0x07, 0x33, 0x43, 0x0f, 0x55, 0x87, 0x5e, 0x99, 0xa1, 0x94, 0x15, 0x39, 0x56, 0x41, 0xac,
+	0x30, 0x5a, 0xc2, 0xc4, 0x25, 0xe2, 0x92, 0x58, 0x92, 0xe8, 0x0c, 0x13, 0x0f, 0x86, 0x18, 0x20,
+	0xd4, 0xcd, 0xc8, 0xc5, 0x13, 0x5c, 0x52, 0x94, 0x9a, 0x98, 0xeb, 0x94, 0x93, 0x9f, 0x9c, 0x5d,
+	0x2c, 0x64, 0xa5, 0x47, 0x94, 0x6b, 0xf4, 0x90, 0x35, 0x05, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97,
+	0x48, 0x59, 0x93, 0xa5, 0xb7, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x83, 0xd1, 0x80, 0x51, 0xa8,
+	0x8e, 0x8b, 0xd3, 0x3d, 0xb5, 0xc4, 0xb9, 0xb4, 0xa8, 0x38, 0xbf, 0x48, 0xc8, 0x9c, 0x48, 0xd3,
+	0xe0, 0x3a, 0x60, 0xce, 0xb0, 0x20, 0x5d, 0x23, 0xc4, 0x0d, 0x4e, 0x49, 0x27, 0x1e, 0xc9, 0x31,
+	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
+	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xe5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
+	0x9f, 0xab, 0x9f, 0x9c, 0x9f, 0x9b, 0x5a, 0x92, 0x94, 0x56, 0x82, 0x60, 0x80, 0x03, 0x59, 0x9f,
+	0xa8, 0xf8, 0x49, 0x62, 0x03, 0x2b, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x2c, 0x79,
+	0xa6, 0x3a, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCompanionServiceClient is the client API for DataCompanionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCompanionServiceClient interface {
+	// StreamBlocks streams the committed blocks in order, starting at the
+	// requested height, and then follows the chain as new blocks are committed.
+	//
+	// Delivery is at least once: the height of the last acknowledged block is
+	// persisted by the node, and a stream started without an explicit height
+	// resumes right after it. Only one stream can be open at a time.
+	StreamBlocks(ctx context.Context, opts ...grpc.CallOption) (DataCompanionService_StreamBlocksClient, error)
+	// GetCursor returns the height of the last block acknowledged by the data
+	// companion.
+	GetCursor(ctx context.Context, in *GetCursorRequest, opts ...grpc.CallOption) (*GetCursorResponse, error)
+}
+
+type dataCompanionServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDataCompanionServiceClient(cc grpc1.ClientConn) DataCompanionServiceClient {
+	return &dataCompanionServiceClient{cc}
+}
+
+func (c *dataCompanionServiceClient) StreamBlocks(ctx context.Context, opts ...grpc.CallOption) (DataCompanionService_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataCompanionService_serviceDesc.Streams[0], "/tendermint.services.data_companion.v1.DataCompanionService/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataCompanionServiceStreamBlocksClient{stream}
+	return x, nil
+}
+
+type DataCompanionService_StreamBlocksClient interface {
+	Send(*StreamBlocksRequest) error
+	Recv() (*StreamBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type dataCompanionServiceStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataCompanionServiceStreamBlocksClient) Send(m *StreamBlocksRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dataCompanionServiceStreamBlocksClient) Recv() (*StreamBlocksResponse, error) {
+	m := new(StreamBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataCompanionServiceClient) GetCursor(ctx context.Context, in *GetCursorRequest, opts ...grpc.CallOption) (*GetCursorResponse, error) {
+	out := new(GetCursorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.data_companion.v1.DataCompanionService/GetCursor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataCompanionServiceServer is the server API for DataCompanionService service.
+type DataCompanionServiceServer interface {
+	// StreamBlocks streams the committed blocks in order, starting at the
+	// requested height, and then follows the chain as new blocks are committed.
+	//
+	// Delivery is at least once: the height of the last acknowledged block is
+	// persisted by the node, and a stream started without an explicit height
+	// resumes right after it. Only one stream can be open at a time.
+	StreamBlocks(DataCompanionService_StreamBlocksServer) error
+	// GetCursor returns the height of the last block acknowledged by the data
+	// companion.
+	GetCursor(context.Context, *GetCursorRequest) (*GetCursorResponse, error)
+}
+
+// UnimplementedDataCompanionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDataCompanionServiceServer struct {
+}
+
+func (*UnimplementedDataCompanionServiceServer) StreamBlocks(srv DataCompanionService_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (*UnimplementedDataCompanionServiceServer) GetCursor(ctx context.Context, req *GetCursorRequest) (*GetCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCursor not implemented")
+}
+
+func RegisterDataCompanionServiceServer(s grpc1.Server, srv DataCompanionServiceServer) {
+	s.RegisterService(&_DataCompanionService_serviceDesc, srv)
+}
+
+func _DataCompanionService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataCompanionServiceServer).StreamBlocks(&dataCompanionServiceStreamBlocksServer{stream})
+}
+
+type DataCompanionService_StreamBlocksServer interface {
+	Send(*StreamBlocksResponse) error
+	Recv() (*StreamBlocksRequest, error)
+	grpc.ServerStream
+}
+
+type dataCompanionServiceStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataCompanionServiceStreamBlocksServer) Send(m *StreamBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dataCompanionServiceStreamBlocksServer) Recv() (*StreamBlocksRequest, error) {
+	m := new(StreamBlocksRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DataCompanionService_GetCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCompanionServiceServer).GetCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.data_companion.v1.DataCompanionService/GetCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCompanionServiceServer).GetCursor(ctx, req.(*GetCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DataCompanionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.data_companion.v1.DataCompanionService",
+	HandlerType: (*DataCompanionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCursor",
+			Handler:    _DataCompanionService_GetCursor_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _DataCompanionService_StreamBlocks_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "tendermint/services/data_companion/v1/data_companion_service.proto",
+}
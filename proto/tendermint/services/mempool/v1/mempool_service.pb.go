@@ -0,0 +1,371 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/mempool/v1/mempool_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/mempool/v1/mempool_service.proto", fileDescriptor_8da9a8f6a00981a4)
+}
+
+var fileDescriptor_8da9a8f6a00981a4 = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0xeb, 0xa1, 0x80, 0x8c, 0x84, 0x84, 0xc5, 0xd4, 0xc1, 0x4f, 0x50, 0xec, 0xfe, 0x30,
+	0x80, 0x60, 0x01, 0x84, 0x3a, 0xc1, 0x40, 0xcb, 0x02, 0x03, 0x52, 0xd2, 0x5b, 0x1a, 0xc0, 0x76,
+	0x88, 0x9d, 0x28, 0x8f, 0xc0, 0x08, 0x6f, 0xc5, 0xd8, 0x91, 0x11, 0x25, 0x1b, 0x4f, 0x81, 0x48,
+	0x6c, 0x55, 0x80, 0xd4, 0x9a, 0x6e, 0x56, 0xf2, 0x7d, 0xf7, 0x1c, 0x5b, 0xba, 0x78, 0xcf, 0x80,
+	0x1c, 0x43, 0x22, 0x22, 0x69, 0xb8, 0x86, 0x24, 0x8b, 0x42, 0xd0, 0x5c, 0x80, 0x88, 0x95, 0x7a,
+	0xe4, 0x59, 0xd7, 0x1d, 0x6f, 0xed, 0x3f, 0x16, 0x27, 0xca, 0x28, 0x42, 0xe7, 0x16, 0x73, 0x16,
+	0xb3, 0x28, 0xcb, 0xba, 0xad, 0xb6, 0xdf, 0xd4, 0x7a, 0x5a, 0xef, 0xb3, 0x89, 0xb7, 0xce, 0xeb,
+	0x2f, 0xc3, 0x1a, 0x26, 0x02, 0x6f, 0x0c, 0xd3, 0x40, 0x44, 0x66, 0x94, 0x13, 0xce, 0x16, 0xa7,
+	0x31, 0x47, 0x5e, 0xc2, 0x53, 0x0a, 0xda, 0xb4, 0x3a, 0xfe, 0x82, 0x8e, 0x95, 0xd4, 0x40, 0xee,
+	0xf1, 0xfa, 0xe9, 0x14, 0xc2, 0x87, 0x51, 0x4e, 0xd8, 0x32, 0xd9, 0x82, 0x2e, 0x8c, 0x7b, 0xf3,
+	0x36, 0x6b, 0x8c, 0x9b, 0x03, 0xf8, 0xbe, 0x57, 0x7b, 0x99, 0x59, 0x61, 0x2e, 0x67, 0xd7, 0x93,
+	0xb6, 0x29, 0xcf, 0x08, 0x6f, 0x0f, 0xc0, 0x5c, 0xc9, 0x50, 0xc9, 0x49, 0x94, 0x08, 0x18, 0x8f,
+	0x72, 0x4d, 0xf6, 0x3d, 0x86, 0xfc, 0x54, 0x5c, 0xfc, 0xc1, 0x0a, 0xa6, 0xad, 0xf2, 0x8a, 0xf0,
+	0xce, 0x00, 0xcc, 0x45, 0x2a, 0x7e, 0xb5, 0x39, 0xf4, 0x98, 0xf9, 0xc7, 0x72, 0x85, 0x8e, 0x56,
+	0x93, 0x6d, 0xa7, 0x1c, 0x6f, 0x56, 0xef, 0x75, 0x96, 0x81, 0x34, 0x9a, 0xf4, 0xbc, 0x1e, 0xb7,
+	0x86, 0x5d, 0x81, 0xfe, 0xbf, 0x9c, 0x3a, 0xb7, 0x83, 0x4e, 0x6e, 0xde, 0x0a, 0x8a, 0x66, 0x05,
+	0x45, 0x1f, 0x05, 0x45, 0x2f, 0x25, 0x6d, 0xcc, 0x4a, 0xda, 0x78, 0x2f, 0x69, 0xe3, 0xfa, 0xf8,
+	0x2e, 0x32, 0xd3, 0x34, 0x60, 0xa1, 0x12, 0x3c, 0x54, 0x02, 0x4c, 0x30, 0x31, 0xf3, 0x43, 0xb5,
+	0x2a, 0x7c, 0xf1, 0x5e, 0x05, 0x6b, 0x15, 0xd5, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xec, 0x6c,
+	0xdb, 0xde, 0xd6, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolServiceClient is the client API for MempoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolServiceClient interface {
+	// SubmitTx checks a transaction against the application and, if it is
+	// valid, adds it to the mempool to be gossiped to peers. It returns once
+	// the transaction has been checked, without waiting for it to be committed.
+	SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error)
+	// CheckTx checks a transaction against the application without adding it
+	// to the mempool.
+	CheckTx(ctx context.Context, in *CheckTxRequest, opts ...grpc.CallOption) (*CheckTxResponse, error)
+	// GetTx retrieves a transaction in the mempool by its hash.
+	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	// GetUnconfirmedTxs retrieves transactions in the mempool.
+	GetUnconfirmedTxs(ctx context.Context, in *GetUnconfirmedTxsRequest, opts ...grpc.CallOption) (*GetUnconfirmedTxsResponse, error)
+	// GetNumUnconfirmedTxs retrieves the number and total size of the
+	// transactions in the mempool.
+	GetNumUnconfirmedTxs(ctx context.Context, in *GetNumUnconfirmedTxsRequest, opts ...grpc.CallOption) (*GetNumUnconfirmedTxsResponse, error)
+	// GetTxEvents returns a stream of the transactions entering and leaving the
+	// mempool. This is a long-lived stream that is only terminated by the
+	// server if an error occurs. Events may be dropped if the caller does not
+	// keep up with them. The caller is expected to handle disconnections and
+	// automatically reconnect.
+	GetTxEvents(ctx context.Context, in *GetTxEventsRequest, opts ...grpc.CallOption) (MempoolService_GetTxEventsClient, error)
+}
+
+type mempoolServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolServiceClient(cc grpc1.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{cc}
+}
+
+func (c *mempoolServiceClient) SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error) {
+	out := new(SubmitTxResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.mempool.v1.MempoolService/SubmitTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) CheckTx(ctx context.Context, in *CheckTxRequest, opts ...grpc.CallOption) (*CheckTxResponse, error) {
+	out := new(CheckTxResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.mempool.v1.MempoolService/CheckTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
+	out := new(GetTxResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.mempool.v1.MempoolService/GetTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetUnconfirmedTxs(ctx context.Context, in *GetUnconfirmedTxsRequest, opts ...grpc.CallOption) (*GetUnconfirmedTxsResponse, error) {
+	out := new(GetUnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.mempool.v1.MempoolService/GetUnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetNumUnconfirmedTxs(ctx context.Context, in *GetNumUnconfirmedTxsRequest, opts ...grpc.CallOption) (*GetNumUnconfirmedTxsResponse, error) {
+	out := new(GetNumUnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.mempool.v1.MempoolService/GetNumUnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetTxEvents(ctx context.Context, in *GetTxEventsRequest, opts ...grpc.CallOption) (MempoolService_GetTxEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[0], "/tendermint.services.mempool.v1.MempoolService/GetTxEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceGetTxEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MempoolService_GetTxEventsClient interface {
+	Recv() (*GetTxEventsResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceGetTxEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceGetTxEventsClient) Recv() (*GetTxEventsResponse, error) {
+	m := new(GetTxEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MempoolServiceServer is the server API for MempoolService service.
+type MempoolServiceServer interface {
+	// SubmitTx checks a transaction against the application and, if it is
+	// valid, adds it to the mempool to be gossiped to peers. It returns once
+	// the transaction has been checked, without waiting for it to be committed.
+	SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error)
+	// CheckTx checks a transaction against the application without adding it
+	// to the mempool.
+	CheckTx(context.Context, *CheckTxRequest) (*CheckTxResponse, error)
+	// GetTx retrieves a transaction in the mempool by its hash.
+	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
+	// GetUnconfirmedTxs retrieves transactions in the mempool.
+	GetUnconfirmedTxs(context.Context, *GetUnconfirmedTxsRequest) (*GetUnconfirmedTxsResponse, error)
+	// GetNumUnconfirmedTxs retrieves the number and total size of the
+	// transactions in the mempool.
+	GetNumUnconfirmedTxs(context.Context, *GetNumUnconfirmedTxsRequest) (*GetNumUnconfirmedTxsResponse, error)
+	// GetTxEvents returns a stream of the transactions entering and leaving the
+	// mempool. This is a long-lived stream that is only terminated by the
+	// server if an error occurs. Events may be dropped if the caller does not
+	// keep up with them. The caller is expected to handle disconnections and
+	// automatically reconnect.
+	GetTxEvents(*GetTxEventsRequest, MempoolService_GetTxEventsServer) error
+}
+
+// UnimplementedMempoolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServiceServer struct {
+}
+
+func (*UnimplementedMempoolServiceServer) SubmitTx(ctx context.Context, req *SubmitTxRequest) (*SubmitTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) CheckTx(ctx context.Context, req *CheckTxRequest) (*CheckTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetUnconfirmedTxs(ctx context.Context, req *GetUnconfirmedTxsRequest) (*GetUnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedTxs not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetNumUnconfirmedTxs(ctx context.Context, req *GetNumUnconfirmedTxsRequest) (*GetNumUnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumUnconfirmedTxs not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetTxEvents(req *GetTxEventsRequest, srv MempoolService_GetTxEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTxEvents not implemented")
+}
+
+func RegisterMempoolServiceServer(s grpc1.Server, srv MempoolServiceServer) {
+	s.RegisterService(&_MempoolService_serviceDesc, srv)
+}
+
+func _MempoolService_SubmitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).SubmitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.mempool.v1.MempoolService/SubmitTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).SubmitTx(ctx, req.(*SubmitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).CheckTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.mempool.v1.MempoolService/CheckTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).CheckTx(ctx, req.(*CheckTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).GetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.mempool.v1.MempoolService/GetTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).GetTx(ctx, req.(*GetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetUnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).GetUnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.mempool.v1.MempoolService/GetUnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).GetUnconfirmedTxs(ctx, req.(*GetUnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetNumUnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNumUnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).GetNumUnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.mempool.v1.MempoolService/GetNumUnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).GetNumUnconfirmedTxs(ctx, req.(*GetNumUnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetTxEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTxEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServiceServer).GetTxEvents(m, &mempoolServiceGetTxEventsServer{stream})
+}
+
+type MempoolService_GetTxEventsServer interface {
+	Send(*GetTxEventsResponse) error
+	grpc.ServerStream
+}
+
+type mempoolServiceGetTxEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceGetTxEventsServer) Send(m *GetTxEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _MempoolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.mempool.v1.MempoolService",
+	HandlerType: (*MempoolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitTx",
+			Handler:    _MempoolService_SubmitTx_Handler,
+		},
+		{
+			MethodName: "CheckTx",
+			Handler:    _MempoolService_CheckTx_Handler,
+		},
+		{
+			MethodName: "GetTx",
+			Handler:    _MempoolService_GetTx_Handler,
+		},
+		{
+			MethodName: "GetUnconfirmedTxs",
+			Handler:    _MempoolService_GetUnconfirmedTxs_Handler,
+		},
+		{
+			MethodName: "GetNumUnconfirmedTxs",
+			Handler:    _MempoolService_GetNumUnconfirmedTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetTxEvents",
+			Handler:       _MempoolService_GetTxEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tendermint/services/mempool/v1/mempool_service.proto",
+}
@@ -0,0 +1,1080 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/peer_filter/v1/peer_filter.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetPeerFilterRequest struct {
+}
+
+func (m *GetPeerFilterRequest) Reset()         { *m = GetPeerFilterRequest{} }
+func (m *GetPeerFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPeerFilterRequest) ProtoMessage()    {}
+func (*GetPeerFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aaa9dd5e03c7ee0, []int{0}
+}
+func (m *GetPeerFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPeerFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPeerFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPeerFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPeerFilterRequest.Merge(m, src)
+}
+func (m *GetPeerFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPeerFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPeerFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPeerFilterRequest proto.InternalMessageInfo
+
+// GetPeerFilterResponse is the IP ranges and node IDs which peers are
+// accepted from.
+type GetPeerFilterResponse struct {
+	AllowedCidrs []string `protobuf:"bytes,1,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	DeniedCidrs  []string `protobuf:"bytes,2,rep,name=denied_cidrs,json=deniedCidrs,proto3" json:"denied_cidrs,omitempty"`
+	AllowedIds   []string `protobuf:"bytes,3,rep,name=allowed_ids,json=allowedIds,proto3" json:"allowed_ids,omitempty"`
+	DeniedIds    []string `protobuf:"bytes,4,rep,name=denied_ids,json=deniedIds,proto3" json:"denied_ids,omitempty"`
+}
+
+func (m *GetPeerFilterResponse) Reset()         { *m = GetPeerFilterResponse{} }
+func (m *GetPeerFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPeerFilterResponse) ProtoMessage()    {}
+func (*GetPeerFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aaa9dd5e03c7ee0, []int{1}
+}
+func (m *GetPeerFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPeerFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPeerFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPeerFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPeerFilterResponse.Merge(m, src)
+}
+func (m *GetPeerFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPeerFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPeerFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPeerFilterResponse proto.InternalMessageInfo
+
+func (m *GetPeerFilterResponse) GetAllowedCidrs() []string {
+	if m != nil {
+		return m.AllowedCidrs
+	}
+	return nil
+}
+
+func (m *GetPeerFilterResponse) GetDeniedCidrs() []string {
+	if m != nil {
+		return m.DeniedCidrs
+	}
+	return nil
+}
+
+func (m *GetPeerFilterResponse) GetAllowedIds() []string {
+	if m != nil {
+		return m.AllowedIds
+	}
+	return nil
+}
+
+func (m *GetPeerFilterResponse) GetDeniedIds() []string {
+	if m != nil {
+		return m.DeniedIds
+	}
+	return nil
+}
+
+// SetPeerFilterRequest replaces the IP ranges and node IDs which peers are
+// accepted from.
+type SetPeerFilterRequest struct {
+	// IPs and CIDR ranges, e.g. "10.0.0.0/8", of the peers accepted. If empty,
+	// peers with any IP but the denied ones are accepted.
+	AllowedCidrs []string `protobuf:"bytes,1,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	// IPs and CIDR ranges of the peers rejected, even if allowed.
+	DeniedCidrs []string `protobuf:"bytes,2,rep,name=denied_cidrs,json=deniedCidrs,proto3" json:"denied_cidrs,omitempty"`
+	// IDs of the peers accepted. If empty, all peers but the denied ones are
+	// accepted.
+	AllowedIds []string `protobuf:"bytes,3,rep,name=allowed_ids,json=allowedIds,proto3" json:"allowed_ids,omitempty"`
+	// IDs of the peers rejected, even if allowed.
+	DeniedIds []string `protobuf:"bytes,4,rep,name=denied_ids,json=deniedIds,proto3" json:"denied_ids,omitempty"`
+}
+
+func (m *SetPeerFilterRequest) Reset()         { *m = SetPeerFilterRequest{} }
+func (m *SetPeerFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPeerFilterRequest) ProtoMessage()    {}
+func (*SetPeerFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aaa9dd5e03c7ee0, []int{2}
+}
+func (m *SetPeerFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPeerFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPeerFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPeerFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPeerFilterRequest.Merge(m, src)
+}
+func (m *SetPeerFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPeerFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPeerFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPeerFilterRequest proto.InternalMessageInfo
+
+func (m *SetPeerFilterRequest) GetAllowedCidrs() []string {
+	if m != nil {
+		return m.AllowedCidrs
+	}
+	return nil
+}
+
+func (m *SetPeerFilterRequest) GetDeniedCidrs() []string {
+	if m != nil {
+		return m.DeniedCidrs
+	}
+	return nil
+}
+
+func (m *SetPeerFilterRequest) GetAllowedIds() []string {
+	if m != nil {
+		return m.AllowedIds
+	}
+	return nil
+}
+
+func (m *SetPeerFilterRequest) GetDeniedIds() []string {
+	if m != nil {
+		return m.DeniedIds
+	}
+	return nil
+}
+
+type SetPeerFilterResponse struct {
+}
+
+func (m *SetPeerFilterResponse) Reset()         { *m = SetPeerFilterResponse{} }
+func (m *SetPeerFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*SetPeerFilterResponse) ProtoMessage()    {}
+func (*SetPeerFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aaa9dd5e03c7ee0, []int{3}
+}
+func (m *SetPeerFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPeerFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPeerFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPeerFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPeerFilterResponse.Merge(m, src)
+}
+func (m *SetPeerFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPeerFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPeerFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPeerFilterResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GetPeerFilterRequest)(nil), "tendermint.services.peer_filter.v1.GetPeerFilterRequest")
+	proto.RegisterType((*GetPeerFilterResponse)(nil), "tendermint.services.peer_filter.v1.GetPeerFilterResponse")
+	proto.RegisterType((*SetPeerFilterRequest)(nil), "tendermint.services.peer_filter.v1.SetPeerFilterRequest")
+	proto.RegisterType((*SetPeerFilterResponse)(nil), "tendermint.services.peer_filter.v1.SetPeerFilterResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/peer_filter/v1/peer_filter.proto", fileDescriptor_3aaa9dd5e03c7ee0)
+}
+
+var fileDescriptor_3aaa9dd5e03c7ee0 = []byte{
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x29, 0x49, 0xcd, 0x4b,
+	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6,
+	0x2f, 0x48, 0x4d, 0x2d, 0x8a, 0x4f, 0xcb, 0xcc, 0x29, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe6,
+	0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x29, 0x21, 0x74, 0xe9, 0xc1, 0x74, 0xe9, 0x21, 0x2b,
+	0x2b, 0x33, 0x54, 0x12, 0xe3, 0x12, 0x71, 0x4f, 0x2d, 0x09, 0x48, 0x4d, 0x2d, 0x72, 0x03, 0x8b,
+	0x05, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0xcd, 0x67, 0xe4, 0x12, 0x45, 0x93, 0x28, 0x2e,
+	0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe6, 0xe2, 0x4d, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0x4d, 0x89,
+	0x4f, 0xce, 0x4c, 0x29, 0x2a, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0xe2, 0x81, 0x0a, 0x3a,
+	0x83, 0xc4, 0x84, 0x14, 0xb9, 0x78, 0x52, 0x52, 0xf3, 0x32, 0xe1, 0x6a, 0x98, 0xc0, 0x6a, 0xb8,
+	0x21, 0x62, 0x10, 0x25, 0xf2, 0x5c, 0xdc, 0x30, 0x73, 0x32, 0x53, 0x8a, 0x25, 0x98, 0xc1, 0x2a,
+	0xb8, 0xa0, 0x42, 0x9e, 0x29, 0xc5, 0x42, 0xb2, 0x5c, 0x5c, 0x50, 0x33, 0x40, 0xf2, 0x2c, 0x60,
+	0x79, 0x4e, 0x88, 0x88, 0x67, 0x4a, 0xb1, 0xd2, 0x3c, 0x46, 0x2e, 0x91, 0x60, 0x2c, 0x4e, 0x1f,
+	0x34, 0x0e, 0x14, 0xe7, 0x12, 0x0d, 0xc6, 0x16, 0x82, 0x4e, 0xf1, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
+	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xe5, 0x9a, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
+	0xab, 0x9f, 0x9c, 0x9f, 0x9b, 0x5a, 0x92, 0x94, 0x56, 0x82, 0x60, 0x80, 0x63, 0x55, 0x9f, 0x70,
+	0x52, 0x48, 0x62, 0x03, 0xab, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x23, 0x9a, 0xc3, 0x8c,
+	0x37, 0x02, 0x00, 0x00,
+}
+
+func (m *GetPeerFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPeerFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPeerFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPeerFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPeerFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPeerFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedIds) > 0 {
+		for iNdEx := len(m.DeniedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedIds[iNdEx])
+			copy(dAtA[i:], m.DeniedIds[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.DeniedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedIds) > 0 {
+		for iNdEx := len(m.AllowedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIds[iNdEx])
+			copy(dAtA[i:], m.AllowedIds[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.AllowedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeniedCidrs) > 0 {
+		for iNdEx := len(m.DeniedCidrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedCidrs[iNdEx])
+			copy(dAtA[i:], m.DeniedCidrs[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.DeniedCidrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedCidrs) > 0 {
+		for iNdEx := len(m.AllowedCidrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCidrs[iNdEx])
+			copy(dAtA[i:], m.AllowedCidrs[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.AllowedCidrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPeerFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPeerFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPeerFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedIds) > 0 {
+		for iNdEx := len(m.DeniedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedIds[iNdEx])
+			copy(dAtA[i:], m.DeniedIds[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.DeniedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedIds) > 0 {
+		for iNdEx := len(m.AllowedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIds[iNdEx])
+			copy(dAtA[i:], m.AllowedIds[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.AllowedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeniedCidrs) > 0 {
+		for iNdEx := len(m.DeniedCidrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedCidrs[iNdEx])
+			copy(dAtA[i:], m.DeniedCidrs[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.DeniedCidrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedCidrs) > 0 {
+		for iNdEx := len(m.AllowedCidrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCidrs[iNdEx])
+			copy(dAtA[i:], m.AllowedCidrs[iNdEx])
+			i = encodeVarintPeerFilter(dAtA, i, uint64(len(m.AllowedCidrs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPeerFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPeerFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPeerFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeerFilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeerFilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetPeerFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetPeerFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCidrs) > 0 {
+		for _, s := range m.AllowedCidrs {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.DeniedCidrs) > 0 {
+		for _, s := range m.DeniedCidrs {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.AllowedIds) > 0 {
+		for _, s := range m.AllowedIds {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.DeniedIds) > 0 {
+		for _, s := range m.DeniedIds {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetPeerFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCidrs) > 0 {
+		for _, s := range m.AllowedCidrs {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.DeniedCidrs) > 0 {
+		for _, s := range m.DeniedCidrs {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.AllowedIds) > 0 {
+		for _, s := range m.AllowedIds {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	if len(m.DeniedIds) > 0 {
+		for _, s := range m.DeniedIds {
+			l = len(s)
+			n += 1 + l + sovPeerFilter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetPeerFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPeerFilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeerFilter(x uint64) (n int) {
+	return sovPeerFilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetPeerFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerFilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPeerFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPeerFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerFilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPeerFilterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerFilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPeerFilterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPeerFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCidrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCidrs = append(m.AllowedCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCidrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedCidrs = append(m.DeniedCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIds = append(m.AllowedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedIds = append(m.DeniedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerFilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPeerFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerFilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPeerFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPeerFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCidrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCidrs = append(m.AllowedCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCidrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedCidrs = append(m.DeniedCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIds = append(m.AllowedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedIds = append(m.DeniedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerFilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPeerFilterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerFilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPeerFilterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPeerFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerFilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeerFilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeerFilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeerFilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerFilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeerFilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeerFilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeerFilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeerFilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeerFilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeerFilter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/peer_filter/v1/peer_filter_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/peer_filter/v1/peer_filter_service.proto", fileDescriptor_8144f4356db91cc9)
+}
+
+var fileDescriptor_8144f4356db91cc9 = []byte{
+	// 215 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x29, 0x49, 0xcd, 0x4b,
+	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6,
+	0x2f, 0x48, 0x4d, 0x2d, 0x8a, 0x4f, 0xcb, 0xcc, 0x29, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe6,
+	0xc6, 0x43, 0xd5, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x29, 0x21, 0x74, 0xeb, 0xc1, 0x74,
+	0xeb, 0x21, 0x29, 0xd7, 0x2b, 0x33, 0x94, 0x32, 0x21, 0xcd, 0x06, 0x88, 0xc9, 0x46, 0x0b, 0x99,
+	0xb8, 0x04, 0x03, 0x52, 0x53, 0x8b, 0xdc, 0xc0, 0x82, 0xc1, 0x10, 0x7d, 0x42, 0x2d, 0x8c, 0x5c,
+	0xbc, 0xee, 0xa9, 0x25, 0x08, 0x09, 0x21, 0x0b, 0x3d, 0xc2, 0x4e, 0xd0, 0x43, 0xd1, 0x12, 0x94,
+	0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0x22, 0x65, 0x49, 0x86, 0xce, 0xe2, 0x82, 0xfc, 0xbc, 0x62, 0x88,
+	0x33, 0x82, 0x49, 0x77, 0x46, 0x30, 0xd9, 0xce, 0x08, 0xc6, 0xe6, 0x0c, 0xa7, 0xf8, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
+	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x72, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
+	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0x41, 0x30, 0xc0, 0x21,
+	0xac, 0x4f, 0x38, 0x5a, 0x92, 0xd8, 0xc0, 0x2a, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x18,
+	0xe5, 0x42, 0x20, 0x25, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PeerFilterServiceClient is the client API for PeerFilterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PeerFilterServiceClient interface {
+	// GetPeerFilter returns the IP ranges and node IDs which peers are accepted
+	// from.
+	GetPeerFilter(ctx context.Context, in *GetPeerFilterRequest, opts ...grpc.CallOption) (*GetPeerFilterResponse, error)
+	// SetPeerFilter replaces the IP ranges and node IDs which peers are accepted
+	// from, and disconnects from the peers which are no longer accepted. The
+	// changes are lost when the node restarts.
+	SetPeerFilter(ctx context.Context, in *SetPeerFilterRequest, opts ...grpc.CallOption) (*SetPeerFilterResponse, error)
+}
+
+type peerFilterServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewPeerFilterServiceClient(cc grpc1.ClientConn) PeerFilterServiceClient {
+	return &peerFilterServiceClient{cc}
+}
+
+func (c *peerFilterServiceClient) GetPeerFilter(ctx context.Context, in *GetPeerFilterRequest, opts ...grpc.CallOption) (*GetPeerFilterResponse, error) {
+	out := new(GetPeerFilterResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.peer_filter.v1.PeerFilterService/GetPeerFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerFilterServiceClient) SetPeerFilter(ctx context.Context, in *SetPeerFilterRequest, opts ...grpc.CallOption) (*SetPeerFilterResponse, error) {
+	out := new(SetPeerFilterResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.peer_filter.v1.PeerFilterService/SetPeerFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeerFilterServiceServer is the server API for PeerFilterService service.
+type PeerFilterServiceServer interface {
+	// GetPeerFilter returns the IP ranges and node IDs which peers are accepted
+	// from.
+	GetPeerFilter(context.Context, *GetPeerFilterRequest) (*GetPeerFilterResponse, error)
+	// SetPeerFilter replaces the IP ranges and node IDs which peers are accepted
+	// from, and disconnects from the peers which are no longer accepted. The
+	// changes are lost when the node restarts.
+	SetPeerFilter(context.Context, *SetPeerFilterRequest) (*SetPeerFilterResponse, error)
+}
+
+// UnimplementedPeerFilterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPeerFilterServiceServer struct {
+}
+
+func (*UnimplementedPeerFilterServiceServer) GetPeerFilter(ctx context.Context, req *GetPeerFilterRequest) (*GetPeerFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerFilter not implemented")
+}
+func (*UnimplementedPeerFilterServiceServer) SetPeerFilter(ctx context.Context, req *SetPeerFilterRequest) (*SetPeerFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerFilter not implemented")
+}
+
+func RegisterPeerFilterServiceServer(s grpc1.Server, srv PeerFilterServiceServer) {
+	s.RegisterService(&_PeerFilterService_serviceDesc, srv)
+}
+
+func _PeerFilterService_GetPeerFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerFilterServiceServer).GetPeerFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.peer_filter.v1.PeerFilterService/GetPeerFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerFilterServiceServer).GetPeerFilter(ctx, req.(*GetPeerFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerFilterService_SetPeerFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerFilterServiceServer).SetPeerFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.peer_filter.v1.PeerFilterService/SetPeerFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerFilterServiceServer).SetPeerFilter(ctx, req.(*SetPeerFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PeerFilterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.peer_filter.v1.PeerFilterService",
+	HandlerType: (*PeerFilterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPeerFilter",
+			Handler:    _PeerFilterService_GetPeerFilter_Handler,
+		},
+		{
+			MethodName: "SetPeerFilter",
+			Handler:    _PeerFilterService_SetPeerFilter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/services/peer_filter/v1/peer_filter_service.proto",
+}
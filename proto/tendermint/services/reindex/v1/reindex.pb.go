@@ -0,0 +1,1258 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/reindex/v1/reindex.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReindexRequest requests the reindexing of a range of heights.
+type ReindexRequest struct {
+	// The first height to reindex. If 0, the base height of the block store.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The last height to reindex. If 0, the height of the latest state.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ReindexRequest) Reset()         { *m = ReindexRequest{} }
+func (m *ReindexRequest) String() string { return proto.CompactTextString(m) }
+func (*ReindexRequest) ProtoMessage()    {}
+func (*ReindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{0}
+}
+func (m *ReindexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReindexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReindexRequest.Merge(m, src)
+}
+func (m *ReindexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReindexRequest proto.InternalMessageInfo
+
+func (m *ReindexRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReindexRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// ReindexResponse is the range of heights being reindexed.
+type ReindexResponse struct {
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ReindexResponse) Reset()         { *m = ReindexResponse{} }
+func (m *ReindexResponse) String() string { return proto.CompactTextString(m) }
+func (*ReindexResponse) ProtoMessage()    {}
+func (*ReindexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{1}
+}
+func (m *ReindexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReindexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReindexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReindexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReindexResponse.Merge(m, src)
+}
+func (m *ReindexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReindexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReindexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReindexResponse proto.InternalMessageInfo
+
+func (m *ReindexResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ReindexResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+type GetReindexStatusRequest struct {
+}
+
+func (m *GetReindexStatusRequest) Reset()         { *m = GetReindexStatusRequest{} }
+func (m *GetReindexStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReindexStatusRequest) ProtoMessage()    {}
+func (*GetReindexStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{2}
+}
+func (m *GetReindexStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReindexStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReindexStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReindexStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReindexStatusRequest.Merge(m, src)
+}
+func (m *GetReindexStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReindexStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReindexStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReindexStatusRequest proto.InternalMessageInfo
+
+// GetReindexStatusResponse is the progress of the latest reindexing.
+type GetReindexStatusResponse struct {
+	// Whether the reindexing is still in progress.
+	Running     bool  `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// The last height reindexed, or start_height - 1 if none was.
+	LastHeight int64 `protobuf:"varint,4,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// The error which stopped the reindexing, if any.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *GetReindexStatusResponse) Reset()         { *m = GetReindexStatusResponse{} }
+func (m *GetReindexStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReindexStatusResponse) ProtoMessage()    {}
+func (*GetReindexStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{3}
+}
+func (m *GetReindexStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReindexStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReindexStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReindexStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReindexStatusResponse.Merge(m, src)
+}
+func (m *GetReindexStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReindexStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReindexStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReindexStatusResponse proto.InternalMessageInfo
+
+func (m *GetReindexStatusResponse) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *GetReindexStatusResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetReindexStatusResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetReindexStatusResponse) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *GetReindexStatusResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CancelReindexRequest struct {
+}
+
+func (m *CancelReindexRequest) Reset()         { *m = CancelReindexRequest{} }
+func (m *CancelReindexRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelReindexRequest) ProtoMessage()    {}
+func (*CancelReindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{4}
+}
+func (m *CancelReindexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelReindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelReindexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelReindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelReindexRequest.Merge(m, src)
+}
+func (m *CancelReindexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelReindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelReindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelReindexRequest proto.InternalMessageInfo
+
+type CancelReindexResponse struct {
+	// Whether a reindexing was in progress.
+	Canceled bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+}
+
+func (m *CancelReindexResponse) Reset()         { *m = CancelReindexResponse{} }
+func (m *CancelReindexResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelReindexResponse) ProtoMessage()    {}
+func (*CancelReindexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a74a00f6a66b0b8c, []int{5}
+}
+func (m *CancelReindexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelReindexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelReindexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelReindexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelReindexResponse.Merge(m, src)
+}
+func (m *CancelReindexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelReindexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelReindexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelReindexResponse proto.InternalMessageInfo
+
+func (m *CancelReindexResponse) GetCanceled() bool {
+	if m != nil {
+		return m.Canceled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ReindexRequest)(nil), "tendermint.services.reindex.v1.ReindexRequest")
+	proto.RegisterType((*ReindexResponse)(nil), "tendermint.services.reindex.v1.ReindexResponse")
+	proto.RegisterType((*GetReindexStatusRequest)(nil), "tendermint.services.reindex.v1.GetReindexStatusRequest")
+	proto.RegisterType((*GetReindexStatusResponse)(nil), "tendermint.services.reindex.v1.GetReindexStatusResponse")
+	proto.RegisterType((*CancelReindexRequest)(nil), "tendermint.services.reindex.v1.CancelReindexRequest")
+	proto.RegisterType((*CancelReindexResponse)(nil), "tendermint.services.reindex.v1.CancelReindexResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/reindex/v1/reindex.proto", fileDescriptor_a74a00f6a66b0b8c)
+}
+
+var fileDescriptor_a74a00f6a66b0b8c = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x4e, 0x3a, 0x41,
+	0x10, 0x66, 0xe1, 0xc7, 0x4f, 0x18, 0x8c, 0x26, 0x17, 0xd4, 0xd3, 0xc4, 0x15, 0xaf, 0xa2, 0x30,
+	0x77, 0x21, 0x3c, 0x81, 0x5a, 0x68, 0x7d, 0x74, 0x5a, 0x98, 0xe3, 0x6e, 0x84, 0x4d, 0x60, 0x17,
+	0x77, 0xe7, 0x88, 0x8f, 0xe1, 0x8b, 0xf8, 0x1e, 0x96, 0x94, 0x96, 0x06, 0x5e, 0xc4, 0xb8, 0xec,
+	0x41, 0x84, 0x44, 0x1b, 0xbb, 0xfd, 0xfe, 0xe4, 0x9b, 0x6f, 0x36, 0x03, 0x17, 0x84, 0x32, 0x43,
+	0x3d, 0x16, 0x92, 0x22, 0x83, 0x7a, 0x2a, 0x52, 0x34, 0x91, 0x46, 0x21, 0x33, 0x7c, 0x8e, 0xa6,
+	0x9d, 0xe2, 0x19, 0x4e, 0xb4, 0x22, 0xe5, 0xf1, 0xb5, 0x3b, 0x2c, 0xdc, 0x61, 0x61, 0x99, 0x76,
+	0x82, 0x18, 0xf6, 0xe2, 0x25, 0x8a, 0xf1, 0x29, 0x47, 0x43, 0xde, 0x39, 0xec, 0x1a, 0x4a, 0x34,
+	0x3d, 0x0c, 0x51, 0x0c, 0x86, 0xe4, 0xb3, 0x16, 0x6b, 0x57, 0xe2, 0x86, 0xe5, 0x6e, 0x2d, 0xe5,
+	0x9d, 0x02, 0xa0, 0xcc, 0x0a, 0x43, 0xd9, 0x1a, 0xea, 0x28, 0xb3, 0xa5, 0x1c, 0xf4, 0x60, 0x7f,
+	0x95, 0x69, 0x26, 0x4a, 0x1a, 0xfc, 0x83, 0xd0, 0x63, 0x38, 0xba, 0x41, 0x72, 0xb9, 0x3d, 0x4a,
+	0x28, 0x37, 0xae, 0x71, 0xf0, 0xca, 0xc0, 0xdf, 0xd6, 0xdc, 0x64, 0x1f, 0x76, 0x74, 0x2e, 0xa5,
+	0x90, 0x03, 0x3b, 0xb4, 0x16, 0x17, 0x70, 0xab, 0x53, 0xf9, 0xb7, 0x4e, 0x95, 0x8d, 0x4e, 0xde,
+	0x19, 0x34, 0x46, 0x89, 0x59, 0x05, 0xfc, 0xb3, 0x3a, 0x7c, 0x51, 0xce, 0xd0, 0x84, 0x2a, 0x6a,
+	0xad, 0xb4, 0x5f, 0x6d, 0xb1, 0x76, 0x3d, 0x5e, 0x82, 0xe0, 0x10, 0x9a, 0xd7, 0x89, 0x4c, 0x71,
+	0xf4, 0xfd, 0xe7, 0x83, 0x2e, 0x1c, 0x6c, 0xf0, 0x6e, 0x87, 0x13, 0xa8, 0xa5, 0x56, 0xc0, 0xcc,
+	0x2d, 0xb1, 0xc2, 0x57, 0xf7, 0x6f, 0x73, 0xce, 0x66, 0x73, 0xce, 0x3e, 0xe6, 0x9c, 0xbd, 0x2c,
+	0x78, 0x69, 0xb6, 0xe0, 0xa5, 0xf7, 0x05, 0x2f, 0xdd, 0x5d, 0x0e, 0x04, 0x0d, 0xf3, 0x7e, 0x98,
+	0xaa, 0x71, 0x94, 0xaa, 0x31, 0x52, 0xff, 0x91, 0xd6, 0x0f, 0x7b, 0x1e, 0xd1, 0xcf, 0xb7, 0xd4,
+	0xff, 0x6f, 0x5d, 0xdd, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x13, 0xb2, 0x52, 0x74, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ReindexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReindexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReindexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReindexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReindexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReindexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReindexStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReindexStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReindexStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReindexStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReindexStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReindexStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintReindex(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintReindex(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelReindexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelReindexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelReindexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelReindexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelReindexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelReindexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Canceled {
+		i--
+		if m.Canceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReindex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReindex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReindexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovReindex(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovReindex(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *ReindexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovReindex(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovReindex(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *GetReindexStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetReindexStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running {
+		n += 2
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovReindex(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovReindex(uint64(m.EndHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovReindex(uint64(m.LastHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovReindex(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelReindexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelReindexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Canceled {
+		n += 2
+	}
+	return n
+}
+
+func sovReindex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReindex(x uint64) (n int) {
+	return sovReindex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReindexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReindexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReindexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReindexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReindexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReindexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReindexStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReindexStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReindexStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReindexStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReindexStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReindexStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Running = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReindex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelReindexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelReindexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelReindexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelReindexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelReindexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelReindexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canceled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReindex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReindex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReindex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReindex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReindex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReindex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReindex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReindex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReindex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReindex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReindex = fmt.Errorf("proto: unexpected end of group")
+)
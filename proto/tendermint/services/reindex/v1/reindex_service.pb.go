@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/reindex/v1/reindex_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/reindex/v1/reindex_service.proto", fileDescriptor_58e802d2d63b3165)
+}
+
+var fileDescriptor_58e802d2d63b3165 = []byte{
+	// 244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x29, 0x49, 0xcd, 0x4b,
+	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6,
+	0x2f, 0x4a, 0xcd, 0xcc, 0x4b, 0x49, 0xad, 0xd0, 0x2f, 0x33, 0x84, 0x31, 0xe3, 0xa1, 0x72, 0x7a,
+	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x72, 0x08, 0x5d, 0x7a, 0x30, 0x5d, 0x7a, 0x50, 0xa5, 0x7a,
+	0x65, 0x86, 0x52, 0x3a, 0xc4, 0x99, 0x0a, 0x31, 0xcd, 0xa8, 0x89, 0x99, 0x8b, 0x2f, 0x08, 0x22,
+	0x12, 0x0c, 0x51, 0x2c, 0x94, 0xc5, 0xc5, 0x0e, 0x15, 0x11, 0xd2, 0xd3, 0xc3, 0x6f, 0x99, 0x1e,
+	0x54, 0x61, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x94, 0x3e, 0xd1, 0xea, 0x8b, 0x0b, 0xf2,
+	0xf3, 0x8a, 0x53, 0x85, 0x5a, 0x19, 0xb9, 0x04, 0xdc, 0x53, 0x4b, 0x60, 0x2e, 0x28, 0x49, 0x2c,
+	0x29, 0x2d, 0x16, 0x32, 0x27, 0x64, 0x0a, 0xba, 0x0e, 0x98, 0xf5, 0x16, 0xa4, 0x6b, 0x84, 0xba,
+	0xa3, 0x86, 0x8b, 0xd7, 0x39, 0x31, 0x2f, 0x39, 0x35, 0x07, 0xe6, 0x73, 0x13, 0x42, 0x46, 0xa1,
+	0x28, 0x87, 0x39, 0xc0, 0x94, 0x44, 0x5d, 0x10, 0xdb, 0x9d, 0xa2, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
+	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
+	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x31, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
+	0x57, 0x3f, 0x39, 0x3f, 0x37, 0xb5, 0x24, 0x29, 0xad, 0x04, 0xc1, 0x00, 0x47, 0xa1, 0x3e, 0xfe,
+	0xf8, 0x4e, 0x62, 0x03, 0xab, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x68, 0x3a, 0x96,
+	0x6e, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ReindexServiceClient is the client API for ReindexService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReindexServiceClient interface {
+	// Reindex starts reindexing a range of heights from the blocks and
+	// FinalizeBlock responses stored by the node. Only one reindexing can be in
+	// progress at a time.
+	Reindex(ctx context.Context, in *ReindexRequest, opts ...grpc.CallOption) (*ReindexResponse, error)
+	// GetReindexStatus returns the progress of the latest reindexing.
+	GetReindexStatus(ctx context.Context, in *GetReindexStatusRequest, opts ...grpc.CallOption) (*GetReindexStatusResponse, error)
+	// CancelReindex stops the reindexing in progress, if any.
+	CancelReindex(ctx context.Context, in *CancelReindexRequest, opts ...grpc.CallOption) (*CancelReindexResponse, error)
+}
+
+type reindexServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewReindexServiceClient(cc grpc1.ClientConn) ReindexServiceClient {
+	return &reindexServiceClient{cc}
+}
+
+func (c *reindexServiceClient) Reindex(ctx context.Context, in *ReindexRequest, opts ...grpc.CallOption) (*ReindexResponse, error) {
+	out := new(ReindexResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.reindex.v1.ReindexService/Reindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reindexServiceClient) GetReindexStatus(ctx context.Context, in *GetReindexStatusRequest, opts ...grpc.CallOption) (*GetReindexStatusResponse, error) {
+	out := new(GetReindexStatusResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.reindex.v1.ReindexService/GetReindexStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reindexServiceClient) CancelReindex(ctx context.Context, in *CancelReindexRequest, opts ...grpc.CallOption) (*CancelReindexResponse, error) {
+	out := new(CancelReindexResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.reindex.v1.ReindexService/CancelReindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReindexServiceServer is the server API for ReindexService service.
+type ReindexServiceServer interface {
+	// Reindex starts reindexing a range of heights from the blocks and
+	// FinalizeBlock responses stored by the node. Only one reindexing can be in
+	// progress at a time.
+	Reindex(context.Context, *ReindexRequest) (*ReindexResponse, error)
+	// GetReindexStatus returns the progress of the latest reindexing.
+	GetReindexStatus(context.Context, *GetReindexStatusRequest) (*GetReindexStatusResponse, error)
+	// CancelReindex stops the reindexing in progress, if any.
+	CancelReindex(context.Context, *CancelReindexRequest) (*CancelReindexResponse, error)
+}
+
+// UnimplementedReindexServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedReindexServiceServer struct {
+}
+
+func (*UnimplementedReindexServiceServer) Reindex(ctx context.Context, req *ReindexRequest) (*ReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reindex not implemented")
+}
+func (*UnimplementedReindexServiceServer) GetReindexStatus(ctx context.Context, req *GetReindexStatusRequest) (*GetReindexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReindexStatus not implemented")
+}
+func (*UnimplementedReindexServiceServer) CancelReindex(ctx context.Context, req *CancelReindexRequest) (*CancelReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReindex not implemented")
+}
+
+func RegisterReindexServiceServer(s grpc1.Server, srv ReindexServiceServer) {
+	s.RegisterService(&_ReindexService_serviceDesc, srv)
+}
+
+func _ReindexService_Reindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).Reindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.reindex.v1.ReindexService/Reindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).Reindex(ctx, req.(*ReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReindexService_GetReindexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReindexStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).GetReindexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.reindex.v1.ReindexService/GetReindexStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).GetReindexStatus(ctx, req.(*GetReindexStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReindexService_CancelReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReindexServiceServer).CancelReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.reindex.v1.ReindexService/CancelReindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReindexServiceServer).CancelReindex(ctx, req.(*CancelReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReindexService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.reindex.v1.ReindexService",
+	HandlerType: (*ReindexServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reindex",
+			Handler:    _ReindexService_Reindex_Handler,
+		},
+		{
+			MethodName: "GetReindexStatus",
+			Handler:    _ReindexService_GetReindexStatus_Handler,
+		},
+		{
+			MethodName: "CancelReindex",
+			Handler:    _ReindexService_CancelReindex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/services/reindex/v1/reindex_service.proto",
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/tx/v1/tx_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("tendermint/services/tx/v1/tx_service.proto", fileDescriptor_a34fc2e74b312465)
+}
+
+var fileDescriptor_a34fc2e74b312465 = []byte{
+	// 218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x49, 0xcd, 0x4b,
+	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6,
+	0x2f, 0xa9, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0x88, 0x87, 0x8a, 0xe8, 0x15, 0x14, 0xe5, 0x97,
+	0xe4, 0x0b, 0x49, 0x22, 0xd4, 0xea, 0xc1, 0xd4, 0xea, 0x95, 0x54, 0xe8, 0x95, 0x19, 0x4a, 0x29,
+	0xe1, 0x33, 0x06, 0xa2, 0xdd, 0xe8, 0x0a, 0x23, 0x17, 0x67, 0x48, 0x45, 0x30, 0x44, 0x56, 0x28,
+	0x8d, 0x8b, 0xd3, 0x3d, 0xb5, 0xc4, 0xa9, 0xd2, 0x23, 0xb1, 0x38, 0x43, 0x48, 0x5b, 0x0f, 0xa7,
+	0xd1, 0x7a, 0x70, 0x55, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x52, 0x3a, 0xc4, 0x29, 0x2e,
+	0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x8a, 0xe7, 0x62, 0x0b, 0x4e, 0x4d, 0x2c, 0x4a, 0xce, 0x10,
+	0xd2, 0xc0, 0xa3, 0x0f, 0xa2, 0x04, 0x66, 0x83, 0x26, 0x11, 0x2a, 0x21, 0xc6, 0x1b, 0x30, 0x3a,
+	0x85, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
+	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4d, 0x7a, 0x66, 0x49,
+	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x6e, 0x6a, 0x49, 0x52, 0x5a, 0x09,
+	0x82, 0x01, 0x0e, 0x14, 0x7d, 0x9c, 0xe1, 0x96, 0xc4, 0x06, 0x56, 0x60, 0x0c, 0x08, 0x00, 0x00,
+	0xff, 0xff, 0xa6, 0xb7, 0xd6, 0x25, 0xa2, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TxServiceClient is the client API for TxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxServiceClient interface {
+	// GetByHash retrieves an indexed transaction by its hash.
+	GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*GetByHashResponse, error)
+	// Search returns a stream of the indexed transactions matching a query.
+	// The stream ends once all matching transactions, up to the requested
+	// limit, have been sent.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (TxService_SearchClient, error)
+}
+
+type txServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewTxServiceClient(cc grpc1.ClientConn) TxServiceClient {
+	return &txServiceClient{cc}
+}
+
+func (c *txServiceClient) GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*GetByHashResponse, error) {
+	out := new(GetByHashResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.tx.v1.TxService/GetByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (TxService_SearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TxService_serviceDesc.Streams[0], "/tendermint.services.tx.v1.TxService/Search", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txServiceSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TxService_SearchClient interface {
+	Recv() (*SearchResponse, error)
+	grpc.ClientStream
+}
+
+type txServiceSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *txServiceSearchClient) Recv() (*SearchResponse, error) {
+	m := new(SearchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TxServiceServer is the server API for TxService service.
+type TxServiceServer interface {
+	// GetByHash retrieves an indexed transaction by its hash.
+	GetByHash(context.Context, *GetByHashRequest) (*GetByHashResponse, error)
+	// Search returns a stream of the indexed transactions matching a query.
+	// The stream ends once all matching transactions, up to the requested
+	// limit, have been sent.
+	Search(*SearchRequest, TxService_SearchServer) error
+}
+
+// UnimplementedTxServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTxServiceServer struct {
+}
+
+func (*UnimplementedTxServiceServer) GetByHash(ctx context.Context, req *GetByHashRequest) (*GetByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHash not implemented")
+}
+func (*UnimplementedTxServiceServer) Search(req *SearchRequest, srv TxService_SearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+
+func RegisterTxServiceServer(s grpc1.Server, srv TxServiceServer) {
+	s.RegisterService(&_TxService_serviceDesc, srv)
+}
+
+func _TxService_GetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).GetByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.tx.v1.TxService/GetByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).GetByHash(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TxServiceServer).Search(m, &txServiceSearchServer{stream})
+}
+
+type TxService_SearchServer interface {
+	Send(*SearchResponse) error
+	grpc.ServerStream
+}
+
+type txServiceSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *txServiceSearchServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _TxService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.tx.v1.TxService",
+	HandlerType: (*TxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByHash",
+			Handler:    _TxService_GetByHash_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Search",
+			Handler:       _TxService_Search_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tendermint/services/tx/v1/tx_service.proto",
+}
@@ -330,6 +330,15 @@ func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, err
 	return result, nil
 }
 
+func (c *baseRPCClient) CrawledPeers(ctx context.Context) (*ctypes.ResultCrawledPeers, error) {
+	result := new(ctypes.ResultCrawledPeers)
+	_, err := c.caller.Call(ctx, "crawled_peers", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	result := new(ctypes.ResultDumpConsensusState)
 	_, err := c.caller.Call(ctx, "dump_consensus_state", map[string]interface{}{}, result)
@@ -505,6 +514,7 @@ func (c *baseRPCClient) TxSearch(
 	page,
 	perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
 	params := map[string]interface{}{
@@ -519,6 +529,9 @@ func (c *baseRPCClient) TxSearch(
 	if perPage != nil {
 		params["per_page"] = perPage
 	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
 
 	_, err := c.caller.Call(ctx, "tx_search", params, result)
 	if err != nil {
@@ -533,6 +546,7 @@ func (c *baseRPCClient) BlockSearch(
 	query string,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error) {
 	result := new(ctypes.ResultBlockSearch)
 	params := map[string]interface{}{
@@ -546,6 +560,9 @@ func (c *baseRPCClient) BlockSearch(
 	if perPage != nil {
 		params["per_page"] = perPage
 	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
 
 	_, err := c.caller.Call(ctx, "block_search", params, result)
 	if err != nil {
@@ -74,22 +74,26 @@ type SignClient interface {
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 	// TxSearch defines a method to search for a paginated set of transactions by
-	// transaction event search criteria.
+	// transaction event search criteria. A non-empty cursor, returned as the
+	// next cursor of the previous page, is used instead of the page number.
 	TxSearch(
 		ctx context.Context,
 		query string,
 		prove bool,
 		page, perPage *int,
 		orderBy string,
+		cursor string,
 	) (*ctypes.ResultTxSearch, error)
 
 	// BlockSearch defines a method to search for a paginated set of blocks based
-	// from FinalizeBlock event search criteria.
+	// from FinalizeBlock event search criteria. A non-empty cursor, returned as
+	// the next cursor of the previous page, is used instead of the page number.
 	BlockSearch(
 		ctx context.Context,
 		query string,
 		page, perPage *int,
 		orderBy string,
+		cursor string,
 	) (*ctypes.ResultBlockSearch, error)
 }
 
@@ -109,6 +113,7 @@ type StatusClient interface {
 // usually.
 type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
+	CrawledPeers(context.Context) (*ctypes.ResultCrawledPeers, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
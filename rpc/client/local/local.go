@@ -114,6 +114,10 @@ func (c *Local) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(c.ctx)
 }
 
+func (c *Local) CrawledPeers(context.Context) (*ctypes.ResultCrawledPeers, error) {
+	return c.env.CrawledPeers(c.ctx)
+}
+
 func (c *Local) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.env.DumpConsensusState(c.ctx)
 }
@@ -195,8 +199,9 @@ func (c *Local) TxSearch(
 	page,
 	perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.env.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+	return c.env.TxSearch(c.ctx, query, prove, page, perPage, orderBy, cursor)
 }
 
 func (c *Local) BlockSearch(
@@ -204,8 +209,9 @@ func (c *Local) BlockSearch(
 	query string,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.env.BlockSearch(c.ctx, query, page, perPage, orderBy)
+	return c.env.BlockSearch(c.ctx, query, page, perPage, orderBy, cursor)
 }
 
 func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
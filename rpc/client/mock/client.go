@@ -124,6 +124,10 @@ func (c Client) NetInfo(_ context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(&rpctypes.Context{})
 }
 
+func (c Client) CrawledPeers(_ context.Context) (*ctypes.ResultCrawledPeers, error) {
+	return c.env.CrawledPeers(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusState(_ context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.env.GetConsensusState(&rpctypes.Context{})
 }
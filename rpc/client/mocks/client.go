@@ -160,13 +160,13 @@ func (_m *Client) BlockResults(ctx context.Context, height *int64) (*coretypes.R
 	return r0, r1
 }
 
-// BlockSearch provides a mock function with given fields: ctx, query, page, perPage, orderBy
-func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
-	ret := _m.Called(ctx, query, page, perPage, orderBy)
+// BlockSearch provides a mock function with given fields: ctx, query, page, perPage, orderBy, cursor
+func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perPage *int, orderBy string, cursor string) (*coretypes.ResultBlockSearch, error) {
+	ret := _m.Called(ctx, query, page, perPage, orderBy, cursor)
 
 	var r0 *coretypes.ResultBlockSearch
-	if rf, ok := ret.Get(0).(func(context.Context, string, *int, *int, string) *coretypes.ResultBlockSearch); ok {
-		r0 = rf(ctx, query, page, perPage, orderBy)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, *int, string, string) *coretypes.ResultBlockSearch); ok {
+		r0 = rf(ctx, query, page, perPage, orderBy, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*coretypes.ResultBlockSearch)
@@ -174,8 +174,8 @@ func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perP
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, *int, *int, string) error); ok {
-		r1 = rf(ctx, query, page, perPage, orderBy)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *int, *int, string, string) error); ok {
+		r1 = rf(ctx, query, page, perPage, orderBy, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -390,6 +390,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// CrawledPeers provides a mock function with given fields: _a0
+func (_m *Client) CrawledPeers(_a0 context.Context) (*coretypes.ResultCrawledPeers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultCrawledPeers
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultCrawledPeers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultCrawledPeers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -774,13 +797,13 @@ func (_m *Client) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.R
 	return r0, r1
 }
 
-// TxSearch provides a mock function with given fields: ctx, query, prove, page, perPage, orderBy
-func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
-	ret := _m.Called(ctx, query, prove, page, perPage, orderBy)
+// TxSearch provides a mock function with given fields: ctx, query, prove, page, perPage, orderBy, cursor
+func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string, cursor string) (*coretypes.ResultTxSearch, error) {
+	ret := _m.Called(ctx, query, prove, page, perPage, orderBy, cursor)
 
 	var r0 *coretypes.ResultTxSearch
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool, *int, *int, string) *coretypes.ResultTxSearch); ok {
-		r0 = rf(ctx, query, prove, page, perPage, orderBy)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, *int, *int, string, string) *coretypes.ResultTxSearch); ok {
+		r0 = rf(ctx, query, prove, page, perPage, orderBy, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*coretypes.ResultTxSearch)
@@ -788,8 +811,8 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, bool, *int, *int, string) error); ok {
-		r1 = rf(ctx, query, prove, page, perPage, orderBy)
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, *int, *int, string, string) error); ok {
+		r1 = rf(ctx, query, prove, page, perPage, orderBy, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -512,7 +512,7 @@ func TestTxSearchWithTimeout(t *testing.T) {
 	require.NoError(t, err)
 
 	// query using a compositeKey (see kvstore application)
-	result, err := timeoutClient.TxSearch(context.Background(), "app.creator='Cosmoshi Netowoko'", false, nil, nil, "asc")
+	result, err := timeoutClient.TxSearch(context.Background(), "app.creator='Cosmoshi Netowoko'", false, nil, nil, "asc", "")
 	require.Nil(t, err)
 	require.Greater(t, len(result.Txs), 0, "expected a lot of transactions")
 }
@@ -530,7 +530,7 @@ func TestBlockSearch(t *testing.T) {
 		require.NoError(t, err)
 	}
 	require.NoError(t, client.WaitForHeight(c, 5, nil))
-	result, err := c.BlockSearch(context.Background(), "begin_event.foo = 100", nil, nil, "asc")
+	result, err := c.BlockSearch(context.Background(), "begin_event.foo = 100", nil, nil, "asc", "")
 	require.NoError(t, err)
 	blockCount := len(result.Blocks)
 	// if we generate block events within the test (by uncommenting
@@ -554,9 +554,10 @@ func TestTxSearch(t *testing.T) {
 
 	// since we're not using an isolated test server, we'll have lingering transactions
 	// from other tests as well
-	result, err := c.TxSearch(context.Background(), "tx.height >= 0", true, nil, nil, "asc")
+	result, err := c.TxSearch(context.Background(), "tx.height >= 0", true, nil, nil, "asc", "")
 	require.NoError(t, err)
 	txCount := len(result.Txs)
+	firstTxs := result.Txs
 
 	// pick out the last tx to have something to search for in tests
 	find := result.Txs[len(result.Txs)-1]
@@ -565,7 +566,7 @@ func TestTxSearch(t *testing.T) {
 	for _, c := range GetClients() {
 
 		// now we query for the tx.
-		result, err := c.TxSearch(context.Background(), fmt.Sprintf("tx.hash='%v'", find.Hash), true, nil, nil, "asc")
+		result, err := c.TxSearch(context.Background(), fmt.Sprintf("tx.hash='%v'", find.Hash), true, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 		require.Equal(t, find.Hash, result.Txs[0].Hash)
@@ -583,51 +584,51 @@ func TestTxSearch(t *testing.T) {
 		}
 
 		// query by height
-		result, err = c.TxSearch(context.Background(), fmt.Sprintf("tx.height=%d", find.Height), true, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), fmt.Sprintf("tx.height=%d", find.Height), true, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 1)
 
 		// query for non existing tx
-		result, err = c.TxSearch(context.Background(), fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
 		// query using a compositeKey (see kvstore application)
-		result, err = c.TxSearch(context.Background(), "app.creator='Cosmoshi Netowoko'", false, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), "app.creator='Cosmoshi Netowoko'", false, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Greater(t, len(result.Txs), 0, "expected a lot of transactions")
 
 		// query using an index key
-		result, err = c.TxSearch(context.Background(), "app.index_key='index is working'", false, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), "app.index_key='index is working'", false, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Greater(t, len(result.Txs), 0, "expected a lot of transactions")
 
 		// query using an noindex key
-		result, err = c.TxSearch(context.Background(), "app.noindex_key='index is working'", false, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), "app.noindex_key='index is working'", false, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Equal(t, len(result.Txs), 0, "expected a lot of transactions")
 
 		// query using a compositeKey (see kvstore application) and height
 		result, err = c.TxSearch(context.Background(),
-			"app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, nil, nil, "asc")
+			"app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, nil, nil, "asc", "")
 		require.Nil(t, err)
 		require.Greater(t, len(result.Txs), 0, "expected a lot of transactions")
 
 		// query a non existing tx with page 1 and txsPerPage 1
 		perPage := 1
-		result, err = c.TxSearch(context.Background(), "app.creator='Cosmoshi Neetowoko'", true, nil, &perPage, "asc")
+		result, err = c.TxSearch(context.Background(), "app.creator='Cosmoshi Neetowoko'", true, nil, &perPage, "asc", "")
 		require.Nil(t, err)
 		require.Len(t, result.Txs, 0)
 
 		// check sorting
-		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "asc")
+		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "asc", "")
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.LessOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
 			require.LessOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
 		}
 
-		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "desc")
+		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "desc", "")
 		require.Nil(t, err)
 		for k := 0; k < len(result.Txs)-1; k++ {
 			require.GreaterOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
@@ -644,7 +645,7 @@ func TestTxSearch(t *testing.T) {
 		totalTx := 0
 		for page := 1; page <= pages; page++ {
 			page := page
-			result, err := c.TxSearch(context.Background(), "tx.height >= 1", true, &page, &perPage, "asc")
+			result, err := c.TxSearch(context.Background(), "tx.height >= 1", true, &page, &perPage, "asc", "")
 			require.NoError(t, err)
 			if page < pages {
 				require.Len(t, result.Txs, perPage)
@@ -663,6 +664,23 @@ func TestTxSearch(t *testing.T) {
 		}
 		require.Equal(t, txCount, totalTx)
 		require.Len(t, seen, txCount)
+
+		// check pagination with cursors
+		var cursorTxs []*ctypes.ResultTx
+		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, &perPage, "asc", "")
+		require.NoError(t, err)
+		cursorTxs = append(cursorTxs, result.Txs...)
+		for result.NextCursor != "" && len(cursorTxs) < txCount {
+			result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, &perPage, "asc", result.NextCursor)
+			require.NoError(t, err)
+			require.Equal(t, -1, result.TotalCount)
+			cursorTxs = append(cursorTxs, result.Txs...)
+		}
+		// The first page of the first search only holds the first txs.
+		require.GreaterOrEqual(t, len(cursorTxs), txCount)
+		for k := 0; k < txCount; k++ {
+			require.Equal(t, firstTxs[k].Hash, cursorTxs[k].Hash)
+		}
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -203,11 +204,16 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 
 // BlockSearch searches for a paginated set of blocks matching
 // FinalizeBlock event search criteria.
+//
+// If the block indexer supports it, the cursor of the next page is returned
+// alongside the results. Passing it instead of a page number returns the next
+// page without loading all the matching blocks, which are then not counted.
 func (env *Environment) BlockSearch(
 	ctx *rpctypes.Context,
 	query string,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error) {
 	// skip if block indexing is disabled
 	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
@@ -219,26 +225,40 @@ func (env *Environment) BlockSearch(
 		return nil, err
 	}
 
+	var desc bool
+	switch orderBy {
+	case "desc", "":
+		desc = true
+	case "asc":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	perPage := env.validatePerPage(perPagePtr)
+	pager, canPage := env.BlockIndexer.(indexer.BlockPager)
+	if cursor != "" {
+		if !canPage {
+			return nil, errors.New("the block indexer does not support cursors")
+		} else if pagePtr != nil {
+			return nil, errors.New("page and cursor cannot be used together")
+		}
+		return env.blockSearchPage(ctx, pager, q, perPage, desc, cursor)
+	}
+
 	results, err := env.BlockIndexer.Search(ctx.Context(), q)
 	if err != nil {
 		return nil, err
 	}
 
 	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc", "":
+	if desc {
 		sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
-
-	case "asc":
+	} else {
 		sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
 	}
 
 	// paginate results
 	totalCount := len(results)
-	perPage := env.validatePerPage(perPagePtr)
 
 	page, err := validatePage(pagePtr, perPage, totalCount)
 	if err != nil {
@@ -248,9 +268,56 @@ func (env *Environment) BlockSearch(
 	skipCount := validateSkipCount(page, perPage)
 	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
 
-	apiResults := make([]*ctypes.ResultBlock, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		block := env.BlockStore.LoadBlock(results[i])
+	res := &ctypes.ResultBlockSearch{
+		Blocks:     env.resultBlocks(results[skipCount : skipCount+pageSize]),
+		TotalCount: totalCount,
+	}
+	if canPage && skipCount+pageSize < totalCount {
+		res.NextCursor = encodeCursor(results[skipCount+pageSize-1], 0)
+	}
+	return res, nil
+}
+
+// blockSearchPage returns the page of blocks following the given cursor. The
+// matching blocks are not counted, so the total count is -1.
+func (env *Environment) blockSearchPage(
+	ctx *rpctypes.Context,
+	pager indexer.BlockPager,
+	q *cmtquery.Query,
+	perPage int,
+	desc bool,
+	cursor string,
+) (*ctypes.ResultBlockSearch, error) {
+	after, _, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// One more result is requested to know whether there is a next page.
+	results, err := pager.SearchPage(ctx.Context(), q, after, perPage+1, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(results) > perPage {
+		results = results[:perPage]
+		nextCursor = encodeCursor(results[perPage-1], 0)
+	}
+
+	return &ctypes.ResultBlockSearch{
+		Blocks:     env.resultBlocks(results),
+		TotalCount: -1,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// resultBlocks loads the blocks at the given heights, skipping the ones that
+// are not in the block store.
+func (env *Environment) resultBlocks(heights []int64) []*ctypes.ResultBlock {
+	apiResults := make([]*ctypes.ResultBlock, 0, len(heights))
+	for _, height := range heights {
+		block := env.BlockStore.LoadBlock(height)
 		if block != nil {
 			blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
 			if blockMeta != nil {
@@ -261,6 +328,5 @@ func (env *Environment) BlockSearch(
 			}
 		}
 	}
-
-	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
+	return apiResults
 }
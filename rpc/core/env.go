@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -55,6 +57,13 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerScore(p2p.ID) int64
+	BannedPeers() []p2p.BannedPeer
+}
+
+// The PEX reactor of a seed.
+type crawler interface {
+	CrawledPeers() []pex.CrawledPeer
 }
 
 // A reactor that transitions from block sync or state sync to consensus mode.
@@ -79,6 +88,7 @@ type Environment struct {
 	MempoolReactor   syncReactor
 	P2PPeers         peers
 	P2PTransport     transport
+	P2PCrawler       crawler // nil unless the node runs in seed mode
 
 	// objects
 	PubKey       crypto.PubKey
@@ -162,6 +172,29 @@ func (env *Environment) InitGenesisChunks() error {
 	return nil
 }
 
+// encodeCursor returns the opaque cursor designating the search result at the
+// given height and index, from which the next page of results starts.
+func encodeCursor(height int64, index uint32) string {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], index)
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+// decodeCursor returns the height and index of the search result designated
+// by the given cursor.
+func decodeCursor(cursor string) (int64, uint32, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(bz) != 12 {
+		return 0, 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	height := int64(binary.BigEndian.Uint64(bz))
+	if height <= 0 {
+		return 0, 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return height, binary.BigEndian.Uint32(bz[8:]), nil
+}
+
 func validateSkipCount(page, perPage int) int {
 	skipCount := (page - 1) * perPage
 	if skipCount < 0 {
@@ -80,3 +80,17 @@ func TestPaginationPerPage(t *testing.T) {
 	p := env.validatePerPage(nil)
 	assert.Equal(t, defaultPerPage, p)
 }
+
+func TestCursor(t *testing.T) {
+	cursor := encodeCursor(12, 3)
+	height, index, err := decodeCursor(cursor)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, 12, height)
+		assert.EqualValues(t, 3, index)
+	}
+
+	for _, invalid := range []string{"invalid", encodeCursor(0, 0), encodeCursor(-1, 0), cursor[1:]} {
+		_, _, err := decodeCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -25,16 +25,31 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			Score:            env.P2PPeers.PeerScore(peer.ID()),
 		})
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
 	// PRO: useful info
 	// CON: privacy
 	return &ctypes.ResultNetInfo{
-		Listening: env.P2PTransport.IsListening(),
-		Listeners: env.P2PTransport.Listeners(),
-		NPeers:    len(peers),
-		Peers:     peers,
+		Listening:   env.P2PTransport.IsListening(),
+		Listeners:   env.P2PTransport.Listeners(),
+		NPeers:      len(peers),
+		Peers:       peers,
+		BannedPeers: env.P2PPeers.BannedPeers(),
+	}, nil
+}
+
+// CrawledPeers returns what a seed learned about the addresses it crawled.
+// More: https://docs.cometbft.com/main/rpc/#/Info/crawled_peers
+func (env *Environment) CrawledPeers(*rpctypes.Context) (*ctypes.ResultCrawledPeers, error) {
+	if env.P2PCrawler == nil {
+		return nil, errors.New("the node is not running in seed mode")
+	}
+	peers := env.P2PCrawler.CrawledPeers()
+	return &ctypes.ResultCrawledPeers{
+		NPeers: len(peers),
+		Peers:  peers,
 	}, nil
 }
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
@@ -87,3 +89,19 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+func TestCrawledPeers(t *testing.T) {
+	env := &Environment{}
+	env.Logger = log.TestingLogger()
+
+	// not a seed
+	_, err := env.CrawledPeers(&rpctypes.Context{})
+	require.Error(t, err)
+
+	book := pex.NewAddrBook(filepath.Join(t.TempDir(), "addrbook.json"), false)
+	env.P2PCrawler = pex.NewReactor(book, &pex.ReactorConfig{SeedMode: true})
+	res, err := env.CrawledPeers(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Zero(t, res.NPeers)
+	assert.Empty(t, res.Peers)
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"sort"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -50,6 +52,11 @@ func (env *Environment) Tx(_ *rpctypes.Context, hash []byte, prove bool) (*ctype
 
 // TxSearch allows you to query for multiple transactions results. It returns a
 // list of transactions (maximum ?per_page entries) and the total count.
+//
+// If the transaction indexer supports it, the cursor of the next page is
+// returned alongside the results. Passing it instead of a page number returns
+// the next page without loading all the matching transactions, which are then
+// not counted. The same goes for the first page when no page number is given.
 // More: https://docs.cometbft.com/main/rpc/#/Info/tx_search
 func (env *Environment) TxSearch(
 	ctx *rpctypes.Context,
@@ -57,6 +64,7 @@ func (env *Environment) TxSearch(
 	prove bool,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
@@ -70,34 +78,51 @@ func (env *Environment) TxSearch(
 		return nil, err
 	}
 
+	var desc bool
+	switch orderBy {
+	case "desc":
+		desc = true
+	case "asc", "":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	perPage := env.validatePerPage(perPagePtr)
+	pager, canPage := env.TxIndexer.(txindex.Pager)
+	if cursor != "" {
+		if !canPage {
+			return nil, errors.New("the transaction indexer does not support cursors")
+		} else if pagePtr != nil {
+			return nil, errors.New("page and cursor cannot be used together")
+		}
+	}
+	// Unless a page is requested, the first page is searched as the following
+	// ones, without loading all the matching results.
+	if canPage && pagePtr == nil {
+		return env.txSearchPage(ctx, pager, q, prove, perPage, desc, cursor)
+	}
+
 	results, err := env.TxIndexer.Search(ctx.Context(), q)
 	if err != nil {
 		return nil, err
 	}
 
 	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Height == results[j].Height {
+			if desc {
 				return results[i].Index > results[j].Index
 			}
+			return results[i].Index < results[j].Index
+		}
+		if desc {
 			return results[i].Height > results[j].Height
-		})
-	case "asc", "":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
-				return results[i].Index < results[j].Index
-			}
-			return results[i].Height < results[j].Height
-		})
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
-	}
+		}
+		return results[i].Height < results[j].Height
+	})
 
 	// paginate results
 	totalCount := len(results)
-	perPage := env.validatePerPage(perPagePtr)
 
 	page, err := validatePage(pagePtr, perPage, totalCount)
 	if err != nil {
@@ -107,10 +132,63 @@ func (env *Environment) TxSearch(
 	skipCount := validateSkipCount(page, perPage)
 	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
 
-	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
+	res := &ctypes.ResultTxSearch{
+		Txs:        env.resultTxs(results[skipCount:skipCount+pageSize], prove),
+		TotalCount: totalCount,
+	}
+	if canPage && skipCount+pageSize < totalCount {
+		last := results[skipCount+pageSize-1]
+		res.NextCursor = encodeCursor(last.Height, last.Index)
+	}
+	return res, nil
+}
+
+// txSearchPage returns the page of transactions following the given cursor,
+// or the first page if it is empty. The matching transactions are not counted,
+// so the total count is -1.
+func (env *Environment) txSearchPage(
+	ctx *rpctypes.Context,
+	pager txindex.Pager,
+	q *cmtquery.Query,
+	prove bool,
+	perPage int,
+	desc bool,
+	cursor string,
+) (*ctypes.ResultTxSearch, error) {
+	var after *txindex.Position
+	if cursor != "" {
+		height, index, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &txindex.Position{Height: height, Index: index}
+	}
 
+	// One more result is requested to know whether there is a next page.
+	results, err := pager.SearchPage(ctx.Context(), q, after, perPage+1, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(results) > perPage {
+		results = results[:perPage]
+		last := results[perPage-1]
+		nextCursor = encodeCursor(last.Height, last.Index)
+	}
+
+	return &ctypes.ResultTxSearch{
+		Txs:        env.resultTxs(results, prove),
+		TotalCount: -1,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// resultTxs converts the given transaction results to RPC results, with their
+// inclusion proof if prove is set.
+func (env *Environment) resultTxs(results []*abci.TxResult, prove bool) []*ctypes.ResultTx {
+	apiResults := make([]*ctypes.ResultTx, 0, len(results))
+	for _, r := range results {
 		var proof types.TxProof
 		if prove {
 			block := env.BlockStore.LoadBlock(r.Height)
@@ -126,6 +204,5 @@ func (env *Environment) TxSearch(
 			Proof:    proof,
 		})
 	}
-
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	return apiResults
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
 )
@@ -113,10 +114,17 @@ func (s *ResultStatus) TxIndexEnabled() bool {
 
 // Info about peer connections
 type ResultNetInfo struct {
-	Listening bool     `json:"listening"`
-	Listeners []string `json:"listeners"`
-	NPeers    int      `json:"n_peers"`
-	Peers     []Peer   `json:"peers"`
+	Listening   bool             `json:"listening"`
+	Listeners   []string         `json:"listeners"`
+	NPeers      int              `json:"n_peers"`
+	Peers       []Peer           `json:"peers"`
+	BannedPeers []p2p.BannedPeer `json:"banned_peers"`
+}
+
+// Addresses crawled by a seed
+type ResultCrawledPeers struct {
+	NPeers int               `json:"n_peers"`
+	Peers  []pex.CrawledPeer `json:"peers"`
 }
 
 // Log from dialing seeds
@@ -135,6 +143,7 @@ type Peer struct {
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	Score            int64                `json:"score"`
 }
 
 // Validators for a height.
@@ -208,12 +217,16 @@ type ResultTx struct {
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	// NextCursor, if set, is the cursor to pass to get the next page.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ResultBlockSearch defines the RPC response type for a block search by events.
 type ResultBlockSearch struct {
 	Blocks     []*ResultBlock `json:"blocks"`
 	TotalCount int            `json:"total_count"`
+	// NextCursor, if set, is the cursor to pass to get the next page.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // List of mempool txs
@@ -23,6 +23,9 @@ type Client interface {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	MempoolServiceClient
+	EventServiceClient
+	TxServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -35,6 +38,9 @@ type clientBuilder struct {
 	versionServiceEnabled      bool
 	blockServiceEnabled        bool
 	blockResultsServiceEnabled bool
+	mempoolServiceEnabled      bool
+	eventServiceEnabled        bool
+	txServiceEnabled           bool
 }
 
 func newClientBuilder() *clientBuilder {
@@ -44,6 +50,9 @@ func newClientBuilder() *clientBuilder {
 		versionServiceEnabled:      true,
 		blockServiceEnabled:        true,
 		blockResultsServiceEnabled: true,
+		mempoolServiceEnabled:      true,
+		eventServiceEnabled:        true,
+		txServiceEnabled:           true,
 	}
 }
 
@@ -57,6 +66,9 @@ type client struct {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	MempoolServiceClient
+	EventServiceClient
+	TxServiceClient
 }
 
 // Close implements Client.
@@ -95,6 +107,39 @@ func WithBlockServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithMempoolServiceEnabled allows control of whether or not to create a
+// client for interacting with the mempool service of a CometBFT node.
+//
+// If disabled and the client attempts to access the mempool service API, the
+// client will panic.
+func WithMempoolServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.mempoolServiceEnabled = enabled
+	}
+}
+
+// WithEventServiceEnabled allows control of whether or not to create a
+// client for interacting with the event service of a CometBFT node.
+//
+// If disabled and the client attempts to access the event service API, the
+// client will panic.
+func WithEventServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.eventServiceEnabled = enabled
+	}
+}
+
+// WithTxServiceEnabled allows control of whether or not to create a client
+// for interacting with the tx service of a CometBFT node.
+//
+// If disabled and the client attempts to access the tx service API, the
+// client will panic.
+func WithTxServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.txServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -133,10 +178,25 @@ func New(ctx context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.blockResultsServiceEnabled {
 		blockResultServiceClient = newBlockResultsServiceClient(conn)
 	}
+	mempoolServiceClient := newDisabledMempoolServiceClient()
+	if builder.mempoolServiceEnabled {
+		mempoolServiceClient = newMempoolServiceClient(conn)
+	}
+	eventServiceClient := newDisabledEventServiceClient()
+	if builder.eventServiceEnabled {
+		eventServiceClient = newEventServiceClient(conn)
+	}
+	txServiceClient := newDisabledTxServiceClient()
+	if builder.txServiceEnabled {
+		txServiceClient = newTxServiceClient(conn)
+	}
 	return &client{
 		conn:                      conn,
 		VersionServiceClient:      versionServiceClient,
 		BlockServiceClient:        blockServiceClient,
 		BlockResultsServiceClient: blockResultServiceClient,
+		MempoolServiceClient:      mempoolServiceClient,
+		EventServiceClient:        eventServiceClient,
+		TxServiceClient:           txServiceClient,
 	}, nil
 }
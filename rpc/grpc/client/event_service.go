@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	eventsvc "github.com/cometbft/cometbft/proto/tendermint/services/event/v1"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/grpc"
+)
+
+// Event is sent to the client via a channel for every event matching the
+// query of a subscription. If Error is set, the subscription has terminated.
+type Event struct {
+	// The query the event matched.
+	Query string
+	// The attributes of the event, keyed by their composite key
+	// "{eventType}.{eventAttrKey}".
+	Events map[string][]string
+	// The data of the event, e.g. types.EventDataNewBlock or
+	// types.EventDataTx.
+	Data  types.TMEventData
+	Error error
+}
+
+type subscribeConfig struct {
+	chSize uint
+}
+
+type SubscribeOption func(*subscribeConfig)
+
+// SubscribeChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func SubscribeChannelSize(sz uint) SubscribeOption {
+	return func(opts *subscribeConfig) {
+		opts.chSize = sz
+	}
+}
+
+// EventServiceClient allows subscribing to the events published by a node.
+type EventServiceClient interface {
+	// Subscribe sends the events matching the given query to the resulting
+	// output channel. The query has the same format as the query accepted by
+	// the JSON-RPC subscribe endpoint, e.g. "tm.event = 'NewBlock'".
+	//
+	// Events are not dropped: if the caller does not keep up with them, the
+	// node eventually cancels the subscription.
+	Subscribe(ctx context.Context, query string, opts ...SubscribeOption) (<-chan Event, error)
+}
+
+type eventServiceClient struct {
+	client eventsvc.EventServiceClient
+}
+
+func newEventServiceClient(conn grpc.ClientConn) EventServiceClient {
+	return &eventServiceClient{
+		client: eventsvc.NewEventServiceClient(conn),
+	}
+}
+
+// Subscribe implements EventServiceClient.
+func (c *eventServiceClient) Subscribe(ctx context.Context, query string, opts ...SubscribeOption) (<-chan Event, error) {
+	subscribeClient, err := c.client.Subscribe(ctx, &eventsvc.SubscribeRequest{Query: query})
+	if err != nil {
+		return nil, fmt.Errorf("error getting a stream for events: %w", err)
+	}
+
+	cfg := &subscribeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	resultCh := make(chan Event, cfg.chSize)
+
+	go func(client eventsvc.EventService_SubscribeClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if err != nil {
+				res := Event{Error: fmt.Errorf("error receiving an event from a stream: %w", err)}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			res, err := eventFromProto(response)
+			if err != nil {
+				res = Event{Error: fmt.Errorf("error converting an event from its Protobuf representation: %w", err)}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}(subscribeClient)
+
+	return resultCh, nil
+}
+
+func eventFromProto(pe *eventsvc.SubscribeResponse) (Event, error) {
+	event := Event{
+		Query:  pe.Query,
+		Events: make(map[string][]string, len(pe.Events)),
+	}
+	for key, values := range pe.Events {
+		event.Events[key] = values.GetValues()
+	}
+
+	switch data := pe.Data.(type) {
+	case *eventsvc.SubscribeResponse_NewBlock:
+		block, err := types.BlockFromProto(data.NewBlock.Block)
+		if err != nil {
+			return Event{}, err
+		}
+		blockID, err := types.BlockIDFromProto(data.NewBlock.BlockId)
+		if err != nil {
+			return Event{}, err
+		}
+		var resultFinalizeBlock abci.ResponseFinalizeBlock
+		if data.NewBlock.ResultFinalizeBlock != nil {
+			resultFinalizeBlock = *data.NewBlock.ResultFinalizeBlock
+		}
+		event.Data = types.EventDataNewBlock{
+			Block:               block,
+			BlockID:             *blockID,
+			ResultFinalizeBlock: resultFinalizeBlock,
+		}
+	case *eventsvc.SubscribeResponse_NewBlockHeader:
+		header, err := types.HeaderFromProto(data.NewBlockHeader.Header)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Data = types.EventDataNewBlockHeader{Header: header}
+	case *eventsvc.SubscribeResponse_NewBlockEvents:
+		events := make([]abci.Event, len(data.NewBlockEvents.Events))
+		for i, e := range data.NewBlockEvents.Events {
+			events[i] = *e
+		}
+		event.Data = types.EventDataNewBlockEvents{
+			Height: data.NewBlockEvents.Height,
+			Events: events,
+			NumTxs: data.NewBlockEvents.NumTxs,
+		}
+	case *eventsvc.SubscribeResponse_NewEvidence:
+		ev, err := types.EvidenceFromProto(data.NewEvidence.Evidence)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Data = types.EventDataNewEvidence{
+			Height:   data.NewEvidence.Height,
+			Evidence: ev,
+		}
+	case *eventsvc.SubscribeResponse_Tx:
+		if data.Tx.TxResult == nil {
+			return Event{}, errors.New("nil tx result")
+		}
+		event.Data = types.EventDataTx{TxResult: *data.Tx.TxResult}
+	case *eventsvc.SubscribeResponse_RoundState:
+		event.Data = types.EventDataRoundState{
+			Height: data.RoundState.Height,
+			Round:  data.RoundState.Round,
+			Step:   data.RoundState.Step,
+		}
+	case *eventsvc.SubscribeResponse_NewRound:
+		event.Data = types.EventDataNewRound{
+			Height: data.NewRound.Height,
+			Round:  data.NewRound.Round,
+			Step:   data.NewRound.Step,
+			Proposer: types.ValidatorInfo{
+				Address: data.NewRound.Proposer.GetAddress(),
+				Index:   data.NewRound.Proposer.GetIndex(),
+			},
+		}
+	case *eventsvc.SubscribeResponse_CompleteProposal:
+		blockID, err := types.BlockIDFromProto(data.CompleteProposal.BlockId)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Data = types.EventDataCompleteProposal{
+			Height:  data.CompleteProposal.Height,
+			Round:   data.CompleteProposal.Round,
+			Step:    data.CompleteProposal.Step,
+			BlockID: *blockID,
+		}
+	case *eventsvc.SubscribeResponse_Vote:
+		vote, err := types.VoteFromProto(data.Vote.Vote)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Data = types.EventDataVote{Vote: vote}
+	case *eventsvc.SubscribeResponse_ValidatorSetUpdates:
+		vals := make([]*types.Validator, len(data.ValidatorSetUpdates.ValidatorUpdates))
+		for i, pv := range data.ValidatorSetUpdates.ValidatorUpdates {
+			val, err := types.ValidatorFromProto(pv)
+			if err != nil {
+				return Event{}, err
+			}
+			vals[i] = val
+		}
+		event.Data = types.EventDataValidatorSetUpdates{ValidatorUpdates: vals}
+	case *eventsvc.SubscribeResponse_StringData:
+		event.Data = types.EventDataString(data.StringData.Value)
+	default:
+		return Event{}, fmt.Errorf("unexpected event data type: %T", data)
+	}
+	return event, nil
+}
+
+type disabledEventServiceClient struct{}
+
+func newDisabledEventServiceClient() EventServiceClient {
+	return &disabledEventServiceClient{}
+}
+
+// Subscribe implements EventServiceClient - disabled client.
+func (*disabledEventServiceClient) Subscribe(context.Context, string, ...SubscribeOption) (<-chan Event, error) {
+	panic("event service client is disabled")
+}
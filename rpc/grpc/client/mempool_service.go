@@ -0,0 +1,246 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	mempoolsvc "github.com/cometbft/cometbft/proto/tendermint/services/mempool/v1"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/grpc"
+)
+
+// SubmitTxResult is the result of submitting a transaction via the CometBFT
+// MempoolService gRPC API.
+type SubmitTxResult struct {
+	// The hash of the transaction.
+	Hash []byte `json:"hash"`
+	// The result of checking the transaction against the application. The
+	// transaction was added to the mempool only if the code is OK.
+	CheckTx *abci.ResponseCheckTx `json:"check_tx"`
+}
+
+// UnconfirmedTxs contains transactions in the mempool.
+type UnconfirmedTxs struct {
+	Txs types.Txs `json:"txs"`
+	// The total number of transactions in the mempool.
+	Total int64 `json:"total"`
+	// The total size, in bytes, of the transactions in the mempool.
+	TotalBytes int64 `json:"total_bytes"`
+}
+
+// TxEventType indicates whether a transaction entered or left the mempool.
+type TxEventType int
+
+const (
+	TxEventUnknown TxEventType = iota
+	TxEventAdded
+	TxEventRemoved
+)
+
+// TxEvent is sent to the client via a channel every time a transaction enters
+// or leaves the mempool. If Error is set, the stream has terminated.
+type TxEvent struct {
+	Type  TxEventType
+	Hash  []byte
+	Tx    types.Tx
+	Error error
+}
+
+type getTxEventsConfig struct {
+	chSize uint
+}
+
+type GetTxEventsOption func(*getTxEventsConfig)
+
+// GetTxEventsChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func GetTxEventsChannelSize(sz uint) GetTxEventsOption {
+	return func(opts *getTxEventsConfig) {
+		opts.chSize = sz
+	}
+}
+
+// MempoolServiceClient provides access to the mempool of a node.
+type MempoolServiceClient interface {
+	// SubmitTx checks the given transaction against the application and, if
+	// it is valid, adds it to the mempool.
+	SubmitTx(ctx context.Context, tx types.Tx) (*SubmitTxResult, error)
+
+	// CheckTx checks the given transaction against the application without
+	// adding it to the mempool.
+	CheckTx(ctx context.Context, tx types.Tx) (*abci.ResponseCheckTx, error)
+
+	// GetTx attempts to retrieve the transaction in the mempool with the
+	// given hash.
+	GetTx(ctx context.Context, hash []byte) (types.Tx, error)
+
+	// GetUnconfirmedTxs retrieves up to limit transactions in the mempool. If
+	// limit is 0, the server's default limit is used.
+	GetUnconfirmedTxs(ctx context.Context, limit int64) (*UnconfirmedTxs, error)
+
+	// GetNumUnconfirmedTxs retrieves the number and total size of the
+	// transactions in the mempool. The returned Txs are always empty.
+	GetNumUnconfirmedTxs(ctx context.Context) (*UnconfirmedTxs, error)
+
+	// GetTxEvents sends an event to the resulting output channel every time a
+	// transaction enters or leaves the mempool.
+	GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEvent, error)
+}
+
+type mempoolServiceClient struct {
+	client mempoolsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: mempoolsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// SubmitTx implements MempoolServiceClient.
+func (c *mempoolServiceClient) SubmitTx(ctx context.Context, tx types.Tx) (*SubmitTxResult, error) {
+	res, err := c.client.SubmitTx(ctx, &mempoolsvc.SubmitTxRequest{Tx: tx})
+	if err != nil {
+		return nil, err
+	}
+	return &SubmitTxResult{
+		Hash:    res.Hash,
+		CheckTx: res.CheckTx,
+	}, nil
+}
+
+// CheckTx implements MempoolServiceClient.
+func (c *mempoolServiceClient) CheckTx(ctx context.Context, tx types.Tx) (*abci.ResponseCheckTx, error) {
+	res, err := c.client.CheckTx(ctx, &mempoolsvc.CheckTxRequest{Tx: tx})
+	if err != nil {
+		return nil, err
+	}
+	return res.CheckTx, nil
+}
+
+// GetTx implements MempoolServiceClient.
+func (c *mempoolServiceClient) GetTx(ctx context.Context, hash []byte) (types.Tx, error) {
+	res, err := c.client.GetTx(ctx, &mempoolsvc.GetTxRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return res.Tx, nil
+}
+
+// GetUnconfirmedTxs implements MempoolServiceClient.
+func (c *mempoolServiceClient) GetUnconfirmedTxs(ctx context.Context, limit int64) (*UnconfirmedTxs, error) {
+	res, err := c.client.GetUnconfirmedTxs(ctx, &mempoolsvc.GetUnconfirmedTxsRequest{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	txs := make(types.Txs, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx
+	}
+	return &UnconfirmedTxs{
+		Txs:        txs,
+		Total:      res.Total,
+		TotalBytes: res.TotalBytes,
+	}, nil
+}
+
+// GetNumUnconfirmedTxs implements MempoolServiceClient.
+func (c *mempoolServiceClient) GetNumUnconfirmedTxs(ctx context.Context) (*UnconfirmedTxs, error) {
+	res, err := c.client.GetNumUnconfirmedTxs(ctx, &mempoolsvc.GetNumUnconfirmedTxsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &UnconfirmedTxs{
+		Total:      res.Total,
+		TotalBytes: res.TotalBytes,
+	}, nil
+}
+
+// GetTxEvents implements MempoolServiceClient.
+func (c *mempoolServiceClient) GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEvent, error) {
+	txEventsClient, err := c.client.GetTxEvents(ctx, &mempoolsvc.GetTxEventsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting a stream for mempool tx events: %w", err)
+	}
+
+	cfg := &getTxEventsConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	resultCh := make(chan TxEvent, cfg.chSize)
+
+	go func(client mempoolsvc.MempoolService_GetTxEventsClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if err != nil {
+				res := TxEvent{Error: fmt.Errorf("error receiving a mempool tx event from a stream: %w", err)}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			res := TxEvent{
+				Type: txEventTypeFromProto(response.Type),
+				Hash: response.Hash,
+				Tx:   response.Tx,
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+		}
+	}(txEventsClient)
+
+	return resultCh, nil
+}
+
+func txEventTypeFromProto(t mempoolsvc.TxEventType) TxEventType {
+	switch t {
+	case mempoolsvc.TxEventType_TX_EVENT_TYPE_ADDED:
+		return TxEventAdded
+	case mempoolsvc.TxEventType_TX_EVENT_TYPE_REMOVED:
+		return TxEventRemoved
+	default:
+		return TxEventUnknown
+	}
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// SubmitTx implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) SubmitTx(context.Context, types.Tx) (*SubmitTxResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// CheckTx implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) CheckTx(context.Context, types.Tx) (*abci.ResponseCheckTx, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetTx implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) GetTx(context.Context, []byte) (types.Tx, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetUnconfirmedTxs implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) GetUnconfirmedTxs(context.Context, int64) (*UnconfirmedTxs, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetNumUnconfirmedTxs implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) GetNumUnconfirmedTxs(context.Context) (*UnconfirmedTxs, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetTxEvents implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) GetTxEvents(context.Context, ...GetTxEventsOption) (<-chan TxEvent, error) {
+	panic("mempool service client is disabled")
+}
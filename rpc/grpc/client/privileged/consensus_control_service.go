@@ -0,0 +1,175 @@
+package privileged
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/consensus_control/v1"
+)
+
+// ConsensusState is the full consensus state of a node and of its peers.
+type ConsensusState struct {
+	// The round state of the node.
+	RoundState json.RawMessage
+	Peers      []PeerConsensusState
+}
+
+// PeerConsensusState is the consensus state of a peer of the node.
+type PeerConsensusState struct {
+	NodeAddress string
+	PeerState   json.RawMessage
+}
+
+// DialPeersOptions controls which sets of peers the dialed peers are added to.
+type DialPeersOptions struct {
+	Persistent    bool
+	Unconditional bool
+	Private       bool
+}
+
+// ConsensusControlServiceClient provides access to operational actions on a
+// running node. None of the changes it makes survive a restart of the node.
+type ConsensusControlServiceClient interface {
+	// DialSeeds dials the given seeds, in the form "id@host:port",
+	// asynchronously.
+	DialSeeds(ctx context.Context, seeds []string) error
+
+	// DialPeers dials the given peers, in the form "id@host:port",
+	// asynchronously.
+	DialPeers(ctx context.Context, peers []string, opts DialPeersOptions) error
+
+	// FlushMempool removes all the transactions from the mempool of the node.
+	FlushMempool(ctx context.Context) error
+
+	// DumpConsensusState returns the full consensus state of the node and of
+	// its peers.
+	DumpConsensusState(ctx context.Context) (*ConsensusState, error)
+
+	// SetCreateEmptyBlocks changes whether the node creates empty blocks.
+	SetCreateEmptyBlocks(ctx context.Context, createEmptyBlocks bool) error
+
+	// GetCreateEmptyBlocks returns whether the node currently creates empty
+	// blocks.
+	GetCreateEmptyBlocks(ctx context.Context) (bool, error)
+
+	// SetLogLevel changes the log level of the node. The level has the same
+	// format as the log_level configuration parameter, e.g.
+	// "consensus:debug,*:info".
+	SetLogLevel(ctx context.Context, level string) error
+}
+
+type consensusControlServiceClient struct {
+	inner v1.ConsensusControlServiceClient
+}
+
+func newConsensusControlServiceClient(conn grpc.ClientConn) ConsensusControlServiceClient {
+	return &consensusControlServiceClient{
+		inner: v1.NewConsensusControlServiceClient(conn),
+	}
+}
+
+// DialSeeds implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) DialSeeds(ctx context.Context, seeds []string) error {
+	_, err := c.inner.DialSeeds(ctx, &v1.DialSeedsRequest{Seeds: seeds})
+	return err
+}
+
+// DialPeers implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) DialPeers(ctx context.Context, peers []string, opts DialPeersOptions) error {
+	_, err := c.inner.DialPeers(ctx, &v1.DialPeersRequest{
+		Peers:         peers,
+		Persistent:    opts.Persistent,
+		Unconditional: opts.Unconditional,
+		Private:       opts.Private,
+	})
+	return err
+}
+
+// FlushMempool implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) FlushMempool(ctx context.Context) error {
+	_, err := c.inner.FlushMempool(ctx, &v1.FlushMempoolRequest{})
+	return err
+}
+
+// DumpConsensusState implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) DumpConsensusState(ctx context.Context) (*ConsensusState, error) {
+	res, err := c.inner.DumpConsensusState(ctx, &v1.DumpConsensusStateRequest{})
+	if err != nil {
+		return nil, err
+	}
+	state := &ConsensusState{
+		RoundState: res.RoundState,
+		Peers:      make([]PeerConsensusState, len(res.Peers)),
+	}
+	for i, peer := range res.Peers {
+		state.Peers[i] = PeerConsensusState{
+			NodeAddress: peer.NodeAddress,
+			PeerState:   peer.PeerState,
+		}
+	}
+	return state, nil
+}
+
+// SetCreateEmptyBlocks implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) SetCreateEmptyBlocks(ctx context.Context, createEmptyBlocks bool) error {
+	_, err := c.inner.SetCreateEmptyBlocks(ctx, &v1.SetCreateEmptyBlocksRequest{CreateEmptyBlocks: createEmptyBlocks})
+	return err
+}
+
+// GetCreateEmptyBlocks implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) GetCreateEmptyBlocks(ctx context.Context) (bool, error) {
+	res, err := c.inner.GetCreateEmptyBlocks(ctx, &v1.GetCreateEmptyBlocksRequest{})
+	if err != nil {
+		return false, err
+	}
+	return res.CreateEmptyBlocks, nil
+}
+
+// SetLogLevel implements ConsensusControlServiceClient.
+func (c *consensusControlServiceClient) SetLogLevel(ctx context.Context, level string) error {
+	_, err := c.inner.SetLogLevel(ctx, &v1.SetLogLevelRequest{LogLevel: level})
+	return err
+}
+
+type disabledConsensusControlServiceClient struct{}
+
+func newDisabledConsensusControlServiceClient() ConsensusControlServiceClient {
+	return &disabledConsensusControlServiceClient{}
+}
+
+// DialSeeds implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) DialSeeds(context.Context, []string) error {
+	panic("consensus control service client is disabled")
+}
+
+// DialPeers implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) DialPeers(context.Context, []string, DialPeersOptions) error {
+	panic("consensus control service client is disabled")
+}
+
+// FlushMempool implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) FlushMempool(context.Context) error {
+	panic("consensus control service client is disabled")
+}
+
+// DumpConsensusState implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) DumpConsensusState(context.Context) (*ConsensusState, error) {
+	panic("consensus control service client is disabled")
+}
+
+// SetCreateEmptyBlocks implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) SetCreateEmptyBlocks(context.Context, bool) error {
+	panic("consensus control service client is disabled")
+}
+
+// GetCreateEmptyBlocks implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) GetCreateEmptyBlocks(context.Context) (bool, error) {
+	panic("consensus control service client is disabled")
+}
+
+// SetLogLevel implements ConsensusControlServiceClient.
+func (*disabledConsensusControlServiceClient) SetLogLevel(context.Context, string) error {
+	panic("consensus control service client is disabled")
+}
@@ -0,0 +1,216 @@
+package privileged
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/data_companion/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// CommittedBlock is sent to the data companion via a channel for every
+// committed block. If Error is set, the stream has terminated.
+type CommittedBlock struct {
+	BlockID               types.BlockID
+	Block                 *types.Block
+	FinalizeBlockResponse *abci.ResponseFinalizeBlock
+	Error                 error
+}
+
+type streamBlocksConfig struct {
+	startHeight          int64
+	advanceRetainHeights bool
+	chSize               uint
+}
+
+type StreamBlocksOption func(*streamBlocksConfig)
+
+// StreamBlocksFromHeight starts the stream at the given height. If not used,
+// the stream resumes after the last acknowledged block.
+func StreamBlocksFromHeight(height int64) StreamBlocksOption {
+	return func(opts *streamBlocksConfig) {
+		opts.startHeight = height
+	}
+}
+
+// StreamBlocksAdvanceRetainHeights advances the data companion block and block
+// results retain heights of the node as blocks get acknowledged.
+func StreamBlocksAdvanceRetainHeights() StreamBlocksOption {
+	return func(opts *streamBlocksConfig) {
+		opts.advanceRetainHeights = true
+	}
+}
+
+// StreamBlocksChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func StreamBlocksChannelSize(sz uint) StreamBlocksOption {
+	return func(opts *streamBlocksConfig) {
+		opts.chSize = sz
+	}
+}
+
+// BlockStream is a stream of committed blocks pushed by a node.
+type BlockStream interface {
+	// Blocks returns the channel to which the committed blocks are sent, in
+	// order. It is closed when the stream terminates.
+	Blocks() <-chan CommittedBlock
+
+	// Ack acknowledges all the blocks up to and including the given height,
+	// which will not be streamed again when resuming.
+	Ack(height int64) error
+}
+
+// DataCompanionServiceClient allows a data companion to have the committed
+// blocks and their results pushed by a node.
+type DataCompanionServiceClient interface {
+	// StreamBlocks opens a stream of committed blocks, which follows the chain
+	// as new blocks are committed, until the given context is canceled. Only
+	// one stream can be open at a time.
+	//
+	// Blocks are delivered at least once: unless acknowledged, they are
+	// streamed again when resuming.
+	StreamBlocks(ctx context.Context, opts ...StreamBlocksOption) (BlockStream, error)
+
+	// GetDataCompanionCursor returns the height of the last acknowledged
+	// block, or 0 if no block has been acknowledged yet.
+	GetDataCompanionCursor(ctx context.Context) (int64, error)
+}
+
+type dataCompanionServiceClient struct {
+	inner v1.DataCompanionServiceClient
+}
+
+func newDataCompanionServiceClient(conn grpc.ClientConn) DataCompanionServiceClient {
+	return &dataCompanionServiceClient{
+		inner: v1.NewDataCompanionServiceClient(conn),
+	}
+}
+
+// StreamBlocks implements DataCompanionServiceClient.
+func (c *dataCompanionServiceClient) StreamBlocks(ctx context.Context, opts ...StreamBlocksOption) (BlockStream, error) {
+	cfg := &streamBlocksConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	streamClient, err := c.inner.StreamBlocks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting a stream for blocks: %w", err)
+	}
+	err = streamClient.Send(&v1.StreamBlocksRequest{
+		Request: &v1.StreamBlocksRequest_Start{Start: &v1.StreamBlocksStart{
+			StartHeight:          cfg.startHeight,
+			AdvanceRetainHeights: cfg.advanceRetainHeights,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error starting the stream of blocks: %w", err)
+	}
+	stream := &blockStream{
+		client:   streamClient,
+		resultCh: make(chan CommittedBlock, cfg.chSize),
+	}
+	go stream.receive(ctx)
+	return stream, nil
+}
+
+// GetDataCompanionCursor implements DataCompanionServiceClient.
+func (c *dataCompanionServiceClient) GetDataCompanionCursor(ctx context.Context) (int64, error) {
+	res, err := c.inner.GetCursor(ctx, &v1.GetCursorRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Height, nil
+}
+
+type blockStream struct {
+	client   v1.DataCompanionService_StreamBlocksClient
+	resultCh chan CommittedBlock
+
+	// Serializes the acks sent on the stream.
+	mtx cmtsync.Mutex
+}
+
+// Blocks implements BlockStream.
+func (s *blockStream) Blocks() <-chan CommittedBlock {
+	return s.resultCh
+}
+
+// Ack implements BlockStream.
+func (s *blockStream) Ack(height int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.client.Send(&v1.StreamBlocksRequest{
+		Request: &v1.StreamBlocksRequest_Ack{Ack: &v1.StreamBlocksAck{Height: height}},
+	})
+}
+
+func (s *blockStream) receive(ctx context.Context) {
+	defer close(s.resultCh)
+	for {
+		response, err := s.client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			res := CommittedBlock{Error: fmt.Errorf("error receiving a block from a stream: %w", err)}
+			select {
+			case <-ctx.Done():
+			case s.resultCh <- res:
+			}
+			return
+		}
+		res, err := committedBlockFromProto(response)
+		if err != nil {
+			res = CommittedBlock{Error: fmt.Errorf("error converting a block from its Protobuf representation: %w", err)}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case s.resultCh <- res:
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func committedBlockFromProto(res *v1.StreamBlocksResponse) (CommittedBlock, error) {
+	block, err := types.BlockFromProto(res.Block)
+	if err != nil {
+		return CommittedBlock{}, err
+	}
+	blockID, err := types.BlockIDFromProto(res.BlockId)
+	if err != nil {
+		return CommittedBlock{}, err
+	}
+	if res.FinalizeBlockResponse == nil {
+		return CommittedBlock{}, errors.New("nil finalize block response")
+	}
+	return CommittedBlock{
+		BlockID:               *blockID,
+		Block:                 block,
+		FinalizeBlockResponse: res.FinalizeBlockResponse,
+	}, nil
+}
+
+type disabledDataCompanionServiceClient struct{}
+
+func newDisabledDataCompanionServiceClient() DataCompanionServiceClient {
+	return &disabledDataCompanionServiceClient{}
+}
+
+// StreamBlocks implements DataCompanionServiceClient.
+func (*disabledDataCompanionServiceClient) StreamBlocks(context.Context, ...StreamBlocksOption) (BlockStream, error) {
+	panic("data companion service client is disabled")
+}
+
+// GetDataCompanionCursor implements DataCompanionServiceClient.
+func (*disabledDataCompanionServiceClient) GetDataCompanionCursor(context.Context) (int64, error) {
+	panic("data companion service client is disabled")
+}
@@ -0,0 +1,87 @@
+package privileged
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/peer_filter/v1"
+)
+
+// PeerFilter is the IP ranges and node IDs which a node accepts peers from.
+type PeerFilter struct {
+	// IPs and CIDR ranges, e.g. "10.0.0.0/8", of the peers accepted. If empty,
+	// peers with any IP but the denied ones are accepted.
+	AllowedCIDRs []string
+	// IPs and CIDR ranges of the peers rejected, even if allowed.
+	DeniedCIDRs []string
+	// IDs of the peers accepted. If empty, all peers but the denied ones are
+	// accepted.
+	AllowedIDs []string
+	// IDs of the peers rejected, even if allowed.
+	DeniedIDs []string
+}
+
+// PeerFilterServiceClient allows changing the peers which a running node
+// accepts.
+type PeerFilterServiceClient interface {
+	// GetPeerFilter returns the IP ranges and node IDs which the node accepts
+	// peers from.
+	GetPeerFilter(ctx context.Context) (*PeerFilter, error)
+
+	// SetPeerFilter replaces the IP ranges and node IDs which the node accepts
+	// peers from, and disconnects it from the peers which are no longer
+	// accepted. The changes are lost when the node restarts.
+	SetPeerFilter(ctx context.Context, filter PeerFilter) error
+}
+
+type peerFilterServiceClient struct {
+	inner v1.PeerFilterServiceClient
+}
+
+func newPeerFilterServiceClient(conn grpc.ClientConn) PeerFilterServiceClient {
+	return &peerFilterServiceClient{
+		inner: v1.NewPeerFilterServiceClient(conn),
+	}
+}
+
+// GetPeerFilter implements PeerFilterServiceClient.
+func (c *peerFilterServiceClient) GetPeerFilter(ctx context.Context) (*PeerFilter, error) {
+	res, err := c.inner.GetPeerFilter(ctx, &v1.GetPeerFilterRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &PeerFilter{
+		AllowedCIDRs: res.AllowedCidrs,
+		DeniedCIDRs:  res.DeniedCidrs,
+		AllowedIDs:   res.AllowedIds,
+		DeniedIDs:    res.DeniedIds,
+	}, nil
+}
+
+// SetPeerFilter implements PeerFilterServiceClient.
+func (c *peerFilterServiceClient) SetPeerFilter(ctx context.Context, filter PeerFilter) error {
+	_, err := c.inner.SetPeerFilter(ctx, &v1.SetPeerFilterRequest{
+		AllowedCidrs: filter.AllowedCIDRs,
+		DeniedCidrs:  filter.DeniedCIDRs,
+		AllowedIds:   filter.AllowedIDs,
+		DeniedIds:    filter.DeniedIDs,
+	})
+	return err
+}
+
+type disabledPeerFilterServiceClient struct{}
+
+func newDisabledPeerFilterServiceClient() PeerFilterServiceClient {
+	return &disabledPeerFilterServiceClient{}
+}
+
+// GetPeerFilter implements PeerFilterServiceClient.
+func (*disabledPeerFilterServiceClient) GetPeerFilter(context.Context) (*PeerFilter, error) {
+	panic("peer filter service client is disabled")
+}
+
+// SetPeerFilter implements PeerFilterServiceClient.
+func (*disabledPeerFilterServiceClient) SetPeerFilter(context.Context, PeerFilter) error {
+	panic("peer filter service client is disabled")
+}
@@ -17,6 +17,10 @@ type Option func(*clientBuilder)
 // a CometBFT node via the privileged gRPC server.
 type Client interface {
 	PruningServiceClient
+	ConsensusControlServiceClient
+	DataCompanionServiceClient
+	ReindexServiceClient
+	PeerFilterServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -26,14 +30,22 @@ type clientBuilder struct {
 	dialerFunc func(context.Context, string) (net.Conn, error)
 	grpcOpts   []ggrpc.DialOption
 
-	pruningServiceEnabled bool
+	pruningServiceEnabled          bool
+	consensusControlServiceEnabled bool
+	dataCompanionServiceEnabled    bool
+	reindexServiceEnabled          bool
+	peerFilterServiceEnabled       bool
 }
 
 func newClientBuilder() *clientBuilder {
 	return &clientBuilder{
-		dialerFunc:            defaultDialerFunc,
-		grpcOpts:              make([]ggrpc.DialOption, 0),
-		pruningServiceEnabled: true,
+		dialerFunc:                     defaultDialerFunc,
+		grpcOpts:                       make([]ggrpc.DialOption, 0),
+		pruningServiceEnabled:          true,
+		consensusControlServiceEnabled: true,
+		dataCompanionServiceEnabled:    true,
+		reindexServiceEnabled:          true,
+		peerFilterServiceEnabled:       true,
 	}
 }
 
@@ -45,6 +57,10 @@ type client struct {
 	conn *ggrpc.ClientConn
 
 	PruningServiceClient
+	ConsensusControlServiceClient
+	DataCompanionServiceClient
+	ReindexServiceClient
+	PeerFilterServiceClient
 }
 
 // Close implements Client.
@@ -72,6 +88,51 @@ func WithPruningServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithConsensusControlServiceEnabled allows control of whether or not to
+// create a client for interacting with the consensus control service of a
+// CometBFT node.
+//
+// If disabled and the client attempts to access the consensus control service
+// API, the client will panic.
+func WithConsensusControlServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.consensusControlServiceEnabled = enabled
+	}
+}
+
+// WithDataCompanionServiceEnabled allows control of whether or not to create a
+// client for interacting with the data companion service of a CometBFT node.
+//
+// If disabled and the client attempts to access the data companion service
+// API, the client will panic.
+func WithDataCompanionServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.dataCompanionServiceEnabled = enabled
+	}
+}
+
+// WithReindexServiceEnabled allows control of whether or not to create a
+// client for interacting with the reindex service of a CometBFT node.
+//
+// If disabled and the client attempts to access the reindex service API, the
+// client will panic.
+func WithReindexServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.reindexServiceEnabled = enabled
+	}
+}
+
+// WithPeerFilterServiceEnabled allows control of whether or not to create a
+// client for interacting with the peer filter service of a CometBFT node.
+//
+// If disabled and the client attempts to access the peer filter service API,
+// the client will panic.
+func WithPeerFilterServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.peerFilterServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -103,8 +164,28 @@ func New(ctx context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.pruningServiceEnabled {
 		pruningServiceClient = newPruningServiceClient(conn)
 	}
+	consensusControlServiceClient := newDisabledConsensusControlServiceClient()
+	if builder.consensusControlServiceEnabled {
+		consensusControlServiceClient = newConsensusControlServiceClient(conn)
+	}
+	dataCompanionServiceClient := newDisabledDataCompanionServiceClient()
+	if builder.dataCompanionServiceEnabled {
+		dataCompanionServiceClient = newDataCompanionServiceClient(conn)
+	}
+	reindexServiceClient := newDisabledReindexServiceClient()
+	if builder.reindexServiceEnabled {
+		reindexServiceClient = newReindexServiceClient(conn)
+	}
+	peerFilterServiceClient := newDisabledPeerFilterServiceClient()
+	if builder.peerFilterServiceEnabled {
+		peerFilterServiceClient = newPeerFilterServiceClient(conn)
+	}
 	return &client{
-		conn:                 conn,
-		PruningServiceClient: pruningServiceClient,
+		conn:                          conn,
+		PruningServiceClient:          pruningServiceClient,
+		ConsensusControlServiceClient: consensusControlServiceClient,
+		DataCompanionServiceClient:    dataCompanionServiceClient,
+		ReindexServiceClient:          reindexServiceClient,
+		PeerFilterServiceClient:       peerFilterServiceClient,
 	}, nil
 }
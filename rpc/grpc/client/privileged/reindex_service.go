@@ -0,0 +1,103 @@
+package privileged
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/reindex/v1"
+)
+
+// ReindexStatus is the progress of the latest reindexing of a node.
+type ReindexStatus struct {
+	// Is the reindexing still in progress?
+	Running     bool
+	StartHeight int64
+	EndHeight   int64
+	// The last height reindexed, or StartHeight-1 if none was.
+	LastHeight int64
+	// The error which stopped the reindexing, if any.
+	Error string
+}
+
+// ReindexServiceClient allows rebuilding the transaction and block indexes of
+// a running node.
+type ReindexServiceClient interface {
+	// Reindex starts reindexing the heights from startHeight to endHeight,
+	// inclusive, in the background, and returns the range being reindexed. A
+	// startHeight of 0 stands for the base height of the node, and an
+	// endHeight of 0 for its latest height. Only one reindexing can be in
+	// progress at a time.
+	Reindex(ctx context.Context, startHeight, endHeight int64) (int64, int64, error)
+
+	// GetReindexStatus returns the progress of the latest reindexing.
+	GetReindexStatus(ctx context.Context) (*ReindexStatus, error)
+
+	// CancelReindex stops the reindexing in progress, if any, and returns
+	// whether there was one.
+	CancelReindex(ctx context.Context) (bool, error)
+}
+
+type reindexServiceClient struct {
+	inner v1.ReindexServiceClient
+}
+
+func newReindexServiceClient(conn grpc.ClientConn) ReindexServiceClient {
+	return &reindexServiceClient{
+		inner: v1.NewReindexServiceClient(conn),
+	}
+}
+
+// Reindex implements ReindexServiceClient.
+func (c *reindexServiceClient) Reindex(ctx context.Context, startHeight, endHeight int64) (int64, int64, error) {
+	res, err := c.inner.Reindex(ctx, &v1.ReindexRequest{StartHeight: startHeight, EndHeight: endHeight})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.StartHeight, res.EndHeight, nil
+}
+
+// GetReindexStatus implements ReindexServiceClient.
+func (c *reindexServiceClient) GetReindexStatus(ctx context.Context) (*ReindexStatus, error) {
+	res, err := c.inner.GetReindexStatus(ctx, &v1.GetReindexStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &ReindexStatus{
+		Running:     res.Running,
+		StartHeight: res.StartHeight,
+		EndHeight:   res.EndHeight,
+		LastHeight:  res.LastHeight,
+		Error:       res.Error,
+	}, nil
+}
+
+// CancelReindex implements ReindexServiceClient.
+func (c *reindexServiceClient) CancelReindex(ctx context.Context) (bool, error) {
+	res, err := c.inner.CancelReindex(ctx, &v1.CancelReindexRequest{})
+	if err != nil {
+		return false, err
+	}
+	return res.Canceled, nil
+}
+
+type disabledReindexServiceClient struct{}
+
+func newDisabledReindexServiceClient() ReindexServiceClient {
+	return &disabledReindexServiceClient{}
+}
+
+// Reindex implements ReindexServiceClient.
+func (*disabledReindexServiceClient) Reindex(context.Context, int64, int64) (int64, int64, error) {
+	panic("reindex service client is disabled")
+}
+
+// GetReindexStatus implements ReindexServiceClient.
+func (*disabledReindexServiceClient) GetReindexStatus(context.Context) (*ReindexStatus, error) {
+	panic("reindex service client is disabled")
+}
+
+// CancelReindex implements ReindexServiceClient.
+func (*disabledReindexServiceClient) CancelReindex(context.Context) (bool, error) {
+	panic("reindex service client is disabled")
+}
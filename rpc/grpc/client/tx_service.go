@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	txsvc "github.com/cometbft/cometbft/proto/tendermint/services/tx/v1"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/grpc"
+)
+
+// IndexedTx is a transaction returned by the CometBFT TxService gRPC API.
+type IndexedTx struct {
+	Hash     []byte            `json:"hash"`
+	Height   int64             `json:"height"`
+	Index    uint32            `json:"index"`
+	Tx       types.Tx          `json:"tx"`
+	TxResult abci.ExecTxResult `json:"tx_result"`
+	Proof    *types.TxProof    `json:"proof,omitempty"`
+}
+
+func indexedTxFromProto(ptx *txsvc.IndexedTx) (*IndexedTx, error) {
+	tx := &IndexedTx{
+		Hash:   ptx.Hash,
+		Height: ptx.Height,
+		Index:  ptx.Index,
+		Tx:     ptx.Tx,
+	}
+	if ptx.TxResult != nil {
+		tx.TxResult = *ptx.TxResult
+	}
+	if ptx.Proof != nil {
+		proof, err := types.TxProofFromProto(*ptx.Proof)
+		if err != nil {
+			return nil, err
+		}
+		tx.Proof = &proof
+	}
+	return tx, nil
+}
+
+// TxSearchResult is sent to the client via a channel for every transaction
+// matching a search. If Error is set, the search has terminated early.
+type TxSearchResult struct {
+	Tx    *IndexedTx
+	Error error
+}
+
+type searchTxsConfig struct {
+	prove      bool
+	descending bool
+	after      *txsvc.Cursor
+	limit      uint32
+	chSize     uint
+}
+
+type SearchTxsOption func(*searchTxsConfig)
+
+// SearchTxsWithProofs includes the merkle proofs of the inclusion of the
+// transactions in their blocks.
+func SearchTxsWithProofs() SearchTxsOption {
+	return func(opts *searchTxsConfig) {
+		opts.prove = true
+	}
+}
+
+// SearchTxsDescending returns the transactions in descending order of height,
+// then index in the block. By default, they are returned in ascending order.
+func SearchTxsDescending() SearchTxsOption {
+	return func(opts *searchTxsConfig) {
+		opts.descending = true
+	}
+}
+
+// SearchTxsAfter only returns the transactions after the one at the given
+// height and index, in the requested order. To resume an interrupted search,
+// use the height and index of the last transaction received.
+func SearchTxsAfter(height int64, index uint32) SearchTxsOption {
+	return func(opts *searchTxsConfig) {
+		opts.after = &txsvc.Cursor{Height: height, Index: index}
+	}
+}
+
+// SearchTxsLimit limits the number of transactions returned. If not used or
+// the limit is set to 0, all matching transactions are returned.
+func SearchTxsLimit(limit uint32) SearchTxsOption {
+	return func(opts *searchTxsConfig) {
+		opts.limit = limit
+	}
+}
+
+// SearchTxsChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func SearchTxsChannelSize(sz uint) SearchTxsOption {
+	return func(opts *searchTxsConfig) {
+		opts.chSize = sz
+	}
+}
+
+// TxServiceClient provides access to the transactions indexed by a node.
+type TxServiceClient interface {
+	// GetTxByHash attempts to retrieve the indexed transaction with the given
+	// hash, optionally with a merkle proof of its inclusion in its block.
+	GetTxByHash(ctx context.Context, hash []byte, prove bool) (*IndexedTx, error)
+
+	// SearchTxs sends the indexed transactions matching the given query to the
+	// resulting output channel, which is closed once all of them have been
+	// sent. The query has the same format as the query accepted by the
+	// JSON-RPC tx_search endpoint, e.g. "tx.height = 5".
+	SearchTxs(ctx context.Context, query string, opts ...SearchTxsOption) (<-chan TxSearchResult, error)
+}
+
+type txServiceClient struct {
+	client txsvc.TxServiceClient
+}
+
+func newTxServiceClient(conn grpc.ClientConn) TxServiceClient {
+	return &txServiceClient{
+		client: txsvc.NewTxServiceClient(conn),
+	}
+}
+
+// GetTxByHash implements TxServiceClient.
+func (c *txServiceClient) GetTxByHash(ctx context.Context, hash []byte, prove bool) (*IndexedTx, error) {
+	res, err := c.client.GetByHash(ctx, &txsvc.GetByHashRequest{
+		Hash:  hash,
+		Prove: prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return indexedTxFromProto(res.Tx)
+}
+
+// SearchTxs implements TxServiceClient.
+func (c *txServiceClient) SearchTxs(ctx context.Context, query string, opts ...SearchTxsOption) (<-chan TxSearchResult, error) {
+	cfg := &searchTxsConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	req := &txsvc.SearchRequest{
+		Query:   query,
+		Prove:   cfg.prove,
+		OrderBy: txsvc.OrderBy_ORDER_BY_ASC,
+		After:   cfg.after,
+		Limit:   cfg.limit,
+	}
+	if cfg.descending {
+		req.OrderBy = txsvc.OrderBy_ORDER_BY_DESC
+	}
+
+	searchClient, err := c.client.Search(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting a stream for the tx search: %w", err)
+	}
+	resultCh := make(chan TxSearchResult, cfg.chSize)
+
+	go func(client txsvc.TxService_SearchClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					// All the results have been received.
+					return
+				}
+				res := TxSearchResult{Error: fmt.Errorf("error receiving a tx search result from a stream: %w", err)}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			tx, err := indexedTxFromProto(response.Tx)
+			res := TxSearchResult{Tx: tx}
+			if err != nil {
+				res = TxSearchResult{Error: fmt.Errorf("error converting a tx search result from its Protobuf representation: %w", err)}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}(searchClient)
+
+	return resultCh, nil
+}
+
+type disabledTxServiceClient struct{}
+
+func newDisabledTxServiceClient() TxServiceClient {
+	return &disabledTxServiceClient{}
+}
+
+// GetTxByHash implements TxServiceClient - disabled client.
+func (*disabledTxServiceClient) GetTxByHash(context.Context, []byte, bool) (*IndexedTx, error) {
+	panic("tx service client is disabled")
+}
+
+// SearchTxs implements TxServiceClient - disabled client.
+func (*disabledTxServiceClient) SearchTxs(context.Context, string, ...SearchTxsOption) (<-chan TxSearchResult, error) {
+	panic("tx service client is disabled")
+}
@@ -7,9 +7,19 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	pbconsensuscontrolsvc "github.com/cometbft/cometbft/proto/tendermint/services/consensus_control/v1"
+	pbdatacompanionsvc "github.com/cometbft/cometbft/proto/tendermint/services/data_companion/v1"
+	pbpeerfiltersvc "github.com/cometbft/cometbft/proto/tendermint/services/peer_filter/v1"
 	pbpruningsvc "github.com/cometbft/cometbft/proto/tendermint/services/pruning/v1"
+	pbreindexsvc "github.com/cometbft/cometbft/proto/tendermint/services/reindex/v1"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/consensuscontrolservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/datacompanionservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/peerfilterservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/pruningservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/reindexservice"
 	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
 )
 
 // Option is any function that allows for configuration of the gRPC server
@@ -17,10 +27,14 @@ import (
 type Option func(*serverBuilder)
 
 type serverBuilder struct {
-	listener       net.Listener
-	pruningService pbpruningsvc.PruningServiceServer
-	logger         log.Logger
-	grpcOpts       []grpc.ServerOption
+	listener                net.Listener
+	pruningService          pbpruningsvc.PruningServiceServer
+	consensusControlService pbconsensuscontrolsvc.ConsensusControlServiceServer
+	dataCompanionService    pbdatacompanionsvc.DataCompanionServiceServer
+	reindexService          pbreindexsvc.ReindexServiceServer
+	peerFilterService       pbpeerfiltersvc.PeerFilterServiceServer
+	logger                  log.Logger
+	grpcOpts                []grpc.ServerOption
 }
 
 func newServerBuilder(listener net.Listener) *serverBuilder {
@@ -38,6 +52,45 @@ func WithPruningService(pruner *sm.Pruner, logger log.Logger) Option {
 	}
 }
 
+// WithConsensusControlService enables the consensus control service on the
+// CometBFT privileged server. If logLevelSetter is nil, the log level of the
+// node cannot be changed via the service.
+func WithConsensusControlService(
+	peers consensuscontrolservice.Peers,
+	consensus consensuscontrolservice.Consensus,
+	mempool mempl.Mempool,
+	logLevelSetter log.LevelSetter,
+	logger log.Logger,
+) Option {
+	return func(b *serverBuilder) {
+		b.consensusControlService = consensuscontrolservice.New(peers, consensus, mempool, logLevelSetter, logger)
+	}
+}
+
+// WithDataCompanionService enables the data companion service on the CometBFT
+// privileged server.
+func WithDataCompanionService(blockStore *store.BlockStore, stateStore sm.Store, pruner *sm.Pruner, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.dataCompanionService = datacompanionservice.New(blockStore, stateStore, pruner, logger)
+	}
+}
+
+// WithReindexService enables the reindex service on the CometBFT privileged
+// server.
+func WithReindexService(reindexer *sm.Reindexer, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.reindexService = reindexservice.New(reindexer, logger)
+	}
+}
+
+// WithPeerFilterService enables the peer filter service on the CometBFT
+// privileged server.
+func WithPeerFilterService(peers peerfilterservice.Peers, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.peerFilterService = peerfilterservice.New(peers, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -69,6 +122,22 @@ func Serve(listener net.Listener, opts ...Option) error {
 		pbpruningsvc.RegisterPruningServiceServer(server, b.pruningService)
 		b.logger.Debug("Registered pruning service")
 	}
+	if b.consensusControlService != nil {
+		pbconsensuscontrolsvc.RegisterConsensusControlServiceServer(server, b.consensusControlService)
+		b.logger.Debug("Registered consensus control service")
+	}
+	if b.dataCompanionService != nil {
+		pbdatacompanionsvc.RegisterDataCompanionServiceServer(server, b.dataCompanionService)
+		b.logger.Debug("Registered data companion service")
+	}
+	if b.reindexService != nil {
+		pbreindexsvc.RegisterReindexServiceServer(server, b.reindexService)
+		b.logger.Debug("Registered reindex service")
+	}
+	if b.peerFilterService != nil {
+		pbpeerfiltersvc.RegisterPeerFilterServiceServer(server, b.peerFilterService)
+		b.logger.Debug("Registered peer filter service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting privileged gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -5,6 +5,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
 
@@ -14,10 +15,19 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
 	pbblocksvc "github.com/cometbft/cometbft/proto/tendermint/services/block/v1"
+	pbeventsvc "github.com/cometbft/cometbft/proto/tendermint/services/event/v1"
+	pbmempoolsvc "github.com/cometbft/cometbft/proto/tendermint/services/mempool/v1"
+	pbtxsvc "github.com/cometbft/cometbft/proto/tendermint/services/tx/v1"
 	pbversionsvc "github.com/cometbft/cometbft/proto/tendermint/services/version/v1"
+	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/eventservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/mempoolservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/txservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -30,6 +40,9 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	mempoolService      pbmempoolsvc.MempoolServiceServer
+	eventService        pbeventsvc.EventServiceServer
+	txService           pbtxsvc.TxServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -78,6 +91,36 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithMempoolService enables the mempool service on the CometBFT server.
+// Transactions are checked against the application via proxyAppMempool, and
+// are not accepted while the mempool reactor is waiting for the node to sync.
+func WithMempoolService(
+	mempool mempl.Mempool,
+	proxyAppMempool proxy.AppConnMempool,
+	reactor mempoolservice.SyncReactor,
+	logger log.Logger,
+) Option {
+	return func(b *serverBuilder) {
+		b.mempoolService = mempoolservice.New(mempool, proxyAppMempool, reactor, logger)
+	}
+}
+
+// WithEventService enables the event service on the CometBFT server.
+// Subscriptions are subject to the limits set in the given RPC configuration.
+func WithEventService(eventBus *types.EventBus, cfg *config.RPCConfig, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.eventService = eventservice.New(eventBus, cfg, logger)
+	}
+}
+
+// WithTxService enables the tx service on the CometBFT server. The block
+// store is used to build the merkle proofs of the indexed transactions.
+func WithTxService(txIndexer txindex.TxIndexer, store *store.BlockStore, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.txService = txservice.New(txIndexer, store, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -117,6 +160,18 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.mempoolService != nil {
+		pbmempoolsvc.RegisterMempoolServiceServer(server, b.mempoolService)
+		b.logger.Debug("Registered mempool service")
+	}
+	if b.eventService != nil {
+		pbeventsvc.RegisterEventServiceServer(server, b.eventService)
+		b.logger.Debug("Registered event service")
+	}
+	if b.txService != nil {
+		pbtxsvc.RegisterTxServiceServer(server, b.txService)
+		b.logger.Debug("Registered tx service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -0,0 +1,186 @@
+package consensuscontrolservice
+
+import (
+	context "context"
+	"strings"
+
+	cm "github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/consensus_control/v1"
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Peers is implemented by the p2p switch, which manages the connections to
+// the peers of the node.
+type Peers interface {
+	AddPersistentPeers(addrs []string) error
+	AddUnconditionalPeerIDs(ids []string) error
+	AddPrivatePeerIDs(ids []string) error
+	DialPeersAsync(addrs []string) error
+	Peers() p2p.IPeerSet
+}
+
+// Consensus is implemented by the consensus state.
+type Consensus interface {
+	GetRoundStateJSON() ([]byte, error)
+	CreateEmptyBlocks() bool
+	SetCreateEmptyBlocks(createEmptyBlocks bool)
+}
+
+type consensusControlServiceServer struct {
+	peers          Peers
+	consensus      Consensus
+	mempool        mempl.Mempool
+	logLevelSetter log.LevelSetter
+	logger         log.Logger
+}
+
+// New creates a new CometBFT consensus control service server.
+//
+// If logLevelSetter is nil, the log level of the node cannot be changed and
+// SetLogLevel fails.
+func New(
+	peers Peers,
+	consensus Consensus,
+	mempool mempl.Mempool,
+	logLevelSetter log.LevelSetter,
+	logger log.Logger,
+) v1.ConsensusControlServiceServer {
+	return &consensusControlServiceServer{
+		peers:          peers,
+		consensus:      consensus,
+		mempool:        mempool,
+		logLevelSetter: logLevelSetter,
+		logger:         logger.With("service", "ConsensusControlService"),
+	}
+}
+
+// DialSeeds implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) DialSeeds(_ context.Context, req *v1.DialSeedsRequest) (*v1.DialSeedsResponse, error) {
+	logger := s.logger.With("endpoint", "DialSeeds")
+	if len(req.Seeds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No seeds provided")
+	}
+	logger.Info("Dialing seeds", "seeds", req.Seeds)
+	if err := s.peers.DialPeersAsync(req.Seeds); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot dial seeds: %s", err)
+	}
+	return &v1.DialSeedsResponse{}, nil
+}
+
+// DialPeers implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) DialPeers(_ context.Context, req *v1.DialPeersRequest) (*v1.DialPeersResponse, error) {
+	logger := s.logger.With("endpoint", "DialPeers")
+	if len(req.Peers) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No peers provided")
+	}
+	ids := make([]string, 0, len(req.Peers))
+	for _, peer := range req.Peers {
+		spl := strings.Split(peer, "@")
+		if len(spl) != 2 {
+			return nil, status.Error(codes.InvalidArgument, p2p.ErrNetAddressNoID{Addr: peer}.Error())
+		}
+		ids = append(ids, spl[0])
+	}
+
+	logger.Info("Dialing peers", "peers", req.Peers, "persistent", req.Persistent,
+		"unconditional", req.Unconditional, "private", req.Private)
+
+	if req.Persistent {
+		if err := s.peers.AddPersistentPeers(req.Peers); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Cannot add persistent peers: %s", err)
+		}
+	}
+	if req.Private {
+		if err := s.peers.AddPrivatePeerIDs(ids); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Cannot add private peers: %s", err)
+		}
+	}
+	if req.Unconditional {
+		if err := s.peers.AddUnconditionalPeerIDs(ids); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Cannot add unconditional peers: %s", err)
+		}
+	}
+	if err := s.peers.DialPeersAsync(req.Peers); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot dial peers: %s", err)
+	}
+	return &v1.DialPeersResponse{}, nil
+}
+
+// FlushMempool implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) FlushMempool(context.Context, *v1.FlushMempoolRequest) (*v1.FlushMempoolResponse, error) {
+	s.logger.With("endpoint", "FlushMempool").Info("Flushing mempool")
+	s.mempool.Flush()
+	return &v1.FlushMempoolResponse{}, nil
+}
+
+// DumpConsensusState implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) DumpConsensusState(context.Context, *v1.DumpConsensusStateRequest) (*v1.DumpConsensusStateResponse, error) {
+	logger := s.logger.With("endpoint", "DumpConsensusState")
+
+	peers := s.peers.Peers().List()
+	peerStates := make([]*v1.PeerConsensusState, 0, len(peers))
+	for _, peer := range peers {
+		peerState, ok := peer.Get(types.PeerStateKey).(*cm.PeerState)
+		if !ok { // peer does not have a state yet
+			continue
+		}
+		peerStateJSON, err := peerState.MarshalJSON()
+		if err != nil {
+			return nil, internalError("Failed to marshal peer consensus state", err, logger)
+		}
+		peerStates = append(peerStates, &v1.PeerConsensusState{
+			NodeAddress: peer.SocketAddr().String(),
+			PeerState:   peerStateJSON,
+		})
+	}
+
+	roundState, err := s.consensus.GetRoundStateJSON()
+	if err != nil {
+		return nil, internalError("Failed to marshal round state", err, logger)
+	}
+	return &v1.DumpConsensusStateResponse{
+		RoundState: roundState,
+		Peers:      peerStates,
+	}, nil
+}
+
+// SetCreateEmptyBlocks implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) SetCreateEmptyBlocks(_ context.Context, req *v1.SetCreateEmptyBlocksRequest) (*v1.SetCreateEmptyBlocksResponse, error) {
+	s.logger.With("endpoint", "SetCreateEmptyBlocks").Info("Setting create_empty_blocks", "createEmptyBlocks", req.CreateEmptyBlocks)
+	s.consensus.SetCreateEmptyBlocks(req.CreateEmptyBlocks)
+	return &v1.SetCreateEmptyBlocksResponse{}, nil
+}
+
+// GetCreateEmptyBlocks implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) GetCreateEmptyBlocks(context.Context, *v1.GetCreateEmptyBlocksRequest) (*v1.GetCreateEmptyBlocksResponse, error) {
+	return &v1.GetCreateEmptyBlocksResponse{CreateEmptyBlocks: s.consensus.CreateEmptyBlocks()}, nil
+}
+
+// SetLogLevel implements v1.ConsensusControlServiceServer.
+func (s *consensusControlServiceServer) SetLogLevel(_ context.Context, req *v1.SetLogLevelRequest) (*v1.SetLogLevelResponse, error) {
+	logger := s.logger.With("endpoint", "SetLogLevel")
+	if s.logLevelSetter == nil {
+		return nil, status.Error(codes.FailedPrecondition, "The log level of the node cannot be changed at runtime")
+	}
+	if err := s.logLevelSetter.SetLevel(req.LogLevel); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid log level: %s", err)
+	}
+	logger.Info("Changed log level", "logLevel", req.LogLevel)
+	return &v1.SetLogLevelResponse{}, nil
+}
+
+func internalError(msg string, err error, logger log.Logger) error {
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
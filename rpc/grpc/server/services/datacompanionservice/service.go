@@ -0,0 +1,229 @@
+package datacompanionservice
+
+import (
+	context "context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/data_companion/v1"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pollInterval is the interval at which the block store is checked for new
+// blocks once the stream has caught up with the chain.
+const pollInterval = 100 * time.Millisecond
+
+// errNotYetAvailable is returned when the latest block has been stored, but
+// not the response of the application to its execution.
+var errNotYetAvailable = errors.New("finalize block response not yet available")
+
+type dataCompanionServiceServer struct {
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	pruner     *sm.Pruner
+	logger     log.Logger
+
+	// Only one data companion can stream blocks at a time.
+	streaming atomic.Bool
+}
+
+// New creates a new CometBFT data companion service server.
+func New(blockStore *store.BlockStore, stateStore sm.Store, pruner *sm.Pruner, logger log.Logger) v1.DataCompanionServiceServer {
+	return &dataCompanionServiceServer{
+		blockStore: blockStore,
+		stateStore: stateStore,
+		pruner:     pruner,
+		logger:     logger.With("service", "DataCompanionService"),
+	}
+}
+
+// GetCursor implements v1.DataCompanionServiceServer.
+func (s *dataCompanionServiceServer) GetCursor(context.Context, *v1.GetCursorRequest) (*v1.GetCursorResponse, error) {
+	logger := s.logger.With("endpoint", "GetCursor")
+	height, err := s.stateStore.GetDataCompanionCursor()
+	if err != nil {
+		return nil, internalError("Failed to get data companion cursor", err, logger)
+	}
+	return &v1.GetCursorResponse{Height: height}, nil
+}
+
+// StreamBlocks implements v1.DataCompanionServiceServer.
+func (s *dataCompanionServiceServer) StreamBlocks(stream v1.DataCompanionService_StreamBlocksServer) error {
+	logger := s.logger.With("endpoint", "StreamBlocks")
+
+	if !s.streaming.CompareAndSwap(false, true) {
+		return status.Error(codes.AlreadyExists, "A data companion is already streaming blocks")
+	}
+	defer s.streaming.Store(false)
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "The first request must be a start request")
+	}
+	if start.StartHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid start height %d", start.StartHeight)
+	}
+	if start.AdvanceRetainHeights && !s.pruner.CompanionEnabled() {
+		return status.Error(codes.FailedPrecondition, "Cannot advance retain heights: data companion pruning is disabled")
+	}
+	cursor, err := s.stateStore.GetDataCompanionCursor()
+	if err != nil {
+		return internalError("Failed to get data companion cursor", err, logger)
+	}
+
+	height := start.StartHeight
+	if height == 0 {
+		height = cursor + 1
+	}
+	// If nothing was requested nor acknowledged yet, start at the lowest
+	// available block, whatever it is.
+	startAtBase := start.StartHeight == 0 && cursor == 0
+	logger.Info("Data companion started streaming blocks", "height", height, "cursor", cursor,
+		"advanceRetainHeights", start.AdvanceRetainHeights)
+
+	var lastSent atomic.Int64
+	ackErrCh := make(chan error, 1)
+	go func() {
+		ackErrCh <- s.receiveAcks(stream, cursor, &lastSent, start.AdvanceRetainHeights, logger)
+	}()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		for height <= s.blockStore.Height() {
+			if base := s.blockStore.Base(); height < base {
+				if !startAtBase {
+					return status.Errorf(codes.FailedPrecondition, "Block at height %d has been pruned, lowest available height is %d", height, base)
+				}
+				height = base
+			}
+			startAtBase = false
+
+			res, err := s.committedBlock(height)
+			if errors.Is(err, errNotYetAvailable) {
+				break
+			}
+			if err != nil {
+				return internalError("Failed to load committed block", err, logger)
+			}
+			if err := stream.Send(res); err != nil {
+				if stream.Context().Err() != nil {
+					return status.FromContextError(stream.Context().Err()).Err()
+				}
+				return internalError("Cannot send stream response", err, logger)
+			}
+			lastSent.Store(height)
+			height++
+		}
+
+		select {
+		case err := <-ackErrCh:
+			return err
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// receiveAcks processes the acks sent by the data companion until the stream
+// terminates. It returns nil if the data companion closed the stream.
+func (s *dataCompanionServiceServer) receiveAcks(
+	stream v1.DataCompanionService_StreamBlocksServer,
+	cursor int64,
+	lastSent *atomic.Int64,
+	advanceRetainHeights bool,
+	logger log.Logger,
+) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		ack := req.GetAck()
+		if ack == nil {
+			return status.Error(codes.InvalidArgument, "Expected an ack")
+		}
+		if ack.Height <= 0 || ack.Height > lastSent.Load() {
+			return status.Errorf(codes.InvalidArgument, "Cannot acknowledge block %d, which has not been sent", ack.Height)
+		}
+		// Acks are cumulative, so older ones do not move the cursor back.
+		if ack.Height <= cursor {
+			continue
+		}
+		if err := s.stateStore.SaveDataCompanionCursor(ack.Height); err != nil {
+			return internalError("Failed to save data companion cursor", err, logger)
+		}
+		cursor = ack.Height
+
+		if !advanceRetainHeights {
+			continue
+		}
+		if err := s.pruner.SetCompanionBlockRetainHeight(ack.Height); err != nil && !errors.Is(err, sm.ErrPrunerCannotLowerRetainHeight) {
+			return internalError("Failed to set block retain height", err, logger)
+		}
+		if err := s.pruner.SetABCIResRetainHeight(ack.Height); err != nil && !errors.Is(err, sm.ErrPrunerCannotLowerRetainHeight) {
+			return internalError("Failed to set block results retain height", err, logger)
+		}
+	}
+}
+
+func (s *dataCompanionServiceServer) committedBlock(height int64) (*v1.StreamBlocksResponse, error) {
+	blockMeta := s.blockStore.LoadBlockMeta(height)
+	block := s.blockStore.LoadBlock(height)
+	if blockMeta == nil || block == nil {
+		return nil, status.Errorf(codes.NotFound, "Block not found for height %d", height)
+	}
+	res, err := s.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		var errNoResponses sm.ErrNoABCIResponsesForHeight
+		switch {
+		case errors.As(err, &errNoResponses) && height == s.blockStore.Height():
+			// The block has just been committed and is still being executed.
+			return nil, errNotYetAvailable
+		case errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted):
+			return nil, status.Error(codes.FailedPrecondition, "Finalize block responses are not persisted by the node (see discard_abci_responses)")
+		case errors.As(err, &errNoResponses):
+			return nil, status.Errorf(codes.FailedPrecondition, "Finalize block response for height %d has been pruned", height)
+		default:
+			return nil, err
+		}
+	}
+	pb, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	blockID := blockMeta.BlockID.ToProto()
+	return &v1.StreamBlocksResponse{
+		BlockId:               &blockID,
+		Block:                 pb,
+		FinalizeBlockResponse: res,
+	}, nil
+}
+
+func internalError(msg string, err error, logger log.Logger) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
@@ -0,0 +1,220 @@
+package eventservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	eventsvc "github.com/cometbft/cometbft/proto/tendermint/services/event/v1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// maxQueryLength is the maximum length of a query string that will be
+// accepted. This is just a safety check to avoid outlandish queries.
+const maxQueryLength = 512
+
+type eventServiceServer struct {
+	eventBus *types.EventBus
+	config   *config.RPCConfig
+	logger   log.Logger
+}
+
+// New creates a new CometBFT event service server.
+//
+// Subscriptions are subject to the same limits as the JSON-RPC subscribe
+// endpoint, given by the max_subscription_clients,
+// max_subscriptions_per_client and experimental_subscription_buffer_size
+// parameters of the RPC configuration.
+func New(eventBus *types.EventBus, cfg *config.RPCConfig, logger log.Logger) eventsvc.EventServiceServer {
+	return &eventServiceServer{
+		eventBus: eventBus,
+		config:   cfg,
+		logger:   logger.With("service", "EventService"),
+	}
+}
+
+// Subscribe implements v1.EventServiceServer.
+func (s *eventServiceServer) Subscribe(req *eventsvc.SubscribeRequest, stream eventsvc.EventService_SubscribeServer) error {
+	logger := s.logger.With("endpoint", "Subscribe")
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	// As with websocket connections, the remote address of the client
+	// identifies the subscriber. All the streams opened by a client share the
+	// same connection, and therefore the same address.
+	subscriber := traceID
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		subscriber = p.Addr.String()
+	}
+
+	if s.eventBus.NumClients() >= s.config.MaxSubscriptionClients {
+		return status.Errorf(codes.ResourceExhausted, "max_subscription_clients %d reached", s.config.MaxSubscriptionClients)
+	} else if s.eventBus.NumClientSubscriptions(subscriber) >= s.config.MaxSubscriptionsPerClient {
+		return status.Errorf(codes.ResourceExhausted, "max_subscriptions_per_client %d reached", s.config.MaxSubscriptionsPerClient)
+	} else if len(req.Query) > maxQueryLength {
+		return status.Error(codes.InvalidArgument, "Maximum query length exceeded")
+	}
+
+	q, err := cmtquery.New(req.Query)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Failed to parse query: %s", err)
+	}
+
+	sub, err := s.eventBus.Subscribe(stream.Context(), subscriber, q, s.config.SubscriptionBufferSize)
+	if err != nil {
+		if errors.Is(err, cmtpubsub.ErrAlreadySubscribed) {
+			return status.Error(codes.AlreadyExists, "Already subscribed to this query")
+		}
+		logger.Error("Cannot subscribe to events", "err", err, "query", req.Query, "traceID", traceID)
+		return status.Errorf(codes.Internal, "Cannot subscribe to events (see logs for trace ID: %s)", traceID)
+	}
+	logger.Info("Subscribed to query", "remote", subscriber, "query", req.Query)
+	defer func() {
+		if err := s.eventBus.Unsubscribe(context.Background(), subscriber, q); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Error unsubscribing from event bus", "err", err, "traceID", traceID)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			res, err := subscribeResponse(req.Query, msg)
+			if err != nil {
+				logger.Error("Failed to convert event to its Protobuf representation", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Internal, "Internal server error (see logs for trace ID: %s)", traceID)
+			}
+			if err := stream.Send(res); err != nil {
+				logger.Error("Failed to stream event", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-sub.Canceled():
+			switch {
+			case errors.Is(sub.Err(), cmtpubsub.ErrUnsubscribed):
+				return status.Error(codes.Canceled, "Subscription terminated")
+			case errors.Is(sub.Err(), cmtpubsub.ErrOutOfCapacity):
+				return status.Error(codes.ResourceExhausted, "Subscription canceled because the client is too slow")
+			case sub.Err() == nil:
+				return status.Error(codes.Canceled, "Subscription canceled without errors")
+			default:
+				logger.Info("Subscription canceled with errors", "err", sub.Err(), "traceID", traceID)
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+func subscribeResponse(query string, msg cmtpubsub.Message) (*eventsvc.SubscribeResponse, error) {
+	res := &eventsvc.SubscribeResponse{
+		Query:  query,
+		Events: make(map[string]*eventsvc.EventAttributeValues, len(msg.Events())),
+	}
+	for key, values := range msg.Events() {
+		res.Events[key] = &eventsvc.EventAttributeValues{Values: values}
+	}
+
+	switch data := msg.Data().(type) {
+	case types.EventDataNewBlock:
+		pb, err := data.Block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		blockID := data.BlockID.ToProto()
+		resultFinalizeBlock := data.ResultFinalizeBlock
+		res.Data = &eventsvc.SubscribeResponse_NewBlock{NewBlock: &eventsvc.EventDataNewBlock{
+			Block:               pb,
+			BlockId:             &blockID,
+			ResultFinalizeBlock: &resultFinalizeBlock,
+		}}
+	case types.EventDataNewBlockHeader:
+		res.Data = &eventsvc.SubscribeResponse_NewBlockHeader{NewBlockHeader: &eventsvc.EventDataNewBlockHeader{
+			Header: data.Header.ToProto(),
+		}}
+	case types.EventDataNewBlockEvents:
+		events := make([]*abci.Event, len(data.Events))
+		for i := range data.Events {
+			events[i] = &data.Events[i]
+		}
+		res.Data = &eventsvc.SubscribeResponse_NewBlockEvents{NewBlockEvents: &eventsvc.EventDataNewBlockEvents{
+			Height: data.Height,
+			Events: events,
+			NumTxs: data.NumTxs,
+		}}
+	case types.EventDataNewEvidence:
+		ev, err := types.EvidenceToProto(data.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		res.Data = &eventsvc.SubscribeResponse_NewEvidence{NewEvidence: &eventsvc.EventDataNewEvidence{
+			Height:   data.Height,
+			Evidence: ev,
+		}}
+	case types.EventDataTx:
+		txResult := data.TxResult
+		res.Data = &eventsvc.SubscribeResponse_Tx{Tx: &eventsvc.EventDataTx{
+			TxResult: &txResult,
+		}}
+	case types.EventDataRoundState:
+		res.Data = &eventsvc.SubscribeResponse_RoundState{RoundState: &eventsvc.EventDataRoundState{
+			Height: data.Height,
+			Round:  data.Round,
+			Step:   data.Step,
+		}}
+	case types.EventDataNewRound:
+		res.Data = &eventsvc.SubscribeResponse_NewRound{NewRound: &eventsvc.EventDataNewRound{
+			Height: data.Height,
+			Round:  data.Round,
+			Step:   data.Step,
+			Proposer: &eventsvc.ValidatorInfo{
+				Address: data.Proposer.Address,
+				Index:   data.Proposer.Index,
+			},
+		}}
+	case types.EventDataCompleteProposal:
+		blockID := data.BlockID.ToProto()
+		res.Data = &eventsvc.SubscribeResponse_CompleteProposal{CompleteProposal: &eventsvc.EventDataCompleteProposal{
+			Height:  data.Height,
+			Round:   data.Round,
+			Step:    data.Step,
+			BlockId: &blockID,
+		}}
+	case types.EventDataVote:
+		res.Data = &eventsvc.SubscribeResponse_Vote{Vote: &eventsvc.EventDataVote{
+			Vote: data.Vote.ToProto(),
+		}}
+	case types.EventDataValidatorSetUpdates:
+		vals := make([]*cmtproto.Validator, len(data.ValidatorUpdates))
+		for i, val := range data.ValidatorUpdates {
+			pv, err := val.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = pv
+		}
+		res.Data = &eventsvc.SubscribeResponse_ValidatorSetUpdates{ValidatorSetUpdates: &eventsvc.EventDataValidatorSetUpdates{
+			ValidatorUpdates: vals,
+		}}
+	case types.EventDataString:
+		res.Data = &eventsvc.SubscribeResponse_StringData{StringData: &eventsvc.EventDataString{
+			Value: string(data),
+		}}
+	default:
+		return nil, fmt.Errorf("unexpected event data type: %T", data)
+	}
+	return res, nil
+}
@@ -0,0 +1,216 @@
+package mempoolservice
+
+import (
+	context "context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	mempoolsvc "github.com/cometbft/cometbft/proto/tendermint/services/mempool/v1"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 30
+	maxLimit     = 100
+
+	// Capacity of the channel buffering tx events for each GetTxEvents stream.
+	txEventsCapacity = 100
+)
+
+// SyncReactor is implemented by the mempool reactor, which does not accept
+// transactions while the node is syncing.
+type SyncReactor interface {
+	WaitSync() bool
+}
+
+type mempoolServiceServer struct {
+	mempool         mempl.Mempool
+	proxyAppMempool proxy.AppConnMempool
+	reactor         SyncReactor
+	logger          log.Logger
+}
+
+// New creates a new CometBFT mempool service server.
+func New(
+	mempool mempl.Mempool,
+	proxyAppMempool proxy.AppConnMempool,
+	reactor SyncReactor,
+	logger log.Logger,
+) mempoolsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		mempool:         mempool,
+		proxyAppMempool: proxyAppMempool,
+		reactor:         reactor,
+		logger:          logger.With("service", "MempoolService"),
+	}
+}
+
+// SubmitTx implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) SubmitTx(ctx context.Context, req *mempoolsvc.SubmitTxRequest) (*mempoolsvc.SubmitTxResponse, error) {
+	logger := s.logger.With("endpoint", "SubmitTx")
+
+	if s.reactor.WaitSync() {
+		return nil, status.Error(codes.Unavailable, "Node is catching up, transactions are not accepted yet")
+	}
+
+	tx := types.Tx(req.Tx)
+	resCh := make(chan *abci.ResponseCheckTx, 1)
+	reqRes, err := s.mempool.CheckTx(tx)
+	if err != nil {
+		return nil, checkTxError(err, logger)
+	}
+	reqRes.SetCallback(func(*abci.Response) {
+		select {
+		case <-ctx.Done():
+		case resCh <- reqRes.Response.GetCheckTx():
+		}
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case res := <-resCh:
+		return &mempoolsvc.SubmitTxResponse{
+			Hash:    tx.Hash(),
+			CheckTx: res,
+		}, nil
+	}
+}
+
+// CheckTx implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) CheckTx(ctx context.Context, req *mempoolsvc.CheckTxRequest) (*mempoolsvc.CheckTxResponse, error) {
+	logger := s.logger.With("endpoint", "CheckTx")
+
+	res, err := s.proxyAppMempool.CheckTx(ctx, &abci.RequestCheckTx{Tx: req.Tx})
+	if err != nil {
+		return nil, internalError("Failed to check transaction", err, logger)
+	}
+	return &mempoolsvc.CheckTxResponse{CheckTx: res}, nil
+}
+
+// GetTx implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) GetTx(_ context.Context, req *mempoolsvc.GetTxRequest) (*mempoolsvc.GetTxResponse, error) {
+	var txKey types.TxKey
+	if len(req.Hash) != len(txKey) {
+		return nil, status.Errorf(codes.InvalidArgument, "Transaction hash must be %d bytes long", len(txKey))
+	}
+	copy(txKey[:], req.Hash)
+
+	tx, ok := s.mempool.GetTxByKey(txKey)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Transaction not found in mempool")
+	}
+	return &mempoolsvc.GetTxResponse{Tx: tx}, nil
+}
+
+// GetUnconfirmedTxs implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) GetUnconfirmedTxs(_ context.Context, req *mempoolsvc.GetUnconfirmedTxsRequest) (*mempoolsvc.GetUnconfirmedTxsResponse, error) {
+	limit := req.Limit
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "Limit cannot be negative")
+	case limit == 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	txs := s.mempool.ReapMaxTxs(int(limit))
+	res := &mempoolsvc.GetUnconfirmedTxsResponse{
+		Txs:        make([][]byte, len(txs)),
+		Total:      int64(s.mempool.Size()),
+		TotalBytes: s.mempool.SizeBytes(),
+	}
+	for i, tx := range txs {
+		res.Txs[i] = tx
+	}
+	return res, nil
+}
+
+// GetNumUnconfirmedTxs implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) GetNumUnconfirmedTxs(context.Context, *mempoolsvc.GetNumUnconfirmedTxsRequest) (*mempoolsvc.GetNumUnconfirmedTxsResponse, error) {
+	return &mempoolsvc.GetNumUnconfirmedTxsResponse{
+		Total:      int64(s.mempool.Size()),
+		TotalBytes: s.mempool.SizeBytes(),
+	}, nil
+}
+
+// GetTxEvents implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) GetTxEvents(_ *mempoolsvc.GetTxEventsRequest, stream mempoolsvc.MempoolService_GetTxEventsServer) error {
+	logger := s.logger.With("endpoint", "GetTxEvents")
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	events, cancel := s.mempool.SubscribeTxEvents(txEventsCapacity)
+	defer cancel()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Canceled, "Subscription canceled")
+			}
+			res := &mempoolsvc.GetTxEventsResponse{
+				Type: txEventTypeToProto(event.Type),
+				Hash: event.Tx.Hash(),
+				Tx:   event.Tx,
+			}
+			if err := stream.Send(res); err != nil {
+				logger.Error("Failed to stream tx event", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+func txEventTypeToProto(t mempl.TxEventType) mempoolsvc.TxEventType {
+	switch t {
+	case mempl.TxAdded:
+		return mempoolsvc.TxEventType_TX_EVENT_TYPE_ADDED
+	case mempl.TxRemoved:
+		return mempoolsvc.TxEventType_TX_EVENT_TYPE_REMOVED
+	default:
+		return mempoolsvc.TxEventType_TX_EVENT_TYPE_UNKNOWN
+	}
+}
+
+// checkTxError maps an error returned by the mempool's CheckTx to a gRPC
+// status error.
+func checkTxError(err error, logger log.Logger) error {
+	var (
+		errTooLarge mempl.ErrTxTooLarge
+		errFull     mempl.ErrMempoolIsFull
+	)
+	switch {
+	case errors.Is(err, mempl.ErrTxInCache):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.As(err, &errTooLarge), mempl.IsPreCheckError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &errFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return internalError("Failed to submit transaction", err, logger)
+	}
+}
+
+func internalError(msg string, err error, logger log.Logger) error {
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
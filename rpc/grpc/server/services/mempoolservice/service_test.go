@@ -0,0 +1,54 @@
+package mempoolservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	mempoolsvc "github.com/cometbft/cometbft/proto/tendermint/services/mempool/v1"
+	"github.com/cometbft/cometbft/proxy"
+)
+
+type syncedReactor struct{}
+
+func (syncedReactor) WaitSync() bool { return false }
+
+func TestCheckTx(t *testing.T) {
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), proxy.NopMetrics())
+	proxyApp.SetLogger(log.TestingLogger())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() {
+		if err := proxyApp.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := mempl.NewCListMempool(config.TestMempoolConfig(), proxyApp.Mempool(), 0)
+	mp.SetLogger(log.TestingLogger())
+	svc := New(mp, proxyApp.Mempool(), syncedReactor{}, log.TestingLogger())
+	ctx := context.Background()
+
+	// Checking a transaction does not add it to the mempool.
+	res, err := svc.CheckTx(ctx, &mempoolsvc.CheckTxRequest{Tx: kvstore.NewTxFromID(0)})
+	require.NoError(t, err)
+	assert.Equal(t, abci.CodeTypeOK, res.CheckTx.Code)
+	assert.Zero(t, mp.Size())
+
+	// Unlike submitting it.
+	submitted, err := svc.SubmitTx(ctx, &mempoolsvc.SubmitTxRequest{Tx: kvstore.NewTxFromID(0)})
+	require.NoError(t, err)
+	assert.Equal(t, abci.CodeTypeOK, submitted.CheckTx.Code)
+	assert.Equal(t, 1, mp.Size())
+
+	// Invalid transactions are reported as such.
+	res, err = svc.CheckTx(ctx, &mempoolsvc.CheckTxRequest{Tx: []byte("no separator")})
+	require.NoError(t, err)
+	assert.NotEqual(t, abci.CodeTypeOK, res.CheckTx.Code)
+}
@@ -0,0 +1,80 @@
+package peerfilterservice
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/peer_filter/v1"
+)
+
+// Peers is implemented by the p2p switch, which rejects the peers its access
+// rules do not accept.
+type Peers interface {
+	PeerAccessRules() p2p.PeerAccessRules
+	SetPeerAccessRules(rules p2p.PeerAccessRules) error
+}
+
+type peerFilterServiceServer struct {
+	peers  Peers
+	logger log.Logger
+}
+
+// New creates a new CometBFT peer filter service server.
+func New(peers Peers, logger log.Logger) v1.PeerFilterServiceServer {
+	return &peerFilterServiceServer{
+		peers:  peers,
+		logger: logger.With("service", "PeerFilterService"),
+	}
+}
+
+// GetPeerFilter implements v1.PeerFilterServiceServer.
+func (s *peerFilterServiceServer) GetPeerFilter(context.Context, *v1.GetPeerFilterRequest) (*v1.GetPeerFilterResponse, error) {
+	rules := s.peers.PeerAccessRules()
+	return &v1.GetPeerFilterResponse{
+		AllowedCidrs: rules.AllowedCIDRs,
+		DeniedCidrs:  rules.DeniedCIDRs,
+		AllowedIds:   idsToStrings(rules.AllowedIDs),
+		DeniedIds:    idsToStrings(rules.DeniedIDs),
+	}, nil
+}
+
+// SetPeerFilter implements v1.PeerFilterServiceServer.
+func (s *peerFilterServiceServer) SetPeerFilter(_ context.Context, req *v1.SetPeerFilterRequest) (*v1.SetPeerFilterResponse, error) {
+	logger := s.logger.With("endpoint", "SetPeerFilter")
+	rules := p2p.PeerAccessRules{
+		AllowedCIDRs: req.AllowedCidrs,
+		DeniedCIDRs:  req.DeniedCidrs,
+		AllowedIDs:   stringsToIDs(req.AllowedIds),
+		DeniedIDs:    stringsToIDs(req.DeniedIds),
+	}
+	if err := s.peers.SetPeerAccessRules(rules); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid peer filter: %s", err)
+	}
+	logger.Info("Peer filter changed",
+		"allowed_cidrs", rules.AllowedCIDRs,
+		"denied_cidrs", rules.DeniedCIDRs,
+		"allowed_ids", rules.AllowedIDs,
+		"denied_ids", rules.DeniedIDs,
+	)
+	return &v1.SetPeerFilterResponse{}, nil
+}
+
+func idsToStrings(ids []p2p.ID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strs
+}
+
+func stringsToIDs(strs []string) []p2p.ID {
+	ids := make([]p2p.ID, len(strs))
+	for i, s := range strs {
+		ids[i] = p2p.ID(s)
+	}
+	return ids
+}
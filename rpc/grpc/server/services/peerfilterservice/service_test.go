@@ -0,0 +1,74 @@
+package peerfilterservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/peer_filter/v1"
+)
+
+// accessListPeers applies the rules to an access list, as the switch does.
+type accessListPeers struct {
+	list *p2p.PeerAccessList
+}
+
+func (p accessListPeers) PeerAccessRules() p2p.PeerAccessRules {
+	return p.list.Rules()
+}
+
+func (p accessListPeers) SetPeerAccessRules(rules p2p.PeerAccessRules) error {
+	return p.list.SetRules(rules)
+}
+
+func TestPeerFilter(t *testing.T) {
+	list, err := p2p.NewPeerAccessList(p2p.PeerAccessRules{}, p2p.NopMetrics())
+	require.NoError(t, err)
+	svc := New(accessListPeers{list: list}, log.TestingLogger())
+	ctx := context.Background()
+
+	res, err := svc.GetPeerFilter(ctx, &v1.GetPeerFilterRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, res.AllowedCidrs)
+	assert.Empty(t, res.DeniedCidrs)
+	assert.Empty(t, res.AllowedIds)
+	assert.Empty(t, res.DeniedIds)
+
+	filter := &v1.SetPeerFilterRequest{
+		AllowedCidrs: []string{"10.0.0.0/8", "192.168.1.1"},
+		DeniedCidrs:  []string{"10.0.1.0/24"},
+		AllowedIds:   []string{"0123456789abcdef0123456789abcdef01234567"},
+		DeniedIds:    []string{"76543210fedcba9876543210fedcba9876543210"},
+	}
+	_, err = svc.SetPeerFilter(ctx, filter)
+	require.NoError(t, err)
+
+	res, err = svc.GetPeerFilter(ctx, &v1.GetPeerFilterRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, filter.AllowedCidrs, res.AllowedCidrs)
+	assert.Equal(t, filter.DeniedCidrs, res.DeniedCidrs)
+	assert.Equal(t, filter.AllowedIds, res.AllowedIds)
+	assert.Equal(t, filter.DeniedIds, res.DeniedIds)
+
+	// Invalid rules are rejected, and the previous ones are kept.
+	for _, invalid := range []*v1.SetPeerFilterRequest{
+		{AllowedCidrs: []string{"10.0.0.0/33"}},
+		{DeniedCidrs: []string{"not a cidr"}},
+		{DeniedIds: []string{"not an id"}},
+	} {
+		_, err = svc.SetPeerFilter(ctx, invalid)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	res, err = svc.GetPeerFilter(ctx, &v1.GetPeerFilterRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, filter.AllowedCidrs, res.AllowedCidrs)
+	assert.Equal(t, filter.DeniedCidrs, res.DeniedCidrs)
+}
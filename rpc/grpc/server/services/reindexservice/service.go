@@ -0,0 +1,77 @@
+package reindexservice
+
+import (
+	context "context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/reindex/v1"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+type reindexServiceServer struct {
+	reindexer *sm.Reindexer
+	logger    log.Logger
+}
+
+// New creates a new CometBFT reindex service server.
+func New(reindexer *sm.Reindexer, logger log.Logger) v1.ReindexServiceServer {
+	return &reindexServiceServer{
+		reindexer: reindexer,
+		logger:    logger.With("service", "ReindexService"),
+	}
+}
+
+// Reindex implements v1.ReindexServiceServer.
+func (s *reindexServiceServer) Reindex(_ context.Context, req *v1.ReindexRequest) (*v1.ReindexResponse, error) {
+	logger := s.logger.With("endpoint", "Reindex")
+	reindexStatus, err := s.reindexer.Reindex(req.StartHeight, req.EndHeight)
+	switch {
+	case errors.Is(err, sm.ErrInvalidHeightValue):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, sm.ErrReindexInProgress):
+		return nil, status.Error(codes.AlreadyExists, "A reindexing is already in progress")
+	case errors.Is(err, sm.ErrReindexerStopped):
+		return nil, status.Error(codes.Unavailable, "The node is stopping")
+	case err != nil:
+		return nil, internalError("Failed to start reindexing", err, logger)
+	}
+	return &v1.ReindexResponse{
+		StartHeight: reindexStatus.StartHeight,
+		EndHeight:   reindexStatus.EndHeight,
+	}, nil
+}
+
+// GetReindexStatus implements v1.ReindexServiceServer.
+func (s *reindexServiceServer) GetReindexStatus(context.Context, *v1.GetReindexStatusRequest) (*v1.GetReindexStatusResponse, error) {
+	reindexStatus := s.reindexer.Status()
+	resp := &v1.GetReindexStatusResponse{
+		Running:     reindexStatus.Running,
+		StartHeight: reindexStatus.StartHeight,
+		EndHeight:   reindexStatus.EndHeight,
+		LastHeight:  reindexStatus.LastHeight,
+	}
+	if reindexStatus.Err != nil {
+		resp.Error = reindexStatus.Err.Error()
+	}
+	return resp, nil
+}
+
+// CancelReindex implements v1.ReindexServiceServer.
+func (s *reindexServiceServer) CancelReindex(context.Context, *v1.CancelReindexRequest) (*v1.CancelReindexResponse, error) {
+	return &v1.CancelReindexResponse{Canceled: s.reindexer.Cancel()}, nil
+}
+
+func internalError(msg string, err error, logger log.Logger) error {
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
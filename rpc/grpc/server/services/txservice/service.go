@@ -0,0 +1,233 @@
+package txservice
+
+import (
+	"context"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	txsvc "github.com/cometbft/cometbft/proto/tendermint/services/tx/v1"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxQueryLength is the maximum length of a query string that will be
+// accepted. This is just a safety check to avoid outlandish queries.
+const maxQueryLength = 512
+
+// searchPageSize is the number of results read at once from the indexers which
+// can search page by page.
+const searchPageSize = 100
+
+type txServiceServer struct {
+	txIndexer txindex.TxIndexer
+	store     *store.BlockStore
+	logger    log.Logger
+}
+
+// New creates a new CometBFT tx service server.
+func New(txIndexer txindex.TxIndexer, store *store.BlockStore, logger log.Logger) txsvc.TxServiceServer {
+	return &txServiceServer{
+		txIndexer: txIndexer,
+		store:     store,
+		logger:    logger.With("service", "TxService"),
+	}
+}
+
+// GetByHash implements v1.TxServiceServer.
+func (s *txServiceServer) GetByHash(_ context.Context, req *txsvc.GetByHashRequest) (*txsvc.GetByHashResponse, error) {
+	logger := s.logger.With("endpoint", "GetByHash")
+
+	if err := s.checkIndexingEnabled(); err != nil {
+		return nil, err
+	}
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Transaction hash cannot be empty")
+	}
+
+	r, err := s.txIndexer.Get(req.Hash)
+	if err != nil {
+		return nil, s.internalError("Failed to get transaction from index", err, logger)
+	}
+	if r == nil {
+		return nil, status.Errorf(codes.NotFound, "Transaction %X not found", req.Hash)
+	}
+
+	tx, err := s.indexedTx(r, req.Prove)
+	if err != nil {
+		return nil, s.internalError("Failed to load transaction proof", err, logger)
+	}
+	return &txsvc.GetByHashResponse{Tx: tx}, nil
+}
+
+// Search implements v1.TxServiceServer.
+func (s *txServiceServer) Search(req *txsvc.SearchRequest, stream txsvc.TxService_SearchServer) error {
+	logger := s.logger.With("endpoint", "Search")
+
+	if err := s.checkIndexingEnabled(); err != nil {
+		return err
+	}
+	if len(req.Query) > maxQueryLength {
+		return status.Error(codes.InvalidArgument, "Maximum query length exceeded")
+	}
+	q, err := cmtquery.New(req.Query)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Failed to parse query: %s", err)
+	}
+
+	var desc bool
+	switch req.OrderBy {
+	case txsvc.OrderBy_ORDER_BY_ASC:
+	case txsvc.OrderBy_ORDER_BY_DESC:
+		desc = true
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unknown order: %v", req.OrderBy)
+	}
+	var after *txindex.Position
+	if req.After != nil {
+		after = &txindex.Position{Height: req.After.Height, Index: req.After.Index}
+	}
+	limit := int(req.Limit)
+
+	send := func(results []*abci.TxResult) error {
+		for _, r := range results {
+			tx, err := s.indexedTx(r, req.Prove)
+			if err != nil {
+				return s.internalError("Failed to load transaction proof", err, logger)
+			}
+			if err := stream.Send(&txsvc.SearchResponse{Tx: tx}); err != nil {
+				if stream.Context().Err() != nil {
+					return status.FromContextError(stream.Context().Err()).Err()
+				}
+				return s.internalError("Cannot send stream response", err, logger)
+			}
+		}
+		return nil
+	}
+
+	pager, ok := s.txIndexer.(txindex.Pager)
+	if !ok {
+		results, err := s.search(stream.Context(), q, after, limit, desc)
+		if err != nil {
+			return s.searchError(stream.Context(), err, logger)
+		}
+		return send(results)
+	}
+
+	// Each page is sent as soon as it is read, so that only one page of
+	// results is held in memory.
+	for sent := 0; limit <= 0 || sent < limit; {
+		pageSize := searchPageSize
+		if limit > 0 && limit-sent < pageSize {
+			pageSize = limit - sent
+		}
+		results, err := pager.SearchPage(stream.Context(), q, after, pageSize, desc)
+		if err != nil {
+			return s.searchError(stream.Context(), err, logger)
+		}
+		if err := send(results); err != nil {
+			return err
+		}
+		if len(results) < pageSize {
+			break
+		}
+		sent += len(results)
+		last := results[len(results)-1]
+		after = &txindex.Position{Height: last.Height, Index: last.Index}
+	}
+	return nil
+}
+
+// search returns at most limit results of the transactions matching the
+// query, coming after the given position in ascending (or descending if desc is
+// set) order, for the indexers which cannot search page by page. A limit of 0
+// returns all the results.
+func (s *txServiceServer) search(
+	ctx context.Context,
+	q *cmtquery.Query,
+	after *txindex.Position,
+	limit int,
+	desc bool,
+) ([]*abci.TxResult, error) {
+	results, err := s.txIndexer.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	less := func(height int64, index uint32, r *abci.TxResult) bool {
+		return height < r.Height || (height == r.Height && index < r.Index)
+	}
+	if desc {
+		less = func(height int64, index uint32, r *abci.TxResult) bool {
+			return height > r.Height || (height == r.Height && index > r.Index)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return less(results[i].Height, results[i].Index, results[j])
+	})
+
+	// Skip the results up to and including the cursor.
+	start := 0
+	if after != nil {
+		start = sort.Search(len(results), func(i int) bool {
+			return less(after.Height, after.Index, results[i])
+		})
+	}
+	results = results[start:]
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func (s *txServiceServer) searchError(ctx context.Context, err error, logger log.Logger) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return s.internalError("Failed to search transactions", err, logger)
+}
+
+func (s *txServiceServer) checkIndexingEnabled() error {
+	if _, ok := s.txIndexer.(*null.TxIndex); ok {
+		return status.Error(codes.FailedPrecondition, "Transaction indexing is disabled")
+	}
+	return nil
+}
+
+func (s *txServiceServer) indexedTx(r *abci.TxResult, prove bool) (*txsvc.IndexedTx, error) {
+	tx := &txsvc.IndexedTx{
+		Hash:     types.Tx(r.Tx).Hash(),
+		Height:   r.Height,
+		Index:    r.Index,
+		Tx:       r.Tx,
+		TxResult: &r.Result,
+	}
+	if prove {
+		block := s.store.LoadBlock(r.Height)
+		if block == nil {
+			return nil, status.Errorf(codes.NotFound, "Block not found for height %d", r.Height)
+		}
+		proof := block.Data.Txs.Proof(int(r.Index)).ToProto()
+		tx.Proof = &proof
+	}
+	return tx, nil
+}
+
+func (s *txServiceServer) internalError(msg string, err error, logger log.Logger) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	traceID, traceErr := rpctrace.New()
+	if traceErr != nil {
+		logger.Error("Error generating RPC trace ID", "err", traceErr)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger.Error(msg, "err", err, "traceID", traceID)
+	return status.Errorf(codes.Internal, "%s (see logs for trace ID: %s)", msg, traceID)
+}
@@ -30,4 +30,23 @@ type BlockIndexer interface {
 	SetRetainHeight(retainHeight int64) error
 
 	GetRetainHeight() (int64, error)
+
+	// DeleteHeightsAbove removes the blocks of the heights above the given
+	// one, e.g. after a rollback.
+	DeleteHeightsAbove(height int64) error
+}
+
+// BlockPager is implemented by the block indexers able to return the results
+// of a search page by page.
+type BlockPager interface {
+	// SearchPage returns at most limit heights matching the query, in
+	// ascending (or descending if desc is set) order, which come after the
+	// given height in that order. A zero height starts from the first block.
+	SearchPage(
+		ctx context.Context,
+		q *query.Query,
+		after int64,
+		limit int,
+		desc bool,
+	) ([]int64, error)
 }
@@ -16,6 +16,10 @@ import (
 	"github.com/cometbft/cometbft/state/txindex/null"
 )
 
+// ErrSQLiteNotSupported is returned when configuring the sqlite indexer in a
+// binary built without cgo, which the SQLite driver requires.
+var ErrSQLiteNotSupported = errors.New("the sqlite indexer is not supported by this binary, built without cgo")
+
 // EventSinksFromConfig constructs a slice of indexer.EventSink using the provided
 // configuration.
 //
@@ -27,21 +31,51 @@ func IndexerFromConfig(cfg *config.Config, dbProvider config.DBProvider, chainID
 		if err != nil {
 			return nil, nil, err
 		}
+		filter, err := EventFilterFromConfig(cfg.TxIndex)
+		if err != nil {
+			return nil, nil, err
+		}
 
-		return kv.NewTxIndex(store), blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))), nil
+		return kv.NewTxIndex(store, kv.WithEventFilter(filter)),
+			blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")), blockidxkv.WithEventFilter(filter)),
+			nil
 
 	case "psql":
 		conn := cfg.TxIndex.PsqlConn
 		if conn == "" {
 			return nil, nil, errors.New("the psql connection settings cannot be empty")
 		}
-		es, err := psql.NewEventSink(cfg.TxIndex.PsqlConn, chainID)
+		filter, err := EventFilterFromConfig(cfg.TxIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		es, err := psql.NewEventSink(cfg.TxIndex.PsqlConn, chainID, psql.WithEventFilter(filter))
 		if err != nil {
 			return nil, nil, fmt.Errorf("creating psql indexer: %w", err)
 		}
 		return es.TxIndexer(), es.BlockIndexer(), nil
 
+	case "sqlite":
+		txIndexer, blockIndexer, err := SQLiteIndexerFromConfig(cfg, chainID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating sqlite indexer: %w", err)
+		}
+		return txIndexer, blockIndexer, nil
+
 	default:
 		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, nil
 	}
 }
+
+// EventFilterFromConfig returns the filter of the event attributes to index
+// configured in the [tx_index] section, or nil if all of them are indexed.
+func EventFilterFromConfig(cfg *config.TxIndexConfig) (*indexer.EventFilter, error) {
+	if len(cfg.AllowEvents) == 0 && len(cfg.DenyEvents) == 0 {
+		return nil, nil
+	}
+	filter, err := indexer.NewEventFilter(cfg.AllowEvents, cfg.DenyEvents)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event filter: %w", err)
+	}
+	return filter, nil
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,13 +41,28 @@ type BlockerIndexer struct {
 	// Add unique event identifier to use when querying
 	// Matching will be done both on height AND eventSeq
 	eventSeq int64
-	log      log.Logger
+	// Selects the event attributes to index
+	eventFilter *indexer.EventFilter
+	log         log.Logger
 }
 
-func New(store dbm.DB) *BlockerIndexer {
-	return &BlockerIndexer{
+// Option sets an optional parameter of the BlockerIndexer.
+type Option func(*BlockerIndexer)
+
+// WithEventFilter restricts the indexed event attributes to those selected by
+// the given filter.
+func WithEventFilter(filter *indexer.EventFilter) Option {
+	return func(idx *BlockerIndexer) { idx.eventFilter = filter }
+}
+
+func New(store dbm.DB, options ...Option) *BlockerIndexer {
+	idx := &BlockerIndexer{
 		store: store,
 	}
+	for _, option := range options {
+		option(idx)
+	}
+	return idx
 }
 
 func (idx *BlockerIndexer) SetLogger(l log.Logger) {
@@ -176,6 +193,35 @@ func (idx *BlockerIndexer) Prune(retainHeight int64) (int64, int64, error) {
 	return int64(len(affectedHeights)), retainHeight, err
 }
 
+// DeleteHeightsAbove removes the heights above the given one, along with their
+// events.
+func (idx *BlockerIndexer) DeleteHeightsAbove(height int64) error {
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	itr, err := idx.store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for ; itr.Valid(); itr.Next() {
+		if keyBelongsToHeightRange(itr.Key(), height+1, math.MaxInt64) {
+			if err := batch.Delete(itr.Key()); err != nil {
+				itr.Close()
+				return err
+			}
+		}
+	}
+	if err := itr.Error(); err != nil {
+		itr.Close()
+		return err
+	}
+	// The batch is written once the iterator is released.
+	if err := itr.Close(); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
 func (idx *BlockerIndexer) SetRetainHeight(retainHeight int64) error {
 	return idx.store.SetSync(BlockIndexerRetainHeightKey, int64ToBytes(retainHeight))
 }
@@ -221,6 +267,11 @@ func (idx *BlockerIndexer) getLastRetainHeight() (int64, error) {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// Queries using OR and NOT are evaluated by combining the results of their
+// subexpressions: disjunctions are merged, conjunctions are intersected and
+// negations are subtracted from the results of the other operands of their
+// conjunction, or from all the indexed heights otherwise.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -230,8 +281,287 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions := q.Syntax()
+	filteredHeights, err := idx.searchExpr(ctx, q.Syntax(), heightWindow{})
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	resultMap := make(map[int64]struct{})
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			if _, ok := resultMap[h]; !ok {
+				resultMap[h] = struct{}{}
+				results = append(results, h)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// SearchPage implements indexer.BlockPager. The indexed heights following the
+// given one are read in order, in chunks, and the query is only matched
+// against the window of heights of each chunk, until the page is full. Each
+// chunk is twice as large as the previous one, which did not fill the page, so
+// that only the matches of the last window are held in memory.
+func (idx *BlockerIndexer) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	after int64,
+	limit int,
+	desc bool,
+) ([]int64, error) {
+	results := make([]int64, 0, limit)
+	for size := limit; len(results) < limit; size *= 2 {
+		window, ok, err := idx.nextWindow(after, size, desc)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		filteredHeights, err := idx.searchExpr(ctx, q.Syntax(), window)
+		if err != nil {
+			return nil, err
+		}
+
+		heights := make([]int64, 0, len(filteredHeights))
+		for _, hBz := range filteredHeights {
+			heights = append(heights, int64FromBytes(hBz))
+		}
+		sort.Slice(heights, func(i, j int) bool {
+			if desc {
+				return heights[i] > heights[j]
+			}
+			return heights[i] < heights[j]
+		})
+
+		for _, h := range heights {
+			if len(results) == limit {
+				break
+			}
+			ok, err := idx.Has(h)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				results = append(results, h)
+			}
+		}
+
+		if desc {
+			after = window.min
+		} else {
+			after = window.max
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return results, nil
+}
+
+// nextWindow returns the window of the next size indexed heights coming after
+// the given one, in ascending (or descending if desc is set) order, or false if
+// there are none. A zero height starts from the first block.
+func (idx *BlockerIndexer) nextWindow(after int64, size int, desc bool) (heightWindow, bool, error) {
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return heightWindow{}, false, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+	// The primary keys are ordered by height. The prefix ends with the string
+	// terminator, which can be incremented to get the end of the keys.
+	start, end := prefix, append([]byte{}, prefix...)
+	end[len(end)-1]++
+
+	var it dbm.Iterator
+	if desc {
+		if after > 0 {
+			if end, err = heightKey(after); err != nil {
+				return heightWindow{}, false, fmt.Errorf("failed to create block height index key: %w", err)
+			}
+		}
+		it, err = idx.store.ReverseIterator(start, end)
+	} else {
+		if after > 0 {
+			if start, err = heightKey(after + 1); err != nil {
+				return heightWindow{}, false, fmt.Errorf("failed to create block height index key: %w", err)
+			}
+		}
+		it, err = idx.store.Iterator(start, end)
+	}
+	if err != nil {
+		return heightWindow{}, false, err
+	}
+	defer it.Close()
+
+	var window heightWindow
+	for n := 0; n < size && it.Valid(); it.Next() {
+		height, err := parseHeightFromPrimaryKey(it.Key())
+		if err != nil {
+			continue
+		}
+		if window.min == 0 || height < window.min {
+			window.min = height
+		}
+		if height > window.max {
+			window.max = height
+		}
+		n++
+	}
+	if err := it.Error(); err != nil {
+		return heightWindow{}, false, err
+	}
+	return window, window.max != 0, nil
+}
+
+// searchExpr returns the heights within the window matching the given
+// expression, keyed by their encoding.
+func (idx *BlockerIndexer) searchExpr(ctx context.Context, expr syntax.Expr, window heightWindow) (map[string][]byte, error) {
+	if conditions, ok := syntax.Conjunction(expr); ok {
+		heights, err := idx.searchConditions(ctx, conditions, window)
+		if err != nil {
+			return nil, err
+		}
+		// The matches of the conditions are keyed by height and event
+		// sequence, so that they can be intersected per event.
+		return idxutil.KeyByValue(heights), nil
+	}
+
+	switch expr := expr.(type) {
+	case syntax.Or:
+		union := make(map[string][]byte)
+		for _, sub := range expr {
+			heights, err := idx.searchExpr(ctx, sub, window)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range heights {
+				union[k] = v
+			}
+		}
+		return union, nil
+
+	case syntax.And:
+		// Conditions are searched together, so that they are matched against
+		// the same events, as in a plain conjunction of conditions.
+		var conditions syntax.And
+		var positive, negative []syntax.Expr
+		for _, sub := range expr {
+			switch sub := sub.(type) {
+			case syntax.Condition:
+				conditions = append(conditions, sub)
+			case syntax.Not:
+				negative = append(negative, sub.Expr)
+			default:
+				positive = append(positive, sub)
+			}
+		}
+		if len(conditions) > 0 {
+			positive = append(positive, conditions)
+		}
+		var heights map[string][]byte
+		var err error
+		if len(positive) == 0 {
+			heights, err = idx.allHeights(ctx, window)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, sub := range positive {
+			subHeights, err := idx.searchExpr(ctx, sub, window)
+			if err != nil {
+				return nil, err
+			}
+			if heights == nil {
+				heights = subHeights
+			} else {
+				idxutil.Intersect(heights, subHeights)
+			}
+			if len(heights) == 0 {
+				return heights, nil
+			}
+		}
+		for _, sub := range negative {
+			subHeights, err := idx.searchExpr(ctx, sub, window)
+			if err != nil {
+				return nil, err
+			}
+			idxutil.Subtract(heights, subHeights)
+		}
+		return heights, nil
+
+	case syntax.Not:
+		heights, err := idx.allHeights(ctx, window)
+		if err != nil {
+			return nil, err
+		}
+		subHeights, err := idx.searchExpr(ctx, expr.Expr, window)
+		if err != nil {
+			return nil, err
+		}
+		idxutil.Subtract(heights, subHeights)
+		return heights, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported query expression %s", expr)
+	}
+}
+
+// allHeights returns all the indexed heights within the window, keyed by their
+// encoding.
+func (idx *BlockerIndexer) allHeights(ctx context.Context, window heightWindow) (map[string][]byte, error) {
+	heights := make(map[string][]byte)
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+LOOP:
+	for ; it.Valid(); it.Next() {
+		if !window.contains(int64FromBytes(it.Value())) {
+			continue
+		}
+		heights[string(it.Value())] = it.Value()
+
+		select {
+		case <-ctx.Done():
+			break LOOP
+		default:
+		}
+	}
+	return heights, it.Error()
+}
 
+// searchConditions returns the heights within the window matching the
+// conjunction of the given conditions, keyed by height and event sequence.
+func (idx *BlockerIndexer) searchConditions(
+	ctx context.Context,
+	conditions []syntax.Condition,
+	window heightWindow,
+) (map[string][]byte, error) {
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
@@ -241,6 +571,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	// If we are not matching events and block.height occurs more than once, the later value will
 	// overwrite the first one.
 	conditions, heightInfo, ok = dedupHeight(conditions)
+	heightInfo.window = window
 
 	// Extract ranges. If both upper and lower bounds exist, it's better to get
 	// them in order as to not iterate over kvs that are not within range.
@@ -259,11 +590,12 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 			return nil, err
 		}
 
-		if ok {
-			return []int64{heightInfo.height}, nil
+		filteredHeights := make(map[string][]byte)
+		if ok && window.contains(heightInfo.height) {
+			hBz := int64ToBytes(heightInfo.height)
+			filteredHeights[string(hBz)] = hBz
 		}
-
-		return results, nil
+		return filteredHeights, nil
 	}
 
 	var heightsInitialized bool
@@ -348,34 +680,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	resultMap := make(map[int64]struct{})
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
-
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			if _, ok := resultMap[h]; !ok {
-				resultMap[h] = struct{}{}
-				results = append(results, h)
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			break
-
-		default:
-		}
-	}
-
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	return results, nil
+	return filteredHeights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -414,7 +719,12 @@ LOOP:
 		)
 
 		if qr.Key == types.BlockHeightKey {
-			eventValue, err = parseValueFromPrimaryKey(it.Key())
+			var keyHeight int64
+			keyHeight, err = parseHeightFromPrimaryKey(it.Key())
+			if err == nil && !heightInfo.window.contains(keyHeight) {
+				continue
+			}
+			eventValue = strconv.FormatInt(keyHeight, 10)
 		} else {
 			eventValue, err = parseValueFromEventKey(it.Key())
 		}
@@ -667,6 +977,40 @@ func (idx *BlockerIndexer) match(
 			return nil, err
 		}
 
+	case c.Op == syntax.TIn:
+		// Look up each of the values, as for an equality.
+		for _, arg := range c.Args {
+			prefix, err := orderedcode.Append(nil, c.Tag, arg.Value())
+			if err != nil {
+				return nil, err
+			}
+			if err := idx.matchValues(ctx, prefix, nil, tmpHeights, heightInfo); err != nil {
+				return nil, err
+			}
+		}
+
+	case c.Op == syntax.TStartsWith || c.Op == syntax.TMatches:
+		// Only scan the values starting with the prefix required by the
+		// condition, if any. The prefix is encoded as a string value, without
+		// the terminator.
+		prefix, err := orderedcode.Append(nil, c.Tag, indexer.ValuePrefix(c))
+		if err != nil {
+			return nil, err
+		}
+		prefix = prefix[:len(prefix)-len(orderedCodeStringTerminator)]
+
+		matchValue := func(value string) bool { return strings.HasPrefix(value, c.Arg.Value()) }
+		if c.Op == syntax.TMatches {
+			re, err := regexp.Compile(c.Arg.Value())
+			if err != nil {
+				return nil, err
+			}
+			matchValue = re.MatchString
+		}
+		if err := idx.matchValues(ctx, prefix, matchValue, tmpHeights, heightInfo); err != nil {
+			return nil, err
+		}
+
 	default:
 		return nil, errors.New("other operators should be handled already")
 	}
@@ -701,6 +1045,51 @@ func (idx *BlockerIndexer) match(
 	return filteredHeights, nil
 }
 
+// matchValues adds to tmpHeights the heights indexed under event keys with the
+// given prefix, whose value is accepted by matchValue, if set.
+func (idx *BlockerIndexer) matchValues(
+	ctx context.Context,
+	prefix []byte,
+	matchValue func(value string) bool,
+	tmpHeights map[string][]byte,
+	heightInfo HeightInfo,
+) error {
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if matchValue != nil {
+			eventValue, err := parseValueFromEventKey(it.Key())
+			if err != nil || !matchValue(eventValue) {
+				continue
+			}
+		}
+		keyHeight, err := parseHeightFromEventKey(it.Key())
+		if err != nil {
+			idx.log.Error("failure to parse height from key:", err)
+			continue
+		}
+		withinHeight, err := checkHeightConditions(heightInfo, keyHeight)
+		if err != nil {
+			idx.log.Error("failure checking for height bounds:", err)
+			continue
+		}
+		if !withinHeight {
+			continue
+		}
+
+		idx.setTmpHeights(tmpHeights, it)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return it.Error()
+}
+
 func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, height int64) error {
 	heightBz := int64ToBytes(height)
 
@@ -716,13 +1105,14 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, hei
 				continue
 			}
 
-			// index iff the event specified index:true and it's not a reserved event
+			// index iff the event specified index:true, it's not a reserved event
+			// and it's not filtered out
 			compositeKey := fmt.Sprintf("%s.%s", event.Type, attr.Key)
 			if compositeKey == types.BlockHeightKey {
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeKey)
 			}
 
-			if attr.GetIndex() {
+			if idx.eventFilter.IndexAttribute(event.Type, attr) {
 				key, err := eventKey(compositeKey, attr.Value, height, idx.eventSeq)
 				if err != nil {
 					return fmt.Errorf("failed to create block index key: %w", err)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,24 @@ func TestBlockerIndexer_Prune(t *testing.T) {
 	require.True(t, emptyIntersection(keys1, keys3))
 }
 
+func TestBlockerIndexer_DeleteHeightsAbove(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	require.NoError(t, indexer.Index(getEventsForTesting(1)))
+	keys1 := blockidxkv.GetKeys(*indexer)
+	require.NoError(t, indexer.Index(getEventsForTesting(2)))
+	require.NoError(t, indexer.Index(getEventsForTesting(3)))
+
+	require.NoError(t, indexer.DeleteHeightsAbove(1))
+	require.True(t, isEqualSets(keys1, blockidxkv.GetKeys(*indexer)))
+	for height, indexed := range map[int64]bool{1: true, 2: false, 3: false} {
+		has, err := indexer.Has(height)
+		require.NoError(t, err)
+		require.Equal(t, indexed, has)
+	}
+}
+
 func BenchmarkBlockerIndexer_Prune(_ *testing.B) {
 	config := test.ResetTestRoot("block_indexer")
 	defer func() {
@@ -363,6 +382,42 @@ func TestBlockIndexerMulti(t *testing.T) {
 			q:       query.MustCompile("end_event.baz = 100"),
 			results: []int64{},
 		},
+		"query with disjunction": {
+			q:       query.MustCompile("end_event.bar = 500 OR end_event.bar = 400"),
+			results: []int64{1, 2},
+		},
+		"query with negation": {
+			q:       query.MustCompile("NOT end_event.bar = 500"),
+			results: []int64{2},
+		},
+		"query with negation in conjunction": {
+			q:       query.MustCompile("end_event.foo = 100 AND NOT end_event.bar = 400"),
+			results: []int64{1},
+		},
+		"query with grouped disjunction": {
+			q:       query.MustCompile("(end_event.bar = 500 OR block.height = 2) AND end_event.foo = 300"),
+			results: []int64{1, 2},
+		},
+		"query with negated disjunction": {
+			q:       query.MustCompile("NOT (block.height = 1 OR block.height = 2)"),
+			results: []int64{},
+		},
+		"query with set of values": {
+			q:       query.MustCompile("end_event.bar IN (400, 600)"),
+			results: []int64{2},
+		},
+		"query with prefix": {
+			q:       query.MustCompile("end_event.foo STARTS WITH '3'"),
+			results: []int64{1, 2},
+		},
+		"query with regular expression": {
+			q:       query.MustCompile("end_event.bar MATCHES '^[45]00$'"),
+			results: []int64{1, 2},
+		},
+		"query with regular expression with literal prefix": {
+			q:       query.MustCompile("end_event.bar MATCHES '^5'"),
+			results: []int64{1},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -375,6 +430,105 @@ func TestBlockIndexerMulti(t *testing.T) {
 	}
 }
 
+func TestBlockIndexerSearchPage(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	for _, height := range []int64{1, 2, 5, 9, 10, 11, 20} {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: height,
+			Events: []abci.Event{
+				{
+					Type: "end_event",
+					Attributes: []abci.EventAttribute{
+						{
+							Key:   "foo",
+							Value: fmt.Sprint(height % 2),
+							Index: true,
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	testCases := map[string]struct {
+		q    string
+		desc bool
+		want []int64
+	}{
+		"asc": {
+			q:    "end_event.foo EXISTS",
+			want: []int64{1, 2, 5, 9, 10, 11, 20},
+		},
+		"desc": {
+			q:    "end_event.foo EXISTS",
+			desc: true,
+			want: []int64{20, 11, 10, 9, 5, 2, 1},
+		},
+		"filtered": {
+			q:    "end_event.foo = 1 AND block.height > 1",
+			want: []int64{5, 9, 11},
+		},
+		"negation": {
+			q:    "NOT end_event.foo = 0",
+			desc: true,
+			want: []int64{11, 9, 5, 1},
+		},
+		"no match": {
+			q:    "end_event.foo = 2",
+			want: []int64{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		for _, limit := range []int{1, 3} {
+			limit := limit
+			t.Run(fmt.Sprintf("%s limit=%d", name, limit), func(t *testing.T) {
+				q := query.MustCompile(tc.q)
+				got := make([]int64, 0, len(tc.want))
+				var after int64
+				for {
+					results, err := indexer.SearchPage(context.Background(), q, after, limit, tc.desc)
+					require.NoError(t, err)
+					got = append(got, results...)
+					if len(results) < limit {
+						break
+					}
+					after = results[len(results)-1]
+				}
+				require.Equal(t, tc.want, got)
+			})
+		}
+	}
+}
+
+func TestBlockIndexerEventFilter(t *testing.T) {
+	filter, err := indexer.NewEventFilter(nil, []string{"begin_event.*"})
+	require.NoError(t, err)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(db.NewMemDB(), []byte("block_events")), blockidxkv.WithEventFilter(filter))
+
+	require.NoError(t, blockIndexer.Index(types.EventDataNewBlockEvents{
+		Height: 1,
+		Events: []abci.Event{
+			{Type: "begin_event", Attributes: []abci.EventAttribute{{Key: "proposer", Value: "FCAA001", Index: true}}},
+			{Type: "end_event", Attributes: []abci.EventAttribute{{Key: "foo", Value: "100", Index: true}}},
+		},
+	}))
+
+	testCases := map[string]int{
+		"end_event.foo = 100":              1,
+		"block.height = 1":                 1,
+		"begin_event.proposer = 'FCAA001'": 0,
+	}
+	for q, want := range testCases {
+		results, err := blockIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		require.Len(t, results, want, q)
+	}
+}
+
 func TestBigInt(t *testing.T) {
 
 	bigInt := "10000000000000000000"
@@ -20,8 +20,22 @@ type HeightInfo struct {
 	heightEqIdx     int
 	onlyHeightRange bool
 	onlyHeightEq    bool
+	window          heightWindow
 }
 
+// heightWindow restricts a search to the heights from min to max, both
+// included. A zero bound is ignored.
+type heightWindow struct {
+	min, max int64
+}
+
+func (w heightWindow) contains(height int64) bool {
+	return (w.min == 0 || height >= w.min) && (w.max == 0 || height <= w.max)
+}
+
+// orderedCodeStringTerminator terminates the strings encoded with orderedcode.
+const orderedCodeStringTerminator = "\x00\x01"
+
 func intInSlice(a int, list []int) bool {
 	for _, b := range list {
 		if b == a {
@@ -108,7 +122,7 @@ func eventKey(compositeKey, eventValue string, height int64, eventSeq int64) ([]
 	)
 }
 
-func parseValueFromPrimaryKey(key []byte) (string, error) {
+func parseHeightFromPrimaryKey(key []byte) (int64, error) {
 	var (
 		compositeKey string
 		height       int64
@@ -116,11 +130,20 @@ func parseValueFromPrimaryKey(key []byte) (string, error) {
 
 	remaining, err := orderedcode.Parse(string(key), &compositeKey, &height)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse event key: %w", err)
+		return 0, fmt.Errorf("failed to parse primary key: %w", err)
 	}
 
 	if len(remaining) != 0 {
-		return "", fmt.Errorf("unexpected remainder in key: %s", remaining)
+		return 0, fmt.Errorf("unexpected remainder in key: %s", remaining)
+	}
+
+	return height, nil
+}
+
+func parseValueFromPrimaryKey(key []byte) (string, error) {
+	height, err := parseHeightFromPrimaryKey(key)
+	if err != nil {
+		return "", err
 	}
 
 	return strconv.FormatInt(height, 10), nil
@@ -244,6 +267,9 @@ func dedupHeight(conditions []syntax.Condition) (dedupConditions []syntax.Condit
 }
 
 func checkHeightConditions(heightInfo HeightInfo, keyHeight int64) (bool, error) {
+	if !heightInfo.window.contains(keyHeight) {
+		return false, nil
+	}
 	if heightInfo.heightRange.Key != "" {
 		withinBounds, err := idxutil.CheckBounds(heightInfo.heightRange, big.NewInt(keyHeight))
 		if err != nil || !withinBounds {
@@ -27,6 +27,10 @@ func (idx *BlockerIndexer) Prune(_ int64) (int64, int64, error) {
 	return 0, 0, nil
 }
 
+func (idx *BlockerIndexer) DeleteHeightsAbove(int64) error {
+	return nil
+}
+
 func (idx *BlockerIndexer) Has(int64) (bool, error) {
 	return false, errors.New(`indexing is disabled (set 'tx_index = "kv"' in config)`)
 }
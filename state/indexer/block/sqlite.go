@@ -0,0 +1,26 @@
+//go:build cgo
+
+package block
+
+import (
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+// SQLiteIndexerFromConfig opens the SQLite database of the sqlite indexer in
+// the data directory, and returns its transaction and block indexers.
+func SQLiteIndexerFromConfig(cfg *config.Config, chainID string) (txindex.TxIndexer, indexer.BlockIndexer, error) {
+	filter, err := EventFilterFromConfig(cfg.TxIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	es, err := sqlite.NewEventSink(filepath.Join(cfg.DBDir(), sqlite.DBFile), chainID, sqlite.WithEventFilter(filter))
+	if err != nil {
+		return nil, nil, err
+	}
+	return es.TxIndexer(), es.BlockIndexer(), nil
+}
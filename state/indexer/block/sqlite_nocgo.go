@@ -0,0 +1,15 @@
+//go:build !cgo
+
+package block
+
+import (
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+// SQLiteIndexerFromConfig returns ErrSQLiteNotSupported, since the sqlite
+// indexer requires cgo.
+func SQLiteIndexerFromConfig(*config.Config, string) (txindex.TxIndexer, indexer.BlockIndexer, error) {
+	return nil, nil, ErrSQLiteNotSupported
+}
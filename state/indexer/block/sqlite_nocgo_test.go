@@ -0,0 +1,20 @@
+//go:build !cgo
+
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+func TestIndexerFromConfigSQLiteNotSupported(t *testing.T) {
+	cfg := config.TestConfig()
+	cfg.SetRoot(t.TempDir())
+	cfg.TxIndex.Indexer = "sqlite"
+
+	_, _, err := IndexerFromConfig(cfg, config.DefaultDBProvider, "test-chain")
+	require.ErrorIs(t, err, ErrSQLiteNotSupported)
+}
@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"errors"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// EventFilter restricts the event attributes written by the indexers to a
+// subset of those flagged for indexing by the application.
+//
+// A pattern matches either an event type, e.g. "transfer", selecting all its
+// attributes, or a composite key, e.g. "transfer.sender". Patterns may contain
+// "*" wildcards, matching any sequence of characters, e.g. "transfer.*" or
+// "*.sender".
+//
+// A nil filter indexes all the attributes flagged for indexing.
+type EventFilter struct {
+	allow []string
+	deny  []string
+}
+
+// NewEventFilter returns a filter indexing the attributes which match one of
+// the allow patterns, or all of them if there is none, and none of the deny
+// patterns.
+func NewEventFilter(allow, deny []string) (*EventFilter, error) {
+	for _, patterns := range [][]string{allow, deny} {
+		for _, pattern := range patterns {
+			if pattern == "" {
+				return nil, errors.New("event filter patterns cannot be empty")
+			}
+		}
+	}
+	return &EventFilter{allow: allow, deny: deny}, nil
+}
+
+// IndexAttribute returns whether to index the given attribute of an event of
+// the given type.
+func (f *EventFilter) IndexAttribute(eventType string, attr abci.EventAttribute) bool {
+	if !attr.GetIndex() {
+		return false
+	}
+	if f == nil {
+		return true
+	}
+	compositeKey := eventType + "." + attr.Key
+	if len(f.allow) > 0 && !matchAny(f.allow, eventType, compositeKey) {
+		return false
+	}
+	return !matchAny(f.deny, eventType, compositeKey)
+}
+
+func matchAny(patterns []string, eventType, compositeKey string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, eventType) || matchPattern(pattern, compositeKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether s matches the pattern, in which "*" matches
+// any sequence of characters.
+func matchPattern(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
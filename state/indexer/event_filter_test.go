@@ -0,0 +1,59 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+func TestMatchPattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{"transfer", "transfer", true},
+		{"transfer", "transfers", false},
+		{"transfer.*", "transfer.sender", true},
+		{"transfer.*", "transfer", false},
+		{"*.sender", "transfer.sender", true},
+		{"*.sender", "transfer.recipient", false},
+		{"*", "anything", true},
+		{"t*r.*d*r", "transfer.sender", true},
+		{"t*r.*d*r", "transfer.recipient", false},
+		{"a*a", "a", false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.match, matchPattern(tc.pattern, tc.s), "%q %q", tc.pattern, tc.s)
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	indexed := func(key string) abci.EventAttribute {
+		return abci.EventAttribute{Key: key, Value: "value", Index: true}
+	}
+
+	var nilFilter *EventFilter
+	assert.True(t, nilFilter.IndexAttribute("transfer", indexed("sender")))
+	assert.False(t, nilFilter.IndexAttribute("transfer", abci.EventAttribute{Key: "sender"}))
+
+	filter, err := NewEventFilter([]string{"transfer", "message.action"}, []string{"*.amount"})
+	require.NoError(t, err)
+	assert.True(t, filter.IndexAttribute("transfer", indexed("sender")))
+	assert.False(t, filter.IndexAttribute("transfer", indexed("amount")))
+	assert.False(t, filter.IndexAttribute("transfer", abci.EventAttribute{Key: "recipient"}))
+	assert.True(t, filter.IndexAttribute("message", indexed("action")))
+	assert.False(t, filter.IndexAttribute("message", indexed("sender")))
+	assert.False(t, filter.IndexAttribute("coin_spent", indexed("spender")))
+
+	filter, err = NewEventFilter(nil, []string{"coin_*"})
+	require.NoError(t, err)
+	assert.True(t, filter.IndexAttribute("transfer", indexed("sender")))
+	assert.False(t, filter.IndexAttribute("coin_spent", indexed("spender")))
+
+	_, err = NewEventFilter([]string{""}, nil)
+	require.Error(t, err)
+}
@@ -19,6 +19,20 @@ type BlockIndexer struct {
 	mock.Mock
 }
 
+// DeleteHeightsAbove provides a mock function with given fields: height
+func (_m *BlockIndexer) DeleteHeightsAbove(height int64) error {
+	ret := _m.Called(height)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetRetainHeight provides a mock function with given fields:
 func (_m *BlockIndexer) GetRetainHeight() (int64, error) {
 	ret := _m.Called()
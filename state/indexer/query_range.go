@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"math/big"
+	regexpsyntax "regexp/syntax"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
@@ -179,6 +180,33 @@ func LookForRanges(conditions []syntax.Condition) (ranges QueryRanges, indexes [
 	return ranges, indexes
 }
 
+// ValuePrefix returns the prefix that the attribute values matching the given
+// STARTS WITH or MATCHES condition must begin with, so that only the indexed
+// values having this prefix need to be scanned. The prefix of a regular
+// expression is its leading literal if it is anchored at the start of the
+// value, otherwise it is empty.
+func ValuePrefix(c syntax.Condition) string {
+	switch c.Op {
+	case syntax.TStartsWith:
+		return c.Arg.Value()
+	case syntax.TMatches:
+		re, err := regexpsyntax.Parse(c.Arg.Value(), regexpsyntax.Perl)
+		if err != nil {
+			return ""
+		}
+		re = re.Simplify()
+		if re.Op != regexpsyntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != regexpsyntax.OpBeginText {
+			return ""
+		}
+		if lit := re.Sub[1]; lit.Op == regexpsyntax.OpLiteral && lit.Flags&regexpsyntax.FoldCase == 0 {
+			return string(lit.Rune)
+		}
+		return ""
+	default:
+		return ""
+	}
+}
+
 // IsRangeOperation returns a boolean signifying if a query Operator is a range
 // operation or not.
 func IsRangeOperation(op syntax.Token) bool {
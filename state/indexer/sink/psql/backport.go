@@ -51,6 +51,12 @@ func (b BackportTxIndexer) Prune(_ int64) (int64, int64, error) {
 	return 0, 0, nil
 }
 
+// DeleteHeightsAbove deletes the transactions of the heights above the given
+// one from Postgres, as part of TxIndexer.
+func (b BackportTxIndexer) DeleteHeightsAbove(height int64) error {
+	return b.psql.DeleteTxEventsAbove(height)
+}
+
 // AddBatch indexes a batch of transactions in Postgres, as part of TxIndexer.
 func (b BackportTxIndexer) AddBatch(batch *txindex.Batch) error {
 	return b.psql.IndexTxEvents(batch.Ops)
@@ -67,10 +73,10 @@ func (BackportTxIndexer) Get([]byte) (*abci.TxResult, error) {
 	return nil, errors.New("the TxIndexer.Get method is not supported")
 }
 
-// Search is implemented to satisfy the TxIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Search(context.Context, *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Search method is not supported")
+// Search searches for the transactions matching the query in Postgres, as part
+// of TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.psql.SearchTxEvents(ctx, q)
 }
 
 func (BackportTxIndexer) SetLogger(log.Logger) {}
@@ -98,6 +104,13 @@ func (b BackportBlockIndexer) Prune(_ int64) (int64, int64, error) {
 	return 0, 0, nil
 }
 
+// DeleteHeightsAbove deletes the blocks of the heights above the given one,
+// along with their transactions, from Postgres. It is part of the BlockIndexer
+// interface.
+func (b BackportBlockIndexer) DeleteHeightsAbove(height int64) error {
+	return b.psql.DeleteBlockEventsAbove(height)
+}
+
 // Has is implemented to satisfy the BlockIndexer interface, but it is not
 // supported by the psql event sink and reports an error for all inputs.
 func (BackportBlockIndexer) Has(_ int64) (bool, error) {
@@ -110,10 +123,10 @@ func (b BackportBlockIndexer) Index(block types.EventDataNewBlockEvents) error {
 	return b.psql.IndexBlockEvents(block)
 }
 
-// Search is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
-	return nil, errors.New("the BlockIndexer.Search method is not supported")
+// Search searches for the heights of the blocks matching the query in
+// Postgres. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.psql.SearchBlockEvents(ctx, q)
 }
 
 func (BackportBlockIndexer) SetLogger(log.Logger) {}
@@ -13,6 +13,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -22,6 +23,8 @@ const (
 	tableEvents     = "events"
 	tableAttributes = "attributes"
 	driverName      = "postgres"
+
+	viewEventAttributes = "event_attributes"
 )
 
 // EventSink is an indexer backend providing the tx/block index services.  This
@@ -30,21 +33,36 @@ const (
 type EventSink struct {
 	store   *sql.DB
 	chainID string
+	// Selects the event attributes to index
+	eventFilter *indexer.EventFilter
+}
+
+// Option sets an optional parameter of the EventSink.
+type Option func(*EventSink)
+
+// WithEventFilter restricts the indexed event attributes to those selected by
+// the given filter.
+func WithEventFilter(filter *indexer.EventFilter) Option {
+	return func(es *EventSink) { es.eventFilter = filter }
 }
 
 // NewEventSink constructs an event sink associated with the PostgreSQL
 // database specified by connStr. Events written to the sink are attributed to
 // the specified chainID.
-func NewEventSink(connStr, chainID string) (*EventSink, error) {
+func NewEventSink(connStr, chainID string, options ...Option) (*EventSink, error) {
 	db, err := sql.Open(driverName, connStr)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EventSink{
+	es := &EventSink{
 		store:   db,
 		chainID: chainID,
-	}, nil
+	}
+	for _, option := range options {
+		option(es)
+	}
+	return es, nil
 }
 
 // DB returns the underlying Postgres connection used by the sink.
@@ -82,8 +100,9 @@ func queryWithID(tx *sql.Tx, query string, args ...interface{}) (uint32, error)
 // events into the database associated with dbtx.
 //
 // If txID > 0, the event is attributed to the transaction with that
-// ID; otherwise it is recorded as a block event.
-func insertEvents(dbtx *sql.Tx, blockID, txID uint32, evts []abci.Event) error {
+// ID; otherwise it is recorded as a block event. Only the attributes selected
+// by the filter are inserted, all of them if it is nil.
+func insertEvents(dbtx *sql.Tx, blockID, txID uint32, evts []abci.Event, filter *indexer.EventFilter) error {
 	// Populate the transaction ID field iff one is defined (> 0).
 	var txIDArg interface{}
 	if txID > 0 {
@@ -106,9 +125,9 @@ INSERT INTO `+tableEvents+` (block_id, tx_id, type) VALUES ($1, $2, $3)
 			return err
 		}
 
-		// Add any attributes flagged for indexing.
+		// Add any attributes flagged for indexing and not filtered out.
 		for _, attr := range evt.Attributes {
-			if !attr.Index {
+			if !filter.IndexAttribute(evt.Type, attr) {
 				continue
 			}
 			compositeKey := evt.Type + "." + attr.Key
@@ -160,11 +179,11 @@ INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
 		// Insert the special block meta-event for height.
 		if err := insertEvents(dbtx, blockID, 0, []abci.Event{
 			makeIndexedEvent(types.BlockHeightKey, fmt.Sprint(h.Height)),
-		}); err != nil {
+		}, nil); err != nil {
 			return fmt.Errorf("block meta-events: %w", err)
 		}
 		// Insert all the block events. Order is important here,
-		if err := insertEvents(dbtx, blockID, 0, h.Events); err != nil {
+		if err := insertEvents(dbtx, blockID, 0, h.Events, es.eventFilter); err != nil {
 			return fmt.Errorf("finalizeblock events: %w", err)
 		}
 		return nil
@@ -211,11 +230,11 @@ INSERT INTO `+tableTxResults+` (block_id, index, created_at, tx_hash, tx_result)
 			if err := insertEvents(dbtx, blockID, txID, []abci.Event{
 				makeIndexedEvent(types.TxHashKey, txHash),
 				makeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
-			}); err != nil {
+			}, nil); err != nil {
 				return fmt.Errorf("indexing transaction meta-events: %w", err)
 			}
 			// Index any events packaged with the transaction.
-			if err := insertEvents(dbtx, blockID, txID, txr.Result.Events); err != nil {
+			if err := insertEvents(dbtx, blockID, txID, txr.Result.Events, es.eventFilter); err != nil {
 				return fmt.Errorf("indexing transaction events: %w", err)
 			}
 			return nil
@@ -226,14 +245,121 @@ INSERT INTO `+tableTxResults+` (block_id, index, created_at, tx_hash, tx_result)
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks whose events match the
+// given query, in ascending order. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	b := &queryBuilder{eventFilter: "block_id = " + tableBlocks + ".rowid AND tx_id IS NULL"}
+	chainID := b.arg(es.chainID)
+	pred, err := b.predicate(q.Syntax())
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT height FROM `+tableBlocks+`
+  WHERE chain_id = `+chainID+` AND `+pred+`
+  ORDER BY height;
+`, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	heights := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("scanning block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// the given query, ordered by height and index. It is part of the
+// indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	b := &queryBuilder{eventFilter: "tx_id = " + tableTxResults + ".rowid"}
+	chainID := b.arg(es.chainID)
+	pred, err := b.predicate(q.Syntax())
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE chain_id = `+chainID+` AND `+pred+`
+  ORDER BY height, index;
+`, b.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching transactions: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*abci.TxResult, 0)
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, fmt.Errorf("scanning tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	return results, rows.Err()
+}
+
+// DeleteTxEventsAbove deletes the transaction results of the heights above the
+// given one, along with their events.
+func (es *EventSink) DeleteTxEventsAbove(height int64) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		return deleteEventsAbove(dbtx, es.chainID, height, false)
+	})
+}
+
+// DeleteBlockEventsAbove deletes the blocks of the heights above the given one,
+// along with their transaction results and events.
+func (es *EventSink) DeleteBlockEventsAbove(height int64) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		if err := deleteEventsAbove(dbtx, es.chainID, height, true); err != nil {
+			return err
+		}
+		if _, err := dbtx.Exec(`
+DELETE FROM `+tableBlocks+` WHERE chain_id = $1 AND height > $2;
+`, es.chainID, height); err != nil {
+			return fmt.Errorf("deleting blocks: %w", err)
+		}
+		return nil
+	})
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// deleteEventsAbove deletes the transaction results of the blocks of the
+// heights above the given one, their events and, if withBlockEvents is true,
+// the events of the blocks.
+func deleteEventsAbove(dbtx *sql.Tx, chainID string, height int64, withBlockEvents bool) error {
+	blocks := `SELECT rowid FROM ` + tableBlocks + ` WHERE chain_id = $1 AND height > $2`
+	events := `SELECT rowid FROM ` + tableEvents + ` WHERE block_id IN (` + blocks + `)`
+	if !withBlockEvents {
+		events += ` AND tx_id IS NOT NULL`
+	}
+	if _, err := dbtx.Exec(`DELETE FROM `+tableAttributes+` WHERE event_id IN (`+events+`);`,
+		chainID, height); err != nil {
+		return fmt.Errorf("deleting attributes: %w", err)
+	}
+	if _, err := dbtx.Exec(`DELETE FROM `+tableEvents+` WHERE rowid IN (`+events+`);`,
+		chainID, height); err != nil {
+		return fmt.Errorf("deleting events: %w", err)
+	}
+	if _, err := dbtx.Exec(`DELETE FROM `+tableTxResults+` WHERE block_id IN (`+blocks+`);`,
+		chainID, height); err != nil {
+		return fmt.Errorf("deleting tx results: %w", err)
+	}
+	return nil
 }
 
 // GetTxByHash is not implemented by this sink, and reports an error for all queries.
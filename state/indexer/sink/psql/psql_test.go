@@ -20,6 +20,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 
@@ -149,10 +151,17 @@ func TestIndexing(t *testing.T) {
 		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
 		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		for q, want := range map[string][]int64{
+			"end_event.foo = 100":                                  {1},
+			"end_event.foo > 100 OR thingy.whatzit = 'O.O'":        {1},
+			"NOT begin_event.proposer = 'FCAA001'":                 {},
+			"block.height = 1 AND NOT end_event.foo < 50":          {1},
+			"(thingy.whatzit CONTAINS 'X' OR end_event.foo <= 99)": {},
+		} {
+			heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Equal(t, want, heights, q)
+		}
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -189,16 +198,55 @@ func TestIndexing(t *testing.T) {
 			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
 			return txr != nil, err
 		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		for q, want := range map[string]int{
+			"account.owner = 'Ivan' OR account.owner = 'Vlad'":               1,
+			"account.number = 1 AND NOT account.owner CONTAINS 'Yul'":        0,
+			"tx.height = 1 AND (account.number > 1 OR account.owner EXISTS)": 1,
+			"NOT account EXISTS":                                             0,
+			"account.owner IN ('Vlad', 'Yulieta')":                           1,
+			"account.owner STARTS WITH 'Yul' AND account.owner MATCHES '^I'": 1,
+		} {
+			txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			require.Len(t, txrs, want, q)
+			for _, txr := range txrs {
+				assert.Equal(t, txResult, txr)
+			}
+		}
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
 		require.NoError(t, err)
 	})
 
+	t.Run("IndexTxEventsWithFilter", func(t *testing.T) {
+		filter, err := indexer.NewEventFilter([]string{"payment"}, []string{"payment.memo"})
+		require.NoError(t, err)
+		sink := &EventSink{store: testDB(), chainID: chainID, eventFilter: filter}
+
+		txResult := txResultWithEvents([]abci.Event{
+			makeIndexedEvent("payment.payer", "Ivan"),
+			makeIndexedEvent("payment.memo", "rent"),
+			makeIndexedEvent("refund.payer", "Ivan"),
+		})
+		txResult.Tx = types.Tx("filtered")
+		txResult.Index = 1
+		require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		// The meta-events are indexed whatever the filter.
+		txHash := fmt.Sprintf("%X", types.Tx(txResult.Tx).Hash())
+		for q, want := range map[string]int{
+			"payment.payer = 'Ivan'":     1,
+			"payment.memo = 'rent'":      0,
+			"refund.payer = 'Ivan'":      0,
+			"tx.hash = '" + txHash + "'": 1,
+		} {
+			txrs, err := sink.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			require.Len(t, txrs, want, q)
+		}
+	})
+
 	t.Run("IndexerService", func(t *testing.T) {
 		indexer := &EventSink{store: testDB(), chainID: chainID}
 
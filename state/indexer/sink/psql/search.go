@@ -0,0 +1,146 @@
+package psql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// Regular expressions used to select the attribute values that can be
+// compared to the numbers, dates and timestamps of a query. They match the
+// values accepted by the query package when matching events.
+const (
+	numberPrefixRegexp = `^[0-9]+(?:\.[0-9]+)?`
+	dateRegexp         = `^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	timeRegexp         = `^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[-+][0-9]{2}:[0-9]{2})$`
+)
+
+// sqlOps maps the comparison operators of the query language to SQL.
+var sqlOps = map[syntax.Token]string{
+	syntax.TEq:  "=",
+	syntax.TLt:  "<",
+	syntax.TLeq: "<=",
+	syntax.TGt:  ">",
+	syntax.TGeq: ">=",
+}
+
+// queryBuilder translates query expressions into SQL predicates, collecting
+// the arguments of the statement they are part of.
+type queryBuilder struct {
+	// eventFilter selects, in the event_attributes view, the events of the row
+	// being matched by the predicate.
+	eventFilter string
+	args        []interface{}
+}
+
+// arg adds an argument to the statement and returns its placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// predicate returns an SQL predicate that holds for the rows whose events
+// match the given expression. A nil expression matches all rows.
+func (b *queryBuilder) predicate(expr syntax.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case nil:
+		return "TRUE", nil
+	case syntax.Condition:
+		return b.condition(expr)
+	case syntax.And:
+		return b.join(expr, " AND ")
+	case syntax.Or:
+		return b.join(expr, " OR ")
+	case syntax.Not:
+		pred, err := b.predicate(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + pred, nil
+	default:
+		return "", fmt.Errorf("unsupported query expression %s", expr)
+	}
+}
+
+func (b *queryBuilder) join(exprs []syntax.Expr, sep string) (string, error) {
+	preds := make([]string, len(exprs))
+	for i, expr := range exprs {
+		pred, err := b.predicate(expr)
+		if err != nil {
+			return "", err
+		}
+		preds[i] = pred
+	}
+	return "(" + strings.Join(preds, sep) + ")", nil
+}
+
+// condition returns an SQL predicate that holds for the rows having at least
+// one event attribute matching the given condition.
+func (b *queryBuilder) condition(cond syntax.Condition) (string, error) {
+	tag := b.arg(cond.Tag)
+	if cond.Op == syntax.TExists {
+		// As when matching events, a tag equal to the type of an event
+		// matches this event.
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND (composite_key = %s OR type = %s))",
+			viewEventAttributes, b.eventFilter, tag, tag), nil
+	}
+	if cond.Arg == nil && len(cond.Args) == 0 {
+		return "", fmt.Errorf("missing argument for %v", cond.Op)
+	}
+
+	var match string
+	if cond.Op == syntax.TIn {
+		matches := make([]string, len(cond.Args))
+		for i, arg := range cond.Args {
+			if matches[i] = b.valueMatch(syntax.TEq, arg); matches[i] == "" {
+				return "", fmt.Errorf("invalid op/arg combination (%v, %v)", syntax.TEq, arg.Type)
+			}
+		}
+		match = "(" + strings.Join(matches, " OR ") + ")"
+	} else {
+		match = b.valueMatch(cond.Op, cond.Arg)
+	}
+	if match == "" {
+		return "", fmt.Errorf("invalid op/arg combination (%v, %v)", cond.Op, cond.Arg.Type)
+	}
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND composite_key = %s AND %s)",
+		viewEventAttributes, b.eventFilter, tag, match), nil
+}
+
+// valueMatch returns an SQL predicate on the value of an event attribute for
+// the given operator and argument, or "" if they cannot be combined.
+func (b *queryBuilder) valueMatch(op syntax.Token, arg *syntax.Arg) string {
+	if arg == nil {
+		return ""
+	}
+	switch arg.Type {
+	case syntax.TString:
+		switch op {
+		case syntax.TEq:
+			return "value = " + b.arg(arg.Value())
+		case syntax.TContains:
+			return "strpos(value, " + b.arg(arg.Value()) + ") > 0"
+		case syntax.TStartsWith:
+			return "starts_with(value, " + b.arg(arg.Value()) + ")"
+		case syntax.TMatches:
+			return "value ~ " + b.arg(arg.Value())
+		}
+	case syntax.TNumber:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("substring(value from '%s')::numeric %s %s::numeric",
+				numberPrefixRegexp, sqlOp, b.arg(arg.Value()))
+		}
+	case syntax.TDate:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("CASE WHEN value ~ '%s' THEN value::date %s %s::date END",
+				dateRegexp, sqlOp, b.arg(arg.Value()))
+		}
+	case syntax.TTime:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("CASE WHEN value ~ '%s' THEN value::timestamptz %s %s::timestamptz END",
+				timeRegexp, sqlOp, b.arg(arg.Value()))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,6 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database, which needs no database server.
+//
+// The SQLite driver requires cgo, so the event sink is only compiled in the
+// binaries built with it.
+package sqlite
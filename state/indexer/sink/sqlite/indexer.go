@@ -0,0 +1,164 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxIndexer returns the transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndexer {
+	return TxIndexer{sqlite: es}
+}
+
+// TxIndexer implements the txindex.TxIndexer and txindex.Pager interfaces by
+// delegating indexing operations to an underlying SQLite event sink.
+type TxIndexer struct{ sqlite *EventSink }
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (t TxIndexer) AddBatch(batch *txindex.Batch) error {
+	return t.sqlite.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (t TxIndexer) Index(txr *abci.TxResult) error {
+	return t.sqlite.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the result of the transaction with the given hash, or nil if it
+// is not indexed, as part of TxIndexer.
+func (t TxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+	return t.sqlite.GetTxByHash(hash)
+}
+
+// Search searches for the transactions matching the query in SQLite, as part
+// of TxIndexer.
+func (t TxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return t.sqlite.SearchTxEvents(ctx, q)
+}
+
+// SearchPage returns a page of the transactions matching the query in SQLite,
+// as part of txindex.Pager.
+func (t TxIndexer) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	after *txindex.Position,
+	limit int,
+	desc bool,
+) ([]*abci.TxResult, error) {
+	return t.sqlite.SearchTxEventsPage(ctx, q, after, limit, desc)
+}
+
+func (TxIndexer) SetLogger(log.Logger) {}
+
+// Prune deletes the transactions of the heights below retainHeight, as part of
+// TxIndexer.
+func (t TxIndexer) Prune(retainHeight int64) (int64, int64, error) {
+	return t.sqlite.prune(retainHeight, false)
+}
+
+// GetRetainHeight returns the retain height of the transactions set by
+// SetRetainHeight, as part of TxIndexer.
+func (t TxIndexer) GetRetainHeight() (int64, error) {
+	return getRetainHeight(t.sqlite, retainHeightTxIndexer)
+}
+
+// SetRetainHeight records the retain height of the transactions, as part of
+// TxIndexer.
+func (t TxIndexer) SetRetainHeight(retainHeight int64) error {
+	return t.sqlite.setRetainHeight(t.sqlite.store, retainHeightTxIndexer, retainHeight)
+}
+
+// DeleteHeightsAbove deletes the transactions of the heights above the given
+// one from SQLite, as part of TxIndexer.
+func (t TxIndexer) DeleteHeightsAbove(height int64) error {
+	return t.sqlite.DeleteTxEventsAbove(height)
+}
+
+// BlockIndexer returns the block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndexer {
+	return BlockIndexer{sqlite: es}
+}
+
+// BlockIndexer implements the indexer.BlockIndexer and indexer.BlockPager
+// interfaces by delegating indexing operations to an underlying SQLite event
+// sink.
+type BlockIndexer struct{ sqlite *EventSink }
+
+// Has returns whether the events of the block at the given height are indexed.
+// It is part of the BlockIndexer interface.
+func (b BlockIndexer) Has(height int64) (bool, error) {
+	return b.sqlite.HasBlock(height)
+}
+
+// Index indexes the events of the specified block. It is part of the
+// BlockIndexer interface.
+func (b BlockIndexer) Index(block types.EventDataNewBlockEvents) error {
+	return b.sqlite.IndexBlockEvents(block)
+}
+
+// Search searches for the heights of the blocks matching the query in SQLite.
+// It is part of the BlockIndexer interface.
+func (b BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sqlite.SearchBlockEvents(ctx, q)
+}
+
+// SearchPage returns a page of the heights of the blocks matching the query in
+// SQLite. It is part of the BlockPager interface.
+func (b BlockIndexer) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	after int64,
+	limit int,
+	desc bool,
+) ([]int64, error) {
+	return b.sqlite.SearchBlockEventsPage(ctx, q, after, limit, desc)
+}
+
+func (BlockIndexer) SetLogger(log.Logger) {}
+
+// Prune deletes the block events of the heights below retainHeight. It is part
+// of the BlockIndexer interface.
+func (b BlockIndexer) Prune(retainHeight int64) (int64, int64, error) {
+	return b.sqlite.prune(retainHeight, true)
+}
+
+// GetRetainHeight returns the retain height of the block events set by
+// SetRetainHeight. It is part of the BlockIndexer interface.
+func (b BlockIndexer) GetRetainHeight() (int64, error) {
+	return getRetainHeight(b.sqlite, retainHeightBlockIndexer)
+}
+
+// SetRetainHeight records the retain height of the block events. It is part
+// of the BlockIndexer interface.
+func (b BlockIndexer) SetRetainHeight(retainHeight int64) error {
+	return b.sqlite.setRetainHeight(b.sqlite.store, retainHeightBlockIndexer, retainHeight)
+}
+
+// DeleteHeightsAbove deletes the blocks of the heights above the given one,
+// along with their transactions, from SQLite. It is part of the BlockIndexer
+// interface.
+func (b BlockIndexer) DeleteHeightsAbove(height int64) error {
+	return b.sqlite.DeleteBlockEventsAbove(height)
+}
+
+// getRetainHeight returns the named retain height, or state.ErrKeyNotFound if
+// it was never set, as the key-value indexers do.
+func getRetainHeight(es *EventSink, name string) (int64, error) {
+	height, err := es.getRetainHeight(name)
+	if errors.Is(err, errRetainHeightNotFound) {
+		return 0, state.ErrKeyNotFound
+	}
+	return height, err
+}
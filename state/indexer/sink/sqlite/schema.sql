@@ -0,0 +1,106 @@
+/*
+  This file defines the database schema for the SQLite ("sqlite") event sink
+  implementation in CometBFT. It follows the schema of the PostgreSQL event
+  sink, and is installed by the sink when it opens the database.
+ */
+
+-- The blocks table records metadata about each block.
+-- The block record does not include its events or transactions (see tx_results).
+CREATE TABLE IF NOT EXISTS blocks (
+  rowid      INTEGER PRIMARY KEY,
+
+  height     INTEGER NOT NULL,
+  chain_id   TEXT NOT NULL,
+
+  -- When this block header was logged into the sink, in UTC.
+  created_at TIMESTAMP NOT NULL,
+
+  UNIQUE (height, chain_id)
+);
+
+-- Index blocks by height and chain, since we need to resolve block IDs when
+-- indexing transaction records and transaction events.
+CREATE INDEX IF NOT EXISTS idx_blocks_height_chain ON blocks(height, chain_id);
+
+-- The tx_results table records metadata about transaction results.  Note that
+-- the events from a transaction are stored separately.
+CREATE TABLE IF NOT EXISTS tx_results (
+  rowid INTEGER PRIMARY KEY,
+
+  -- The block to which this transaction belongs.
+  block_id INTEGER NOT NULL REFERENCES blocks(rowid),
+  -- The sequential index of the transaction within the block.
+  "index" INTEGER NOT NULL,
+  -- When this result record was logged into the sink, in UTC.
+  created_at TIMESTAMP NOT NULL,
+  -- The hex-encoded hash of the transaction.
+  tx_hash TEXT NOT NULL,
+  -- The protobuf wire encoding of the TxResult message.
+  tx_result BLOB NOT NULL,
+
+  UNIQUE (block_id, "index")
+);
+
+-- Index transactions by hash, to look them up.
+CREATE INDEX IF NOT EXISTS idx_tx_results_hash ON tx_results(tx_hash);
+
+-- The events table records events. All events (both block and transaction) are
+-- associated with a block ID; transaction events also have a transaction ID.
+CREATE TABLE IF NOT EXISTS events (
+  rowid INTEGER PRIMARY KEY,
+
+  -- The block and transaction this event belongs to.
+  -- If tx_id is NULL, this is a block event.
+  block_id INTEGER NOT NULL REFERENCES blocks(rowid),
+  tx_id    INTEGER NULL REFERENCES tx_results(rowid),
+
+  -- The application-defined type label for the event.
+  type TEXT NOT NULL
+);
+
+-- Index events by block and transaction, to match them in searches.
+CREATE INDEX IF NOT EXISTS idx_events_block_tx ON events(block_id, tx_id);
+CREATE INDEX IF NOT EXISTS idx_events_tx ON events(tx_id);
+
+-- The attributes table records event attributes.
+CREATE TABLE IF NOT EXISTS attributes (
+   event_id      INTEGER NOT NULL REFERENCES events(rowid),
+   key           TEXT NOT NULL, -- bare key
+   composite_key TEXT NOT NULL, -- composed type.key
+   value         TEXT NULL,
+
+   UNIQUE (event_id, key)
+);
+
+-- Index attributes by composite key and value, to match them in searches.
+CREATE INDEX IF NOT EXISTS idx_attributes_composite_key ON attributes(composite_key, value);
+
+-- The retain_heights table records the retain heights set by the pruning
+-- service, and the heights up to which the indexes were pruned.
+CREATE TABLE IF NOT EXISTS retain_heights (
+  chain_id TEXT NOT NULL,
+  -- Which index and retain height this is, e.g. "tx_indexer".
+  name     TEXT NOT NULL,
+  height   INTEGER NOT NULL,
+
+  UNIQUE (chain_id, name)
+);
+
+-- A joined view of events and their attributes. Events that do not have any
+-- attributes are represented as a single row with empty key and value fields.
+CREATE VIEW IF NOT EXISTS event_attributes AS
+  SELECT block_id, tx_id, type, key, composite_key, value
+  FROM events LEFT JOIN attributes ON (events.rowid = attributes.event_id);
+
+-- A joined view of all block events (those having tx_id NULL).
+CREATE VIEW IF NOT EXISTS block_events AS
+  SELECT blocks.rowid as block_id, height, chain_id, type, key, composite_key, value
+  FROM blocks JOIN event_attributes ON (blocks.rowid = event_attributes.block_id)
+  WHERE event_attributes.tx_id IS NULL;
+
+-- A joined view of all transaction events.
+CREATE VIEW IF NOT EXISTS tx_events AS
+  SELECT height, "index", chain_id, type, key, composite_key, value, tx_results.created_at
+  FROM blocks JOIN tx_results ON (blocks.rowid = tx_results.block_id)
+  JOIN event_attributes ON (tx_results.rowid = event_attributes.tx_id)
+  WHERE event_attributes.tx_id IS NOT NULL;
@@ -0,0 +1,245 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// sqlOps maps the comparison operators of the query language to SQL.
+var sqlOps = map[syntax.Token]string{
+	syntax.TEq:  "=",
+	syntax.TLt:  "<",
+	syntax.TLeq: "<=",
+	syntax.TGt:  ">",
+	syntax.TGeq: ">=",
+}
+
+// queryBuilder translates query expressions into SQL predicates, collecting
+// the arguments of the statement they are part of.
+type queryBuilder struct {
+	// eventFilter selects, in the event_attributes view, the events of the row
+	// being matched by the predicate.
+	eventFilter string
+	args        []interface{}
+}
+
+// arg adds an argument to the statement and returns its placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("?%d", len(b.args))
+}
+
+// predicate returns an SQL predicate that holds for the rows whose events
+// match the given expression. A nil expression matches all rows.
+func (b *queryBuilder) predicate(expr syntax.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case nil:
+		return "TRUE", nil
+	case syntax.Condition:
+		return b.condition(expr)
+	case syntax.And:
+		return b.join(expr, " AND ")
+	case syntax.Or:
+		return b.join(expr, " OR ")
+	case syntax.Not:
+		pred, err := b.predicate(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + pred, nil
+	default:
+		return "", fmt.Errorf("unsupported query expression %s", expr)
+	}
+}
+
+func (b *queryBuilder) join(exprs []syntax.Expr, sep string) (string, error) {
+	preds := make([]string, len(exprs))
+	for i, expr := range exprs {
+		pred, err := b.predicate(expr)
+		if err != nil {
+			return "", err
+		}
+		preds[i] = pred
+	}
+	return "(" + strings.Join(preds, sep) + ")", nil
+}
+
+// condition returns an SQL predicate that holds for the rows having at least
+// one event attribute matching the given condition.
+func (b *queryBuilder) condition(cond syntax.Condition) (string, error) {
+	tag := b.arg(cond.Tag)
+	if cond.Op == syntax.TExists {
+		// As when matching events, a tag equal to the type of an event
+		// matches this event.
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND (composite_key = %s OR type = %s))",
+			viewEventAttributes, b.eventFilter, tag, tag), nil
+	}
+	if cond.Arg == nil && len(cond.Args) == 0 {
+		return "", fmt.Errorf("missing argument for %v", cond.Op)
+	}
+
+	var match string
+	if cond.Op == syntax.TIn {
+		matches := make([]string, len(cond.Args))
+		for i, arg := range cond.Args {
+			if matches[i] = b.valueMatch(syntax.TEq, arg); matches[i] == "" {
+				return "", fmt.Errorf("invalid op/arg combination (%v, %v)", syntax.TEq, arg.Type)
+			}
+		}
+		match = "(" + strings.Join(matches, " OR ") + ")"
+	} else {
+		match = b.valueMatch(cond.Op, cond.Arg)
+	}
+	if match == "" {
+		return "", fmt.Errorf("invalid op/arg combination (%v, %v)", cond.Op, cond.Arg.Type)
+	}
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND composite_key = %s AND %s)",
+		viewEventAttributes, b.eventFilter, tag, match), nil
+}
+
+// valueMatch returns an SQL predicate on the value of an event attribute for
+// the given operator and argument, or "" if they cannot be combined. Numbers,
+// dates and timestamps are compared by the functions registered on the
+// connections of the sink, since SQLite has no such types.
+func (b *queryBuilder) valueMatch(op syntax.Token, arg *syntax.Arg) string {
+	if arg == nil {
+		return ""
+	}
+	switch arg.Type {
+	case syntax.TString:
+		switch op {
+		case syntax.TEq:
+			return "value = " + b.arg(arg.Value())
+		case syntax.TContains:
+			return "instr(value, " + b.arg(arg.Value()) + ") > 0"
+		case syntax.TStartsWith:
+			v := b.arg(arg.Value())
+			return "substr(value, 1, length(" + v + ")) = " + v
+		case syntax.TMatches:
+			return "value REGEXP " + b.arg(arg.Value())
+		}
+	case syntax.TNumber:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("%s(value, %s) %s 0", funcCompareNumber, b.arg(arg.Value()), sqlOp)
+		}
+	case syntax.TDate:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("%s(value, %s) %s 0", funcCompareDate, b.arg(arg.Value()), sqlOp)
+		}
+	case syntax.TTime:
+		if sqlOp, ok := sqlOps[op]; ok {
+			return fmt.Sprintf("%s(value, %s) %s 0", funcCompareTime, b.arg(arg.Value()), sqlOp)
+		}
+	}
+	return ""
+}
+
+// The names of the SQL functions comparing the value of an event attribute to
+// the argument of a condition. They return -1, 0 or 1 as the value is less
+// than, equal to or greater than the argument, or NULL if the value is not of
+// the type of the argument.
+const (
+	funcCompareNumber = "cmt_compare_number"
+	funcCompareDate   = "cmt_compare_date"
+	funcCompareTime   = "cmt_compare_time"
+)
+
+// registerFunctions registers the functions used by the translated queries on
+// a new connection.
+func registerFunctions(conn *sqlite3.SQLiteConn) error {
+	// The regexp function implements the REGEXP operator. The last pattern
+	// is kept, since a query matches all the values against the same one.
+	var re *regexp.Regexp
+	if err := conn.RegisterFunc("regexp", func(pattern string, value interface{}) (bool, error) {
+		s, ok := text(value)
+		if !ok {
+			return false, nil
+		}
+		if re == nil || re.String() != pattern {
+			var err error
+			if re, err = regexp.Compile(pattern); err != nil {
+				return false, err
+			}
+		}
+		return re.MatchString(s), nil
+	}, true); err != nil {
+		return err
+	}
+
+	if err := conn.RegisterFunc(funcCompareNumber, func(value interface{}, arg string) interface{} {
+		s, ok := text(value)
+		if !ok {
+			return nil
+		}
+		v, err := parseNumber(s)
+		if err != nil {
+			return nil
+		}
+		a, err := parseNumber(arg)
+		if err != nil {
+			return nil
+		}
+		return v.Cmp(a)
+	}, true); err != nil {
+		return err
+	}
+
+	compareTime := func(parse func(string) (time.Time, error)) func(interface{}, string) interface{} {
+		return func(value interface{}, arg string) interface{} {
+			s, ok := text(value)
+			if !ok {
+				return nil
+			}
+			v, err := parse(s)
+			if err != nil {
+				return nil
+			}
+			a, err := parse(arg)
+			if err != nil {
+				return nil
+			}
+			return v.Compare(a)
+		}
+	}
+	if err := conn.RegisterFunc(funcCompareDate, compareTime(syntax.ParseDate), true); err != nil {
+		return err
+	}
+	return conn.RegisterFunc(funcCompareTime, compareTime(syntax.ParseTime), true)
+}
+
+// text returns the string stored in an SQL value, if it holds one.
+func text(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
+
+// extractNum selects the number prefixing a value, as the query package does
+// to support values such as "8atom".
+var extractNum = regexp.MustCompile(`^\d+(\.\d+)?`)
+
+// parseNumber parses the number prefixing s with the precision used by the
+// query package when matching events.
+func parseNumber(s string) (*big.Float, error) {
+	intVal := new(big.Int)
+	prec := uint(125)
+	if _, ok := intVal.SetString(s, 10); ok {
+		prec = uint(intVal.BitLen())
+	}
+	f, _, err := big.ParseFloat(extractNum.FindString(s), 10, prec, big.ToNearestEven)
+	return f, err
+}
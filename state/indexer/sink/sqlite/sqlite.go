@@ -0,0 +1,598 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/mattn/go-sqlite3"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	tableBlocks        = "blocks"
+	tableTxResults     = "tx_results"
+	tableEvents        = "events"
+	tableAttributes    = "attributes"
+	tableRetainHeights = "retain_heights"
+	driverName         = "sqlite3_cometbft"
+
+	viewEventAttributes = "event_attributes"
+
+	// DBFile is the name of the database file of the sink in the data
+	// directory of the node.
+	DBFile = "tx_index.sqlite"
+)
+
+// schema defines the tables and views of the database, see schema.sql.
+//
+//go:embed schema.sql
+var schema string
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{ConnectHook: registerFunctions})
+}
+
+// EventSink is an indexer backend providing the tx/block index services. This
+// implementation stores records in an SQLite database using the schema
+// defined in state/indexer/sink/sqlite/schema.sql.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+	// Selects the event attributes to index
+	eventFilter *indexer.EventFilter
+}
+
+// Option sets an optional parameter of the EventSink.
+type Option func(*EventSink)
+
+// WithEventFilter restricts the indexed event attributes to those selected by
+// the given filter.
+func WithEventFilter(filter *indexer.EventFilter) Option {
+	return func(es *EventSink) { es.eventFilter = filter }
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// stored in the file at path, which is created along with the schema if
+// needed. Events written to the sink are attributed to the specified chainID.
+func NewEventSink(path, chainID string, options ...Option) (*EventSink, error) {
+	if err := cmtos.EnsureDir(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	// Write-ahead logging lets searches run while blocks are indexed, and
+	// transactions lock the database upfront so that concurrent writers wait
+	// for each other rather than fail.
+	db, err := sql.Open(driverName,
+		"file:"+path+"?_journal_mode=WAL&_busy_timeout=5000&_foreign_keys=on&_txlock=immediate")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("creating schema: %w", err)
+	}
+
+	es := &EventSink{
+		store:   db,
+		chainID: chainID,
+	}
+	for _, option := range options {
+		option(es)
+	}
+	return es, nil
+}
+
+// DB returns the underlying SQLite connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// queryWithID executes the specified SQL query with the given arguments,
+// expecting a single-row, single-column result containing an ID. If the query
+// succeeds, the ID from the result is returned.
+func queryWithID(tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	var id int64
+	if err := tx.QueryRow(query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// insertEvents inserts a slice of events and any indexed attributes of those
+// events into the database associated with dbtx.
+//
+// If txID > 0, the event is attributed to the transaction with that
+// ID; otherwise it is recorded as a block event. Only the attributes selected
+// by the filter are inserted, all of them if it is nil.
+func insertEvents(dbtx *sql.Tx, blockID, txID int64, evts []abci.Event, filter *indexer.EventFilter) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg interface{}
+	if txID > 0 {
+		txIDArg = txID
+	}
+
+	// Add each event to the events table, and retrieve its row ID to use when
+	// adding any attributes the event provides.
+	for _, evt := range evts {
+		// Skip events with an empty type.
+		if evt.Type == "" {
+			continue
+		}
+
+		eid, err := queryWithID(dbtx, `
+INSERT INTO `+tableEvents+` (block_id, tx_id, type) VALUES (?1, ?2, ?3)
+  RETURNING rowid;
+`, blockID, txIDArg, evt.Type)
+		if err != nil {
+			return err
+		}
+
+		// Add any attributes flagged for indexing and not filtered out.
+		for _, attr := range evt.Attributes {
+			if !filter.IndexAttribute(evt.Type, attr) {
+				continue
+			}
+			compositeKey := evt.Type + "." + attr.Key
+			if _, err := dbtx.Exec(`
+INSERT INTO `+tableAttributes+` (event_id, key, composite_key, value)
+  VALUES (?1, ?2, ?3, ?4);
+`, eid, attr.Key, compositeKey, attr.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: compositeKey[i+1:], Value: value, Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockEvents) error {
+	ts := time.Now().UTC()
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		blockID, err := queryWithID(dbtx, `
+INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
+  VALUES (?1, ?2, ?3)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, h.Height, es.chainID, ts)
+		if err == sql.ErrNoRows {
+			return nil // we already saw this block; quietly succeed
+		} else if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		if err := insertEvents(dbtx, blockID, 0, []abci.Event{
+			makeIndexedEvent(types.BlockHeightKey, fmt.Sprint(h.Height)),
+		}, nil); err != nil {
+			return fmt.Errorf("block meta-events: %w", err)
+		}
+		// Insert all the block events. Order is important here,
+		if err := insertEvents(dbtx, blockID, 0, h.Events, es.eventFilter); err != nil {
+			return fmt.Errorf("finalizeblock events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results, part of the
+// indexer.EventSink interface. The blocks of the transactions must have been
+// indexed before.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC()
+
+	for _, txr := range txrs {
+		// Encode the result message in protobuf wire format for indexing.
+		resultData, err := proto.Marshal(txr)
+		if err != nil {
+			return fmt.Errorf("marshaling tx_result: %w", err)
+		}
+
+		// Index the hash of the underlying transaction as a hex string.
+		txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+		if err := runInTransaction(es.store, func(dbtx *sql.Tx) error {
+			// Find the block associated with this transaction. The block header
+			// must have been indexed prior to the transactions belonging to it.
+			blockID, err := queryWithID(dbtx, `
+SELECT rowid FROM `+tableBlocks+` WHERE height = ?1 AND chain_id = ?2;
+`, txr.Height, es.chainID)
+			if err != nil {
+				return fmt.Errorf("finding block ID: %w", err)
+			}
+
+			// Insert a record for this tx_result and capture its ID for indexing events.
+			txID, err := queryWithID(dbtx, `
+INSERT INTO `+tableTxResults+` (block_id, "index", created_at, tx_hash, tx_result)
+  VALUES (?1, ?2, ?3, ?4, ?5)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, blockID, txr.Index, ts, txHash, resultData)
+			if err == sql.ErrNoRows {
+				return nil // we already saw this transaction; quietly succeed
+			} else if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			if err := insertEvents(dbtx, blockID, txID, []abci.Event{
+				makeIndexedEvent(types.TxHashKey, txHash),
+				makeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
+			}, nil); err != nil {
+				return fmt.Errorf("indexing transaction meta-events: %w", err)
+			}
+			// Index any events packaged with the transaction.
+			if err := insertEvents(dbtx, blockID, txID, txr.Result.Events, es.eventFilter); err != nil {
+				return fmt.Errorf("indexing transaction events: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockSearch returns a query builder and the FROM and WHERE clauses selecting
+// the blocks whose events match q. Only the blocks whose events were not
+// pruned can match.
+func (es *EventSink) blockSearch(q *query.Query) (*queryBuilder, string, error) {
+	b := &queryBuilder{eventFilter: "block_id = " + tableBlocks + ".rowid AND tx_id IS NULL"}
+	chainID := b.arg(es.chainID)
+	pred, err := b.predicate(q.Syntax())
+	if err != nil {
+		return nil, "", fmt.Errorf("translating query: %w", err)
+	}
+	return b, `
+FROM ` + tableBlocks + `
+  WHERE chain_id = ` + chainID + ` AND ` + pred + `
+  AND EXISTS (SELECT 1 FROM ` + tableEvents + ` WHERE ` + b.eventFilter + `)`, nil
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match the
+// given query, in ascending order. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	b, from, err := es.blockSearch(q)
+	if err != nil {
+		return nil, err
+	}
+	return es.queryHeights(ctx, `SELECT height `+from+` ORDER BY height;`, b.args...)
+}
+
+// SearchBlockEventsPage returns at most limit heights of the blocks whose
+// events match the given query, coming after the given height in ascending
+// (or descending if desc is set) order. A zero height starts from the first
+// block.
+func (es *EventSink) SearchBlockEventsPage(
+	ctx context.Context,
+	q *query.Query,
+	after int64,
+	limit int,
+	desc bool,
+) ([]int64, error) {
+	b, from, err := es.blockSearch(q)
+	if err != nil {
+		return nil, err
+	}
+	order, cmp := "ASC", ">"
+	if desc {
+		order, cmp = "DESC", "<"
+	}
+	if after != 0 {
+		from += ` AND height ` + cmp + ` ` + b.arg(after)
+	}
+	return es.queryHeights(ctx, `SELECT height `+from+`
+  ORDER BY height `+order+` LIMIT `+b.arg(limit)+`;`, b.args...)
+}
+
+func (es *EventSink) queryHeights(ctx context.Context, query string, args ...interface{}) ([]int64, error) {
+	rows, err := es.store.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	heights := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("scanning block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
+}
+
+// txSearch returns a query builder and the FROM and WHERE clauses selecting
+// the transactions whose events match q.
+func (es *EventSink) txSearch(q *query.Query) (*queryBuilder, string, error) {
+	b := &queryBuilder{eventFilter: "tx_id = " + tableTxResults + ".rowid"}
+	chainID := b.arg(es.chainID)
+	pred, err := b.predicate(q.Syntax())
+	if err != nil {
+		return nil, "", fmt.Errorf("translating query: %w", err)
+	}
+	return b, `
+FROM ` + tableTxResults + `
+  JOIN ` + tableBlocks + ` ON (` + tableBlocks + `.rowid = ` + tableTxResults + `.block_id)
+  WHERE chain_id = ` + chainID + ` AND ` + pred, nil
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// the given query, ordered by height and index. It is part of the
+// indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	b, from, err := es.txSearch(q)
+	if err != nil {
+		return nil, err
+	}
+	return es.queryTxResults(ctx, `SELECT tx_result `+from+` ORDER BY height, "index";`, b.args...)
+}
+
+// SearchTxEventsPage returns at most limit results of the transactions whose
+// events match the given query, coming after the given position in ascending
+// (or descending if desc is set) order. A nil position starts from the first
+// transaction.
+func (es *EventSink) SearchTxEventsPage(
+	ctx context.Context,
+	q *query.Query,
+	after *txindex.Position,
+	limit int,
+	desc bool,
+) ([]*abci.TxResult, error) {
+	b, from, err := es.txSearch(q)
+	if err != nil {
+		return nil, err
+	}
+	order, cmp := "ASC", ">"
+	if desc {
+		order, cmp = "DESC", "<"
+	}
+	if after != nil {
+		from += ` AND (height, "index") ` + cmp + ` (` + b.arg(after.Height) + `, ` + b.arg(after.Index) + `)`
+	}
+	return es.queryTxResults(ctx, `SELECT tx_result `+from+`
+  ORDER BY height `+order+`, "index" `+order+` LIMIT `+b.arg(limit)+`;`, b.args...)
+}
+
+func (es *EventSink) queryTxResults(ctx context.Context, query string, args ...interface{}) ([]*abci.TxResult, error) {
+	rows, err := es.store.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching transactions: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*abci.TxResult, 0)
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, fmt.Errorf("scanning tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	return results, rows.Err()
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed. If the transaction was included more than once,
+// the result of the first inclusion is returned.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	results, err := es.queryTxResults(context.Background(), `
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE chain_id = ?1 AND tx_hash = ?2
+  ORDER BY height, "index" LIMIT 1;
+`, es.chainID, fmt.Sprintf("%X", hash))
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// HasBlock returns whether the events of the block at the given height are
+// indexed.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var has bool
+	err := es.store.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM `+tableBlocks+`
+  JOIN `+tableEvents+` ON (`+tableEvents+`.block_id = `+tableBlocks+`.rowid)
+  WHERE chain_id = ?1 AND height = ?2 AND tx_id IS NULL);
+`, es.chainID, height).Scan(&has)
+	return has, err
+}
+
+// DeleteTxEventsAbove deletes the transaction results of the heights above the
+// given one, along with their events.
+func (es *EventSink) DeleteTxEventsAbove(height int64) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		return deleteEvents(dbtx, blocksAbove, []interface{}{es.chainID, height}, true, false)
+	})
+}
+
+// DeleteBlockEventsAbove deletes the blocks of the heights above the given one,
+// along with their transaction results and events.
+func (es *EventSink) DeleteBlockEventsAbove(height int64) error {
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		if err := deleteEvents(dbtx, blocksAbove, []interface{}{es.chainID, height}, true, true); err != nil {
+			return err
+		}
+		if _, err := dbtx.Exec(`
+DELETE FROM `+tableBlocks+` WHERE chain_id = ?1 AND height > ?2;
+`, es.chainID, height); err != nil {
+			return fmt.Errorf("deleting blocks: %w", err)
+		}
+		return nil
+	})
+}
+
+// Queries selecting the IDs of the blocks above a height, and of those in a
+// range of heights.
+const (
+	blocksAbove   = `SELECT rowid FROM ` + tableBlocks + ` WHERE chain_id = ?1 AND height > ?2`
+	blocksInRange = `SELECT rowid FROM ` + tableBlocks + ` WHERE chain_id = ?1 AND height >= ?2 AND height < ?3`
+)
+
+// deleteEvents deletes the events of the blocks selected by the given query,
+// along with their attributes: those of their transactions, and the
+// transaction results, if txEvents is set, and those of the blocks themselves
+// if blockEvents is set.
+func deleteEvents(dbtx *sql.Tx, blocks string, args []interface{}, txEvents, blockEvents bool) error {
+	events := `SELECT rowid FROM ` + tableEvents + ` WHERE block_id IN (` + blocks + `)`
+	switch {
+	case txEvents && !blockEvents:
+		events += ` AND tx_id IS NOT NULL`
+	case blockEvents && !txEvents:
+		events += ` AND tx_id IS NULL`
+	}
+	if _, err := dbtx.Exec(`DELETE FROM `+tableAttributes+` WHERE event_id IN (`+events+`);`,
+		args...); err != nil {
+		return fmt.Errorf("deleting attributes: %w", err)
+	}
+	if _, err := dbtx.Exec(`DELETE FROM `+tableEvents+` WHERE rowid IN (`+events+`);`,
+		args...); err != nil {
+		return fmt.Errorf("deleting events: %w", err)
+	}
+	if txEvents {
+		if _, err := dbtx.Exec(`DELETE FROM `+tableTxResults+` WHERE block_id IN (`+blocks+`);`,
+			args...); err != nil {
+			return fmt.Errorf("deleting tx results: %w", err)
+		}
+	}
+	return nil
+}
+
+// The names of the heights recorded in the retain_heights table.
+const (
+	retainHeightTxIndexer    = "tx_indexer"
+	retainHeightBlockIndexer = "block_indexer"
+	// The suffix of the heights up to which the indexes were pruned.
+	prunedSuffix = "_pruned"
+)
+
+// errRetainHeightNotFound is returned when a height was never recorded.
+var errRetainHeightNotFound = errors.New("retain height not found")
+
+func (es *EventSink) getRetainHeight(name string) (int64, error) {
+	var height int64
+	err := es.store.QueryRow(`
+SELECT height FROM `+tableRetainHeights+` WHERE chain_id = ?1 AND name = ?2;
+`, es.chainID, name).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, errRetainHeightNotFound
+	}
+	return height, err
+}
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func (es *EventSink) setRetainHeight(db execer, name string, height int64) error {
+	_, err := db.Exec(`
+INSERT INTO `+tableRetainHeights+` (chain_id, name, height) VALUES (?1, ?2, ?3)
+  ON CONFLICT (chain_id, name) DO UPDATE SET height = excluded.height;
+`, es.chainID, name, height)
+	return err
+}
+
+// prune deletes the transactions, or the block events if blockEvents is set,
+// of the heights below retainHeight which were not pruned yet. The blocks left
+// without events nor transactions are deleted too. It returns the number of
+// heights pruned and the height up to which the index is pruned.
+func (es *EventSink) prune(retainHeight int64, blockEvents bool) (int64, int64, error) {
+	name := retainHeightTxIndexer
+	if blockEvents {
+		name = retainHeightBlockIndexer
+	}
+	lastRetainHeight, err := es.getRetainHeight(name + prunedSuffix)
+	if errors.Is(err, errRetainHeightNotFound) {
+		lastRetainHeight = 1
+	} else if err != nil {
+		return 0, 0, fmt.Errorf("failed to look up last retain height: %w", err)
+	}
+	if retainHeight <= lastRetainHeight {
+		return 0, lastRetainHeight, nil
+	}
+
+	args := []interface{}{es.chainID, lastRetainHeight, retainHeight}
+	var pruned int64
+	if err := runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		counted := `SELECT block_id FROM ` + tableTxResults + ` WHERE block_id IN (` + blocksInRange + `)`
+		if blockEvents {
+			counted = `SELECT block_id FROM ` + tableEvents + ` WHERE block_id IN (` + blocksInRange + `) AND tx_id IS NULL`
+		}
+		if err := dbtx.QueryRow(`SELECT COUNT(DISTINCT block_id) FROM (`+counted+`);`,
+			args...).Scan(&pruned); err != nil {
+			return fmt.Errorf("counting heights: %w", err)
+		}
+
+		if err := deleteEvents(dbtx, blocksInRange, args, !blockEvents, blockEvents); err != nil {
+			return err
+		}
+		if _, err := dbtx.Exec(`
+DELETE FROM `+tableBlocks+` WHERE rowid IN (`+blocksInRange+`)
+  AND NOT EXISTS (SELECT 1 FROM `+tableEvents+` WHERE block_id = `+tableBlocks+`.rowid)
+  AND NOT EXISTS (SELECT 1 FROM `+tableTxResults+` WHERE block_id = `+tableBlocks+`.rowid);
+`, args...); err != nil {
+			return fmt.Errorf("deleting blocks: %w", err)
+		}
+		return es.setRetainHeight(dbtx, name+prunedSuffix, retainHeight)
+	}); err != nil {
+		return 0, lastRetainHeight, err
+	}
+	return pruned, retainHeight, nil
+}
+
+// Stop closes the underlying SQLite database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -0,0 +1,319 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "test-chainID"
+
+var (
+	_ indexer.BlockIndexer = BlockIndexer{}
+	_ indexer.BlockPager   = BlockIndexer{}
+	_ txindex.TxIndexer    = TxIndexer{}
+	_ txindex.Pager        = TxIndexer{}
+)
+
+func newTestSink(t *testing.T, options ...Option) *EventSink {
+	t.Helper()
+	es, err := NewEventSink(filepath.Join(t.TempDir(), DBFile), chainID, options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = es.Stop() })
+	return es
+}
+
+func TestIndexing(t *testing.T) {
+	t.Run("IndexBlockEvents", func(t *testing.T) {
+		es := newTestSink(t)
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1)))
+
+		has, err := es.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = es.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		for q, want := range map[string][]int64{
+			"end_event.foo = 100":                                  {1},
+			"end_event.foo > 100 OR thingy.whatzit = 'O.O'":        {1},
+			"NOT begin_event.proposer = 'FCAA001'":                 {},
+			"block.height = 1 AND NOT end_event.foo < 50":          {1},
+			"(thingy.whatzit CONTAINS 'X' OR end_event.foo <= 99)": {},
+			"end_event.day >= DATE 2023-05-01":                     {1},
+			"end_event.time < TIME 2023-05-03T10:00:00Z":           {},
+			"end_event.amount > 9 AND end_event.amount < 11":       {1},
+		} {
+			heights, err := es.SearchBlockEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Equal(t, want, heights, q)
+		}
+
+		// Attempting to reindex the same events should gracefully succeed.
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1)))
+	})
+
+	t.Run("IndexTxEvents", func(t *testing.T) {
+		es := newTestSink(t)
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1)))
+
+		txResult := txResultWithEvents([]abci.Event{
+			makeIndexedEvent("account.number", "1"),
+			makeIndexedEvent("account.owner", "Ivan"),
+			makeIndexedEvent("account.owner", "Yulieta"),
+
+			{Type: "", Attributes: []abci.EventAttribute{
+				{
+					Key:   "not_allowed",
+					Value: "Vlad",
+					Index: true,
+				},
+			}},
+		})
+		require.NoError(t, es.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		txr, err := es.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+		txr, err = es.GetTxByHash(types.Tx("missing").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+		_, err = es.TxIndexer().Get(nil)
+		require.ErrorIs(t, err, txindex.ErrorEmptyHash)
+
+		for q, want := range map[string]int{
+			"account.owner = 'Ivan' OR account.owner = 'Vlad'":               1,
+			"account.number = 1 AND NOT account.owner CONTAINS 'Yul'":        0,
+			"tx.height = 1 AND (account.number > 1 OR account.owner EXISTS)": 1,
+			"NOT account EXISTS":                                             0,
+			"account.owner IN ('Vlad', 'Yulieta')":                           1,
+			"account.owner STARTS WITH 'Yul' AND account.owner MATCHES '^I'": 1,
+			"account.owner MATCHES '^[A-Z]+$'":                               0,
+		} {
+			txrs, err := es.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			require.Len(t, txrs, want, q)
+			for _, txr := range txrs {
+				assert.Equal(t, txResult, txr)
+			}
+		}
+
+		// try to insert the duplicate tx events.
+		err = es.IndexTxEvents([]*abci.TxResult{txResult})
+		require.NoError(t, err)
+	})
+
+	t.Run("IndexTxEventsWithFilter", func(t *testing.T) {
+		filter, err := indexer.NewEventFilter([]string{"payment"}, []string{"payment.memo"})
+		require.NoError(t, err)
+		es := newTestSink(t, WithEventFilter(filter))
+		require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1)))
+
+		txResult := txResultWithEvents([]abci.Event{
+			makeIndexedEvent("payment.payer", "Ivan"),
+			makeIndexedEvent("payment.memo", "rent"),
+			makeIndexedEvent("refund.payer", "Ivan"),
+		})
+		require.NoError(t, es.IndexTxEvents([]*abci.TxResult{txResult}))
+
+		// The meta-events are indexed whatever the filter.
+		txHash := fmt.Sprintf("%X", types.Tx(txResult.Tx).Hash())
+		for q, want := range map[string]int{
+			"payment.payer = 'Ivan'":     1,
+			"payment.memo = 'rent'":      0,
+			"refund.payer = 'Ivan'":      0,
+			"tx.hash = '" + txHash + "'": 1,
+		} {
+			txrs, err := es.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			require.Len(t, txrs, want, q)
+		}
+	})
+}
+
+func TestReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DBFile)
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, es.IndexBlockEvents(newTestBlockEvents(1)))
+	require.NoError(t, es.Stop())
+
+	es, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	has, err := es.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, has)
+}
+
+func TestSearchPage(t *testing.T) {
+	es := indexHeights(t, 5)
+	ctx := context.Background()
+	q := query.MustCompile("tx.height >= 2")
+
+	txrs, err := es.TxIndexer().SearchPage(ctx, q, nil, 3, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2/0", "2/1", "3/0"}, positions(txrs))
+
+	txrs, err = es.TxIndexer().SearchPage(ctx, q, &txindex.Position{Height: 3, Index: 0}, 3, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3/1", "4/0", "4/1"}, positions(txrs))
+
+	txrs, err = es.TxIndexer().SearchPage(ctx, q, &txindex.Position{Height: 3, Index: 0}, 3, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2/1", "2/0"}, positions(txrs))
+
+	heights, err := es.BlockIndexer().SearchPage(ctx, query.MustCompile("block.height > 1"), 0, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 4}, heights)
+
+	heights, err = es.BlockIndexer().SearchPage(ctx, query.MustCompile("block.height > 1"), 4, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, heights)
+}
+
+func TestPrune(t *testing.T) {
+	es := indexHeights(t, 5)
+	txIndexer, blockIndexer := es.TxIndexer(), es.BlockIndexer()
+
+	_, err := txIndexer.GetRetainHeight()
+	require.ErrorIs(t, err, state.ErrKeyNotFound)
+	require.NoError(t, txIndexer.SetRetainHeight(3))
+	height, err := txIndexer.GetRetainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), height)
+	require.NoError(t, blockIndexer.SetRetainHeight(4))
+	height, err = blockIndexer.GetRetainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), height)
+
+	pruned, retainHeight, err := txIndexer.Prune(3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pruned)
+	assert.Equal(t, int64(3), retainHeight)
+	txrs, err := txIndexer.Search(context.Background(), query.MustCompile("tx.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3/0", "3/1", "4/0", "4/1", "5/0", "5/1"}, positions(txrs))
+
+	// Pruning again up to the same height does nothing.
+	pruned, retainHeight, err = txIndexer.Prune(3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), pruned)
+	assert.Equal(t, int64(3), retainHeight)
+
+	pruned, retainHeight, err = blockIndexer.Prune(4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+	assert.Equal(t, int64(4), retainHeight)
+	heights, err := blockIndexer.Search(context.Background(), query.MustCompile("block.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 5}, heights)
+	has, err := blockIndexer.Has(3)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// The transactions of height 3 are kept along with their block.
+	var blocks int
+	require.NoError(t, es.DB().QueryRow(`SELECT COUNT(*) FROM `+tableBlocks+`;`).Scan(&blocks))
+	assert.Equal(t, 3, blocks)
+	txr, err := txIndexer.Get(types.Tx("foo3").Hash())
+	require.NoError(t, err)
+	assert.NotNil(t, txr)
+}
+
+func TestDeleteHeightsAbove(t *testing.T) {
+	es := indexHeights(t, 5)
+	txIndexer, blockIndexer := es.TxIndexer(), es.BlockIndexer()
+
+	require.NoError(t, txIndexer.DeleteHeightsAbove(4))
+	txrs, err := txIndexer.Search(context.Background(), query.MustCompile("tx.height > 0"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 8)
+	has, err := blockIndexer.Has(5)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	require.NoError(t, blockIndexer.DeleteHeightsAbove(2))
+	heights, err := blockIndexer.Search(context.Background(), query.MustCompile("block.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, heights)
+	txrs, err = txIndexer.Search(context.Background(), query.MustCompile("tx.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1/0", "1/1", "2/0", "2/1"}, positions(txrs))
+}
+
+// indexHeights returns a sink indexing heights 1 to n, each having the txs
+// "foo<height>" and "bar<height>".
+func indexHeights(t *testing.T, n int64) *EventSink {
+	t.Helper()
+	es := newTestSink(t)
+	for h := int64(1); h <= n; h++ {
+		require.NoError(t, es.BlockIndexer().Index(newTestBlockEvents(h)))
+		batch := txindex.NewBatch(2)
+		for i, tx := range []string{"foo", "bar"} {
+			require.NoError(t, batch.Add(&abci.TxResult{
+				Height: h,
+				Index:  uint32(i),
+				Tx:     types.Tx(fmt.Sprintf("%s%d", tx, h)),
+			}))
+		}
+		require.NoError(t, es.TxIndexer().AddBatch(batch))
+	}
+	return es
+}
+
+// positions returns the "height/index" positions of the given results.
+func positions(txrs []*abci.TxResult) []string {
+	ps := make([]string, len(txrs))
+	for i, txr := range txrs {
+		ps[i] = fmt.Sprintf("%d/%d", txr.Height, txr.Index)
+	}
+	return ps
+}
+
+// newTestBlockEvents constructs a fresh copy of a new block event containing
+// known test values to exercise the indexer.
+func newTestBlockEvents(height int64) types.EventDataNewBlockEvents {
+	return types.EventDataNewBlockEvents{
+		Height: height,
+		Events: []abci.Event{
+			makeIndexedEvent("begin_event.proposer", "FCAA001"),
+			makeIndexedEvent("thingy.whatzit", "O.O"),
+			makeIndexedEvent("end_event.foo", "100"),
+			makeIndexedEvent("thingy.whatzit", "-.O"),
+			makeIndexedEvent("end_event.amount", "10stake"),
+			makeIndexedEvent("end_event.day", "2023-05-02"),
+			makeIndexedEvent("end_event.time", "2023-05-03T10:00:00Z"),
+		},
+	}
+}
+
+// txResultWithEvents constructs a fresh transaction result with fixed values
+// for testing, that includes the specified events.
+func txResultWithEvents(events []abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{
+			Data:   []byte{0},
+			Code:   abci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
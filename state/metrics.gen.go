@@ -88,6 +88,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_indexer_base_height",
 			Help:      "BlockIndexerBaseHeight shows the first height at which block indices are available",
 		}, labels).With(labelsAndValues...),
+		PruningPolicyRetainHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruning_policy_retain_height",
+			Help:      "PruningPolicyRetainHeight is the retain height computed by each of the storage retention policies",
+		}, append(labels, "policy")).With(labelsAndValues...),
+		PruningPolicyHeldBackHeights: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruning_policy_held_back_heights",
+			Help:      "PruningPolicyHeldBackHeights is the number of heights that each of the storage retention policies prevents from being pruned",
+		}, append(labels, "policy")).With(labelsAndValues...),
 	}
 }
 
@@ -105,5 +117,7 @@ func NopMetrics() *Metrics {
 		ABCIResultsBaseHeight:                  discard.NewGauge(),
 		TxIndexerBaseHeight:                    discard.NewGauge(),
 		BlockIndexerBaseHeight:                 discard.NewGauge(),
+		PruningPolicyRetainHeight:              discard.NewGauge(),
+		PruningPolicyHeldBackHeights:           discard.NewGauge(),
 	}
 }
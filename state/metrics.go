@@ -62,4 +62,12 @@ type Metrics struct {
 	// BlockIndexerBaseHeight shows the first height at which
 	// block indices are available
 	BlockIndexerBaseHeight metrics.Gauge
+
+	// PruningPolicyRetainHeight is the retain height computed by each of the
+	// storage retention policies
+	PruningPolicyRetainHeight metrics.Gauge `metrics_labels:"policy"`
+
+	// PruningPolicyHeldBackHeights is the number of heights that each of the
+	// storage retention policies prevents from being pruned
+	PruningPolicyHeldBackHeights metrics.Gauge `metrics_labels:"policy"`
 }
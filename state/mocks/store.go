@@ -116,6 +116,30 @@ func (_m *Store) GetCompanionBlockRetainHeight() (int64, error) {
 	return r0, r1
 }
 
+// GetDataCompanionCursor provides a mock function with given fields:
+func (_m *Store) GetDataCompanionCursor() (int64, error) {
+	ret := _m.Called()
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOfflineStateSyncHeight provides a mock function with given fields:
 func (_m *Store) GetOfflineStateSyncHeight() (int64, error) {
 	ret := _m.Called()
@@ -359,6 +383,20 @@ func (_m *Store) PruneStates(fromHeight int64, toHeight int64, evidenceThreshold
 	return r0
 }
 
+// RestoreHeight provides a mock function with given fields: height, vals, params, resp
+func (_m *Store) RestoreHeight(height int64, vals *types.ValidatorSet, params types.ConsensusParams, resp *abcitypes.ResponseFinalizeBlock) error {
+	ret := _m.Called(height, vals, params, resp)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *types.ValidatorSet, types.ConsensusParams, *abcitypes.ResponseFinalizeBlock) error); ok {
+		r0 = rf(height, vals, params, resp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Save provides a mock function with given fields: _a0
 func (_m *Store) Save(_a0 state.State) error {
 	ret := _m.Called(_a0)
@@ -415,6 +453,20 @@ func (_m *Store) SaveCompanionBlockRetainHeight(height int64) error {
 	return r0
 }
 
+// SaveDataCompanionCursor provides a mock function with given fields: height
+func (_m *Store) SaveDataCompanionCursor(height int64) error {
+	ret := _m.Called(height)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveFinalizeBlockResponse provides a mock function with given fields: height, res
 func (_m *Store) SaveFinalizeBlockResponse(height int64, res *abcitypes.ResponseFinalizeBlock) error {
 	ret := _m.Called(height, res)
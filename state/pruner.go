@@ -2,6 +2,7 @@ package state
 
 import (
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 var (
@@ -38,13 +40,32 @@ type Pruner struct {
 	interval     time.Duration
 	observer     PrunerObserver
 	metrics      *Metrics
+
+	// Retention policies, disabled when 0.
+	blockRetainAge      time.Duration
+	blockStoreMaxBytes  int64
+	abciResRetainBlocks int64
+
+	// The number of latest heights whose ABCI results are kept when the node
+	// discards ABCI responses, or 0.
+	recentABCIResponses int64
+
+	// The range of the latest blocks that fit in blockStoreMaxBytes, and their
+	// size, updated on every run of the block pruning routine.
+	sizeBase   int64
+	sizeHeight int64
+	sizeBytes  int64
 }
 
 type prunerConfig struct {
-	dcEnabled bool
-	interval  time.Duration
-	observer  PrunerObserver
-	metrics   *Metrics
+	dcEnabled           bool
+	interval            time.Duration
+	observer            PrunerObserver
+	metrics             *Metrics
+	blockRetainAge      time.Duration
+	blockStoreMaxBytes  int64
+	abciResRetainBlocks int64
+	recentABCIResponses int64
 }
 
 func defaultPrunerConfig() *prunerConfig {
@@ -84,6 +105,34 @@ func WithPrunerMetrics(metrics *Metrics) PrunerOption {
 	}
 }
 
+// WithPrunerBlockRetainAge sets a retention policy keeping the blocks
+// committed within the given period.
+func WithPrunerBlockRetainAge(age time.Duration) PrunerOption {
+	return func(p *prunerConfig) { p.blockRetainAge = age }
+}
+
+// WithPrunerBlockStoreMaxBytes sets a retention policy keeping as many of the
+// latest blocks as fit in the given number of bytes.
+func WithPrunerBlockStoreMaxBytes(maxBytes int64) PrunerOption {
+	return func(p *prunerConfig) { p.blockStoreMaxBytes = maxBytes }
+}
+
+// WithPrunerABCIResRetainBlocks sets a retention policy keeping the ABCI
+// results of the given number of latest blocks. ABCI results are then pruned
+// even if the pruner does not respect the retain heights set by the data
+// companion.
+func WithPrunerABCIResRetainBlocks(blocks int64) PrunerOption {
+	return func(p *prunerConfig) { p.abciResRetainBlocks = blocks }
+}
+
+// WithPrunerRecentABCIResponses indicates to the pruner that the node
+// discards ABCI responses, except for those of the given number of latest
+// heights. The older ABCI results are then pruned whatever the retain heights
+// and the retention policies.
+func WithPrunerRecentABCIResponses(heights int64) PrunerOption {
+	return func(p *prunerConfig) { p.recentABCIResponses = heights }
+}
+
 // NewPruner creates a service that controls background pruning of node data.
 //
 // Assumes that the initial application and data companion retain heights have
@@ -110,11 +159,22 @@ func NewPruner(
 		observer:     cfg.observer,
 		metrics:      cfg.metrics,
 		dcEnabled:    cfg.dcEnabled,
+
+		blockRetainAge:      cfg.blockRetainAge,
+		blockStoreMaxBytes:  cfg.blockStoreMaxBytes,
+		abciResRetainBlocks: cfg.abciResRetainBlocks,
+		recentABCIResponses: cfg.recentABCIResponses,
 	}
 	p.BaseService = *service.NewBaseService(logger, "Pruner", p)
 	return p
 }
 
+// CompanionEnabled returns whether the pruner respects the retain heights set
+// by the data companion.
+func (p *Pruner) CompanionEnabled() bool {
+	return p.dcEnabled
+}
+
 func (p *Pruner) SetObserver(obs PrunerObserver) {
 	p.observer = obs
 }
@@ -122,9 +182,12 @@ func (p *Pruner) SetObserver(obs PrunerObserver) {
 func (p *Pruner) OnStart() error {
 	go p.pruneBlocks()
 	// We only care about pruning ABCI results if the data companion has been
-	// enabled.
-	if p.dcEnabled {
+	// enabled, if a retention policy applies to them, or if only the recent
+	// ones are kept.
+	if p.dcEnabled || p.abciResRetainBlocks > 0 || p.recentABCIResponses > 0 {
 		go p.pruneABCIResponses()
+	}
+	if p.dcEnabled {
 		go p.pruneIndexesRoutine()
 	}
 	p.observer.PrunerStarted(p.interval)
@@ -196,8 +259,8 @@ func (p *Pruner) SetCompanionBlockRetainHeight(height int64) error {
 
 // SetABCIResRetainHeight sets the retain height for ABCI responses.
 //
-// If the application has set the DiscardABCIResponses flag to true, nothing
-// will be pruned.
+// If the application has set the DiscardABCIResponses flag to true, without
+// keeping the recent responses, nothing will be pruned.
 func (p *Pruner) SetABCIResRetainHeight(height int64) error {
 	// Ensure that all requests to set retain heights via the pruner are
 	// serialized.
@@ -433,7 +496,7 @@ func (p *Pruner) pruneBlocksToRetainHeight(lastRetainHeight int64) int64 {
 }
 
 func (p *Pruner) pruneABCIResToRetainHeight(lastRetainHeight int64) int64 {
-	targetRetainHeight, err := p.stateStore.GetABCIResRetainHeight()
+	targetRetainHeight, err := p.findABCIResRetainHeight()
 	if err != nil {
 		p.logger.Error("Failed to get ABCI response retain height", "err", err)
 		if errors.Is(err, ErrKeyNotFound) {
@@ -467,22 +530,169 @@ func (p *Pruner) findMinBlockRetainHeight() int64 {
 		p.logger.Error("Unexpected error fetching application retain height", "err", err)
 		return 0
 	}
+	policies := p.blockRetentionPolicies()
+	// An application retain height of 0 prevents any pruning, unless a
+	// retention policy applies.
+	var retainHeights []int64
+	if appRetainHeight > 0 || len(policies) == 0 {
+		retainHeights = append(retainHeights, appRetainHeight)
+	}
 	// We only care about the companion retain height if pruning is configured
 	// to respect the companion's retain height.
-	if !p.dcEnabled {
-		return appRetainHeight
+	if p.dcEnabled {
+		dcRetainHeight, err := p.stateStore.GetCompanionBlockRetainHeight()
+		if err != nil {
+			p.logger.Error("Unexpected error fetching data companion retain height", "err", err)
+			return 0
+		}
+		retainHeights = append(retainHeights, dcRetainHeight)
 	}
-	dcRetainHeight, err := p.stateStore.GetCompanionBlockRetainHeight()
-	if err != nil {
-		p.logger.Error("Unexpected error fetching data companion retain height", "err", err)
+	// We pick the minimum of all the retain heights.
+	return p.applyRetentionPolicies(retainHeights, policies)
+}
+
+// findABCIResRetainHeight returns the height below which ABCI results can be
+// pruned.
+func (p *Pruner) findABCIResRetainHeight() (int64, error) {
+	policies := p.abciResRetentionPolicies()
+	var retainHeights []int64
+	dcRetainHeight, err := p.stateStore.GetABCIResRetainHeight()
+	switch {
+	case err == nil:
+		retainHeights = append(retainHeights, dcRetainHeight)
+	case errors.Is(err, ErrKeyNotFound) && (len(policies) > 0 || p.recentABCIResponses > 0):
+		// Only the retention policy, or the recent responses, apply.
+	default:
+		return 0, err
+	}
+	retainHeight := p.applyRetentionPolicies(retainHeights, policies)
+	// When discarding ABCI responses, the older ones are pruned regardless.
+	if p.recentABCIResponses > 0 {
+		retainHeight = max(retainHeight, p.bs.Height()-p.recentABCIResponses+1)
+	}
+	return retainHeight, nil
+}
+
+// retentionPolicy is the retain height computed by one of the retention
+// policies configured by the operator.
+type retentionPolicy struct {
+	name         string
+	retainHeight int64
+}
+
+// blockRetentionPolicies returns the retain heights of the enabled block
+// retention policies.
+func (p *Pruner) blockRetentionPolicies() []retentionPolicy {
+	var policies []retentionPolicy
+	if p.blockRetainAge > 0 {
+		policies = append(policies, retentionPolicy{"block_retain_age", p.blockAgeRetainHeight()})
+	}
+	if p.blockStoreMaxBytes > 0 {
+		policies = append(policies, retentionPolicy{"block_store_max_bytes", p.blockSizeRetainHeight()})
+	}
+	return policies
+}
+
+// abciResRetentionPolicies returns the retain heights of the enabled ABCI
+// results retention policies.
+func (p *Pruner) abciResRetentionPolicies() []retentionPolicy {
+	if p.abciResRetainBlocks <= 0 {
+		return nil
+	}
+	retainHeight := p.bs.Height() - p.abciResRetainBlocks + 1
+	if retainHeight < 1 {
+		retainHeight = 1
+	}
+	return []retentionPolicy{{"abci_results_retain_blocks", retainHeight}}
+}
+
+// blockAgeRetainHeight returns the lowest height of the blocks committed
+// within the block retain age.
+func (p *Pruner) blockAgeRetainHeight() int64 {
+	base, height := p.bs.Base(), p.bs.Height()
+	cutoff := cmttime.Now().Add(-p.blockRetainAge)
+	// The block times are monotonic, so we can look for the first block
+	// within the retain age.
+	i := sort.Search(int(height-base+1), func(i int) bool {
+		meta := p.bs.LoadBlockMeta(base + int64(i))
+		return meta == nil || !meta.Header.Time.Before(cutoff)
+	})
+	// The latest block is never pruned.
+	return min(base+int64(i), height)
+}
+
+// blockSizeRetainHeight returns the lowest height from which the latest blocks
+// fit in the block store max bytes. The size of these blocks is only computed
+// from scratch on the first run, and then updated with the new blocks.
+func (p *Pruner) blockSizeRetainHeight() int64 {
+	height := p.bs.Height()
+	if p.sizeHeight == 0 || height < p.sizeHeight {
+		p.sizeBase, p.sizeHeight, p.sizeBytes = height+1, height, 0
+		for h := height; h > 0 && h >= p.bs.Base(); h-- {
+			size := p.blockSize(h)
+			if p.sizeBytes+size > p.blockStoreMaxBytes {
+				break
+			}
+			p.sizeBase, p.sizeBytes = h, p.sizeBytes+size
+		}
+	} else {
+		for h := p.sizeHeight + 1; h <= height; h++ {
+			p.sizeBytes += p.blockSize(h)
+		}
+		p.sizeHeight = height
+		for p.sizeBytes > p.blockStoreMaxBytes && p.sizeBase <= height {
+			p.sizeBytes -= p.blockSize(p.sizeBase)
+			p.sizeBase++
+		}
+	}
+	// The latest block is never pruned.
+	return min(p.sizeBase, height)
+}
+
+func (p *Pruner) blockSize(height int64) int64 {
+	meta := p.bs.LoadBlockMeta(height)
+	if meta == nil {
 		return 0
 	}
-	// If we are here, both heights were set and the companion is enabled, so
-	// we pick the minimum.
-	if appRetainHeight < dcRetainHeight {
-		return appRetainHeight
+	return int64(meta.BlockSize)
+}
+
+// applyRetentionPolicies returns the minimum of the given retain heights and
+// of the retain heights of the policies, and reports in the metrics how many
+// heights each policy holds back from the minimum of the others.
+func (p *Pruner) applyRetentionPolicies(retainHeights []int64, policies []retentionPolicy) int64 {
+	heights := append([]int64{}, retainHeights...)
+	for _, policy := range policies {
+		heights = append(heights, policy.retainHeight)
+	}
+	for i, policy := range policies {
+		heldBack := int64(0)
+		if others, ok := minRetainHeight(heights, len(retainHeights)+i); ok && others > policy.retainHeight {
+			heldBack = others - policy.retainHeight
+		}
+		p.metrics.PruningPolicyRetainHeight.With("policy", policy.name).Set(float64(policy.retainHeight))
+		p.metrics.PruningPolicyHeldBackHeights.With("policy", policy.name).Set(float64(heldBack))
+	}
+	retainHeight, _ := minRetainHeight(heights, -1)
+	return retainHeight
+}
+
+// minRetainHeight returns the minimum of the retain heights, except for the
+// one at index skip, and false if there is none.
+func minRetainHeight(retainHeights []int64, skip int) (int64, bool) {
+	var (
+		minHeight int64
+		found     bool
+	)
+	for i, height := range retainHeights {
+		if i == skip {
+			continue
+		}
+		if !found || height < minHeight {
+			minHeight, found = height, true
+		}
 	}
-	return dcRetainHeight
+	return minHeight, found
 }
 
 func (p *Pruner) pruneBlocksToHeight(height int64) (uint64, int64, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	db "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -13,9 +14,11 @@ import (
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	sm "github.com/cometbft/cometbft/state"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/mocks"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 )
@@ -220,3 +223,180 @@ func getEventsAndResults(height int64) (types.EventDataNewBlockEvents, *abci.TxR
 	}
 	return events, txResult1, txResult2
 }
+
+// makeRetentionBlockStore returns a block store mock holding blocks of 100
+// bytes, the latest block being committed now and the previous ones one hour
+// apart.
+func makeRetentionBlockStore(height *int64) *mocks.BlockStore {
+	now := time.Now()
+	bs := &mocks.BlockStore{}
+	bs.On("Base").Return(int64(1))
+	bs.On("Height").Return(func() int64 { return *height })
+	bs.On("LoadBlockMeta", mock.Anything).Return(func(h int64) *types.BlockMeta {
+		if h < 1 || h > *height {
+			return nil
+		}
+		return &types.BlockMeta{
+			BlockSize: 100,
+			Header:    types.Header{Height: h, Time: now.Add(-time.Duration(*height-h) * time.Hour)},
+		}
+	})
+	return bs
+}
+
+func TestMinRetainHeightWithRetentionPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		appRetainHeight int64
+		dcRetainHeight  int64
+		options         []sm.PrunerOption
+		retainHeight    int64
+	}{
+		"no policy": {
+			appRetainHeight: 5,
+			retainHeight:    5,
+		},
+		"age without app retain height": {
+			options:      []sm.PrunerOption{sm.WithPrunerBlockRetainAge(210 * time.Minute)},
+			retainHeight: 7,
+		},
+		"age holding back the app": {
+			appRetainHeight: 9,
+			options:         []sm.PrunerOption{sm.WithPrunerBlockRetainAge(210 * time.Minute)},
+			retainHeight:    7,
+		},
+		"app holding back age": {
+			appRetainHeight: 5,
+			options:         []sm.PrunerOption{sm.WithPrunerBlockRetainAge(210 * time.Minute)},
+			retainHeight:    5,
+		},
+		"age older than the chain": {
+			options:      []sm.PrunerOption{sm.WithPrunerBlockRetainAge(100 * time.Hour)},
+			retainHeight: 1,
+		},
+		"size": {
+			options:      []sm.PrunerOption{sm.WithPrunerBlockStoreMaxBytes(350)},
+			retainHeight: 8,
+		},
+		"size smaller than a block": {
+			options:      []sm.PrunerOption{sm.WithPrunerBlockStoreMaxBytes(50)},
+			retainHeight: 10,
+		},
+		"age and size": {
+			options: []sm.PrunerOption{
+				sm.WithPrunerBlockRetainAge(210 * time.Minute),
+				sm.WithPrunerBlockStoreMaxBytes(350),
+			},
+			retainHeight: 7,
+		},
+		"companion holding back age": {
+			options: []sm.PrunerOption{
+				sm.WithPrunerCompanionEnabled(),
+				sm.WithPrunerBlockRetainAge(210 * time.Minute),
+			},
+			retainHeight: 0,
+		},
+		"age holding back the companion": {
+			dcRetainHeight: 9,
+			options: []sm.PrunerOption{
+				sm.WithPrunerCompanionEnabled(),
+				sm.WithPrunerBlockRetainAge(210 * time.Minute),
+			},
+			retainHeight: 7,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			height := int64(10)
+			stateStore := sm.NewStore(db.NewMemDB(), sm.StoreOptions{})
+			require.NoError(t, initStateStoreRetainHeights(stateStore, tc.appRetainHeight, tc.dcRetainHeight, 0))
+			pruner := sm.NewPruner(stateStore, makeRetentionBlockStore(&height), nil, nil, log.TestingLogger(), tc.options...)
+			require.Equal(t, tc.retainHeight, pruner.FindMinRetainHeight())
+		})
+	}
+}
+
+func TestBlockStoreMaxBytesRetainHeight(t *testing.T) {
+	height := int64(10)
+	stateStore := sm.NewStore(db.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, initStateStoreRetainHeights(stateStore, 0, 0, 0))
+	pruner := sm.NewPruner(stateStore, makeRetentionBlockStore(&height), nil, nil, log.TestingLogger(),
+		sm.WithPrunerBlockStoreMaxBytes(350))
+	require.Equal(t, int64(8), pruner.FindMinRetainHeight())
+
+	// The new blocks push the oldest ones out of the size limit.
+	height = 12
+	require.Equal(t, int64(10), pruner.FindMinRetainHeight())
+	height = 13
+	require.Equal(t, int64(11), pruner.FindMinRetainHeight())
+}
+
+func TestABCIResRetainBlocks(t *testing.T) {
+	height := int64(10)
+	stateStore := sm.NewStore(db.NewMemDB(), sm.StoreOptions{})
+	for h := int64(1); h <= height; h++ {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{AppHash: []byte("app_hash")}))
+	}
+	pruner := sm.NewPruner(stateStore, makeRetentionBlockStore(&height), nil, nil, log.TestingLogger(),
+		sm.WithPrunerABCIResRetainBlocks(4))
+
+	// Without the data companion retain height, only the policy applies.
+	require.Equal(t, int64(7), pruner.PruneABCIResToRetainHeight(0))
+	for h := int64(1); h <= height; h++ {
+		_, err := stateStore.LoadFinalizeBlockResponse(h)
+		if h < 7 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// The data companion retain height holds back the policy.
+	height = 12
+	require.NoError(t, stateStore.SaveABCIResRetainHeight(8))
+	require.Equal(t, int64(8), pruner.PruneABCIResToRetainHeight(7))
+	_, err := stateStore.LoadFinalizeBlockResponse(8)
+	require.NoError(t, err)
+}
+
+func TestRecentABCIResponses(t *testing.T) {
+	height := int64(10)
+	stateStore := sm.NewStore(db.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses:    true,
+		KeepRecentABCIResponses: 4,
+	})
+	for h := int64(1); h <= height; h++ {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{AppHash: []byte("app_hash")}))
+	}
+	for h := int64(1); h <= height; h++ {
+		_, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+	}
+
+	// The recent responses are kept regardless of the data companion retain
+	// height.
+	require.NoError(t, stateStore.SaveABCIResRetainHeight(2))
+	pruner := sm.NewPruner(stateStore, makeRetentionBlockStore(&height), nil, nil, log.TestingLogger(),
+		sm.WithPrunerCompanionEnabled(), sm.WithPrunerRecentABCIResponses(4))
+	require.Equal(t, int64(7), pruner.PruneABCIResToRetainHeight(0))
+	for h := int64(1); h <= height; h++ {
+		_, err := stateStore.LoadFinalizeBlockResponse(h)
+		if h < 7 {
+			require.ErrorAs(t, err, &sm.ErrNoABCIResponsesForHeight{})
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	height = 12
+	require.Equal(t, int64(9), pruner.PruneABCIResToRetainHeight(7))
+	_, err := stateStore.LoadFinalizeBlockResponse(8)
+	require.Error(t, err)
+
+	// Without recent responses, none is kept.
+	stateStore = sm.NewStore(db.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: true})
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(1, &abci.ResponseFinalizeBlock{AppHash: []byte("app_hash")}))
+	_, err = stateStore.LoadFinalizeBlockResponse(1)
+	require.ErrorIs(t, err, sm.ErrFinalizeBlockResponsesNotPersisted)
+}
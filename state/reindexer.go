@@ -0,0 +1,240 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	ErrReindexInProgress = errors.New("a reindexing is already in progress")
+	ErrReindexerStopped  = errors.New("reindexer is not running")
+)
+
+// ReindexStatus is the progress of the latest reindexing.
+type ReindexStatus struct {
+	// Is the reindexing still in progress?
+	Running     bool
+	StartHeight int64
+	EndHeight   int64
+	// The last height reindexed, or StartHeight-1 if none was.
+	LastHeight int64
+	// The error which stopped the reindexing, if any.
+	Err error
+}
+
+// Reindexer is a service that rebuilds the transaction and block indexes of a
+// range of committed heights in the background, from the blocks of the block
+// store and their FinalizeBlock responses. The indexer service keeps indexing
+// the new blocks meanwhile.
+type Reindexer struct {
+	service.BaseService
+
+	bs             BlockStore
+	stateStore     Store
+	indexerService *txindex.IndexerService
+	// The maximum number of heights reindexed per second, or 0 for no limit.
+	heightsPerSecond int
+
+	mtx    sync.Mutex
+	status ReindexStatus
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type ReindexerOption func(*Reindexer)
+
+// WithReindexerRateLimit limits the number of heights reindexed per second,
+// so that reindexing does not slow down the node. There is no limit by
+// default, or if heightsPerSecond is 0.
+func WithReindexerRateLimit(heightsPerSecond int) ReindexerOption {
+	return func(r *Reindexer) { r.heightsPerSecond = heightsPerSecond }
+}
+
+// NewReindexer creates a service reindexing heights through the given indexer
+// service.
+func NewReindexer(
+	bs BlockStore,
+	stateStore Store,
+	indexerService *txindex.IndexerService,
+	logger log.Logger,
+	options ...ReindexerOption,
+) *Reindexer {
+	r := &Reindexer{
+		bs:             bs,
+		stateStore:     stateStore,
+		indexerService: indexerService,
+	}
+	for _, option := range options {
+		option(r)
+	}
+	r.BaseService = *service.NewBaseService(logger, "Reindexer", r)
+	return r
+}
+
+// OnStop implements service.Service by canceling the reindexing in progress
+// and waiting for it to stop.
+func (r *Reindexer) OnStop() {
+	r.mtx.Lock()
+	done := r.done
+	r.mtx.Unlock()
+	if r.Cancel() {
+		<-done
+	}
+}
+
+// Reindex starts reindexing the heights from startHeight to endHeight,
+// inclusive, in the background, and returns the range being reindexed. A
+// startHeight of 0 stands for the base of the block store, and an endHeight of
+// 0 for the height of the latest state. Only one reindexing can be in progress
+// at a time.
+func (r *Reindexer) Reindex(startHeight, endHeight int64) (ReindexStatus, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.IsRunning() {
+		return ReindexStatus{}, ErrReindexerStopped
+	}
+	if r.status.Running {
+		return ReindexStatus{}, ErrReindexInProgress
+	}
+
+	state, err := r.stateStore.Load()
+	if err != nil {
+		return ReindexStatus{}, fmt.Errorf("failed to load state: %w", err)
+	}
+	// The FinalizeBlock response of the latest block might not be saved yet
+	// if the block store is ahead of the state.
+	base, height := r.bs.Base(), state.LastBlockHeight
+	if startHeight == 0 {
+		startHeight = base
+	}
+	if endHeight == 0 {
+		endHeight = height
+	}
+	if startHeight < base || endHeight > height || startHeight > endHeight {
+		return ReindexStatus{}, fmt.Errorf("%w: cannot reindex heights %d to %d, the node has heights %d to %d",
+			ErrInvalidHeightValue, startHeight, endHeight, base, height)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.status = ReindexStatus{
+		Running:     true,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		LastHeight:  startHeight - 1,
+	}
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go r.reindexRoutine(ctx, startHeight, endHeight, r.done)
+
+	return r.status, nil
+}
+
+// Status returns the progress of the latest reindexing.
+func (r *Reindexer) Status() ReindexStatus {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.status
+}
+
+// Cancel stops the reindexing in progress, if any, and returns whether there
+// was one. The heights already reindexed stay indexed.
+func (r *Reindexer) Cancel() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if !r.status.Running {
+		return false
+	}
+	r.cancel()
+	return true
+}
+
+func (r *Reindexer) reindexRoutine(ctx context.Context, startHeight, endHeight int64, done chan struct{}) {
+	defer close(done)
+
+	var tick <-chan time.Time
+	if r.heightsPerSecond > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(r.heightsPerSecond))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	r.Logger.Info("Started reindexing", "start_height", startHeight, "end_height", endHeight)
+	var err error
+	for height := startHeight; height <= endHeight; height++ {
+		if tick != nil {
+			select {
+			case <-ctx.Done():
+			case <-tick:
+			}
+		}
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if err = r.reindexHeight(height); err != nil {
+			break
+		}
+		r.mtx.Lock()
+		r.status.LastHeight = height
+		r.mtx.Unlock()
+		r.Logger.Debug("Reindexed height", "height", height)
+	}
+
+	r.mtx.Lock()
+	r.status.Running = false
+	r.status.Err = err
+	lastHeight := r.status.LastHeight
+	r.cancel()
+	r.mtx.Unlock()
+
+	if err != nil {
+		r.Logger.Error("Stopped reindexing", "last_height", lastHeight, "err", err)
+		return
+	}
+	r.Logger.Info("Finished reindexing", "start_height", startHeight, "end_height", endHeight)
+}
+
+// reindexHeight indexes the block and transaction events of a height again,
+// as the indexer service does for a new block.
+func (r *Reindexer) reindexHeight(height int64) error {
+	block := r.bs.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("block %d not found", height)
+	}
+	resp, err := r.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return fmt.Errorf("failed to load the FinalizeBlock response of height %d: %w", height, err)
+	}
+	if len(resp.TxResults) != len(block.Txs) {
+		return fmt.Errorf("block %d has %d txs but its FinalizeBlock response has %d results",
+			height, len(block.Txs), len(resp.TxResults))
+	}
+
+	batch := txindex.NewBatch(int64(len(block.Txs)))
+	for i, txResult := range resp.TxResults {
+		tr := &abci.TxResult{
+			Height: height,
+			Index:  uint32(i),
+			Tx:     block.Txs[i],
+			Result: *txResult,
+		}
+		if err := batch.Add(tr); err != nil {
+			return fmt.Errorf("failed to add tx %d of block %d to batch: %w", i, height, err)
+		}
+	}
+	events := types.EventDataNewBlockEvents{
+		Height: height,
+		Events: resp.Events,
+		NumTxs: int64(len(block.Txs)),
+	}
+	return r.indexerService.Reindex(events, batch)
+}
@@ -0,0 +1,142 @@
+package state_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeReindexer returns a reindexer of a chain of the given height, whose
+// FinalizeBlock response is missing at missingHeight, if not 0.
+func makeReindexer(
+	t *testing.T,
+	height, missingHeight int64,
+	options ...sm.ReindexerOption,
+) (*sm.Reindexer, *kv.TxIndex, *blockidxkv.BlockerIndexer) {
+	t.Helper()
+	bs := &mocks.BlockStore{}
+	bs.On("Base").Return(int64(1))
+	bs.On("LoadBlock", mock.Anything).Return(func(h int64) *types.Block {
+		return &types.Block{
+			Header: types.Header{Height: h},
+			Data:   types.Data{Txs: types.Txs{types.Tx(fmt.Sprintf("foo%d", h)), types.Tx(fmt.Sprintf("bar%d", h))}},
+		}
+	})
+	stateStore := &mocks.Store{}
+	stateStore.On("Load").Return(sm.State{LastBlockHeight: height}, nil)
+	stateStore.On("LoadFinalizeBlockResponse", mock.Anything).Return(
+		func(h int64) *abci.ResponseFinalizeBlock {
+			events, _, _ := getEventsAndResults(h)
+			return &abci.ResponseFinalizeBlock{
+				Events:    events.Events,
+				TxResults: []*abci.ExecTxResult{{Code: 0}, {Code: 1}},
+			}
+		},
+		func(h int64) error {
+			if h == missingHeight {
+				return sm.ErrNoABCIResponsesForHeight{Height: h}
+			}
+			return nil
+		},
+	)
+
+	txIndexer := kv.NewTxIndex(db.NewMemDB())
+	blockIndexer := blockidxkv.New(db.NewMemDB())
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, types.NewEventBus(), false)
+	reindexer := sm.NewReindexer(bs, stateStore, indexerService, log.TestingLogger(), options...)
+	require.NoError(t, reindexer.Start())
+	t.Cleanup(func() {
+		if !reindexer.IsRunning() {
+			return
+		}
+		if err := reindexer.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return reindexer, txIndexer, blockIndexer
+}
+
+func waitReindexed(t *testing.T, reindexer *sm.Reindexer) sm.ReindexStatus {
+	t.Helper()
+	require.Eventually(t, func() bool { return !reindexer.Status().Running }, 5*time.Second, 10*time.Millisecond)
+	return reindexer.Status()
+}
+
+func TestReindexer(t *testing.T) {
+	reindexer, txIndexer, blockIndexer := makeReindexer(t, 5, 0)
+
+	status, err := reindexer.Reindex(0, 0)
+	require.NoError(t, err)
+	require.True(t, status.Running)
+	require.Equal(t, int64(1), status.StartHeight)
+	require.Equal(t, int64(5), status.EndHeight)
+
+	status = waitReindexed(t, reindexer)
+	require.NoError(t, status.Err)
+	require.Equal(t, int64(5), status.LastHeight)
+	for h := int64(1); h <= 5; h++ {
+		has, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		require.True(t, has, h)
+		for i, tx := range []string{"foo", "bar"} {
+			txResult, err := txIndexer.Get(types.Tx(fmt.Sprintf("%s%d", tx, h)).Hash())
+			require.NoError(t, err)
+			require.NotNil(t, txResult, h)
+			require.Equal(t, uint32(i), txResult.Index)
+			require.Equal(t, uint32(i), txResult.Result.Code)
+		}
+	}
+
+	for _, heights := range [][2]int64{{0, 6}, {4, 3}, {-1, 2}} {
+		_, err := reindexer.Reindex(heights[0], heights[1])
+		require.ErrorIs(t, err, sm.ErrInvalidHeightValue, heights)
+	}
+}
+
+func TestReindexerMissingResponse(t *testing.T) {
+	reindexer, txIndexer, _ := makeReindexer(t, 5, 3)
+
+	_, err := reindexer.Reindex(2, 0)
+	require.NoError(t, err)
+	status := waitReindexed(t, reindexer)
+	require.ErrorAs(t, status.Err, &sm.ErrNoABCIResponsesForHeight{})
+	require.Equal(t, int64(2), status.LastHeight)
+
+	txResult, err := txIndexer.Get(types.Tx("foo4").Hash())
+	require.NoError(t, err)
+	require.Nil(t, txResult)
+}
+
+func TestReindexerCancel(t *testing.T) {
+	reindexer, _, _ := makeReindexer(t, 100, 0, sm.WithReindexerRateLimit(10))
+
+	_, err := reindexer.Reindex(0, 0)
+	require.NoError(t, err)
+	_, err = reindexer.Reindex(0, 0)
+	require.ErrorIs(t, err, sm.ErrReindexInProgress)
+
+	require.True(t, reindexer.Cancel())
+	status := waitReindexed(t, reindexer)
+	require.ErrorIs(t, status.Err, context.Canceled)
+	require.Less(t, status.LastHeight, int64(100))
+	require.False(t, reindexer.Cancel())
+
+	require.NoError(t, reindexer.Stop())
+	_, err = reindexer.Reindex(0, 0)
+	require.ErrorIs(t, err, sm.ErrReindexerStopped)
+}
@@ -123,3 +123,124 @@ func Rollback(bs BlockStore, ss Store, removeBlock bool) (int64, []byte, error)
 
 	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
 }
+
+// RollbackToHeight overwrites the current CometBFT state with the state at the
+// given height, which must be below the current one. The blocks above the next
+// height, which is re-executed on startup, can no longer be replayed: they are
+// removed from the block store if removeBlocks is true, and must otherwise be
+// removed before CometBFT can start. If removeNextBlock is true, the next block
+// is removed as well, along with the ones above it.
+// Note that this function does not affect application state.
+func RollbackToHeight(bs BlockStore, ss Store, height int64, removeBlocks, removeNextBlock bool) (int64, []byte, error) {
+	invalidState, err := ss.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	if invalidState.IsEmpty() {
+		return -1, nil, errors.New("no state found")
+	}
+	if height >= invalidState.LastBlockHeight {
+		return -1, nil, fmt.Errorf("cannot roll back to height %d, which is not below the state height %d",
+			height, invalidState.LastBlockHeight)
+	}
+	if height < invalidState.InitialHeight {
+		return -1, nil, fmt.Errorf("cannot roll back to height %d, which is below the initial height %d",
+			height, invalidState.InitialHeight)
+	}
+
+	// The pending block, if any, is discarded along with the blocks above the
+	// height, so the same invariant as in Rollback applies.
+	storeHeight := bs.Height()
+	if storeHeight != invalidState.LastBlockHeight && storeHeight != invalidState.LastBlockHeight+1 {
+		return -1, nil, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, storeHeight)
+	}
+
+	rollbackBlock := bs.LoadBlockMeta(height)
+	if rollbackBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", height)
+	}
+	// The app hash and last results hash of the rollback height are only
+	// agreed upon in the following block.
+	nextBlock := bs.LoadBlockMeta(height + 1)
+	if nextBlock == nil {
+		return -1, nil, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastValidators, err := ss.LoadValidators(height)
+	if err != nil {
+		return -1, nil, err
+	}
+	validators, err := ss.LoadValidators(height + 1)
+	if err != nil {
+		return -1, nil, err
+	}
+	nextValidators, err := ss.LoadValidators(height + 2)
+	if err != nil {
+		return -1, nil, err
+	}
+	params, err := ss.LoadConsensusParams(height + 1)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	// The change heights are only known to be correct if no change happened
+	// after the rollback height. Otherwise, saving the state stores the full
+	// validator set and consensus params at the heights they are set to.
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	if valChangeHeight > height+2 {
+		valChangeHeight = height + 2
+	}
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	if paramsChangeHeight > height+1 {
+		paramsChangeHeight = height + 1
+	}
+
+	rolledBackState := State{
+		// The next block was built with the version of the state.
+		Version: cmtstate.Version{
+			Consensus: nextBlock.Header.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		// immutable fields
+		ChainID:       invalidState.ChainID,
+		InitialHeight: invalidState.InitialHeight,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+	}
+
+	if err := ss.Save(rolledBackState); err != nil {
+		return -1, nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+
+	// The block store can only be one block ahead of the state on startup.
+	var retainHeight int64
+	switch {
+	case removeNextBlock:
+		retainHeight = height
+	case removeBlocks:
+		retainHeight = height + 1
+	default:
+		return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+	}
+	if storeHeight > retainHeight {
+		if err := bs.DeleteBlocksAbove(retainHeight); err != nil {
+			return -1, nil, fmt.Errorf("failed to remove blocks above height %d from blockstore: %w", retainHeight, err)
+		}
+	}
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
@@ -1,7 +1,9 @@
 package state_test
 
 import (
+	"context"
 	"crypto/rand"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/internal/test"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	"github.com/cometbft/cometbft/state"
@@ -202,6 +205,125 @@ func TestRollbackHard(t *testing.T) {
 	require.Equal(t, rollbackHash, currState.AppHash)
 }
 
+// makeRollbackChain returns stores holding a chain of the given number of
+// blocks, along with the states of every height. The validator set changes
+// after height 4, and the consensus params after height 6.
+func makeRollbackChain(t *testing.T, numBlocks int64) (*store.BlockStore, state.Store, map[int64]state.State) {
+	t.Helper()
+	vals, _ := test.ValidatorSet(context.Background(), t, 2, 10)
+	genDoc := test.GenesisDoc(time.Now(), vals.Validators, test.ConsensusParams(), "test-chain")
+	st, err := state.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	// As set by the application on handshake, regardless of the consensus params.
+	st.Version.Consensus.App = 1
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := state.NewStore(dbm.NewMemDB(), state.StoreOptions{})
+	require.NoError(t, stateStore.Save(st))
+
+	states := make(map[int64]state.State)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= numBlocks; height++ {
+		block := st.MakeBlock(height, test.MakeNTxs(height, 2), lastCommit, nil, st.Validators.Proposer.Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = &types.Commit{Height: height, BlockID: blockID}
+		blockStore.SaveBlock(block, parts, lastCommit)
+
+		nextVals := st.NextValidators.Copy()
+		if height == 4 {
+			val, _, err := test.Validator(context.Background(), 10)
+			require.NoError(t, err)
+			require.NoError(t, nextVals.UpdateWithChangeSet([]*types.Validator{val}))
+			st.LastHeightValidatorsChanged = height + 2
+		}
+		nextVals.IncrementProposerPriority(1)
+		if height == 6 {
+			st.ConsensusParams.Block.MaxBytes /= 2
+			st.LastHeightConsensusParamsChanged = height + 1
+		}
+
+		st.LastBlockHeight = height
+		st.LastBlockID = blockID
+		st.LastBlockTime = block.Time
+		st.LastValidators = st.Validators.Copy()
+		st.Validators = st.NextValidators.Copy()
+		st.NextValidators = nextVals
+		st.AppHash = tmhash.Sum([]byte(fmt.Sprintf("app-%d", height)))
+		st.LastResultsHash = tmhash.Sum([]byte(fmt.Sprintf("results-%d", height)))
+		require.NoError(t, stateStore.Save(st))
+		states[height] = st.Copy()
+	}
+	return blockStore, stateStore, states
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	const numBlocks = 10
+	testCases := map[string]struct {
+		height       int64
+		removeBlocks bool
+		hard         bool
+		storeHeight  int64
+	}{
+		"before the changes":       {height: 3, removeBlocks: true, storeHeight: 4},
+		"before the changes, hard": {height: 3, hard: true, storeHeight: 3},
+		"between the changes":      {height: 5, removeBlocks: true, storeHeight: 6},
+		"after the changes, hard":  {height: 8, hard: true, storeHeight: 8},
+		"one height":               {height: 9, removeBlocks: true, storeHeight: 10},
+		"keeping the blocks":       {height: 5, storeHeight: numBlocks},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			blockStore, stateStore, states := makeRollbackChain(t, numBlocks)
+			expected := states[tc.height]
+
+			height, hash, err := state.RollbackToHeight(blockStore, stateStore, tc.height, tc.removeBlocks, tc.hard)
+			require.NoError(t, err)
+			require.Equal(t, tc.height, height)
+			require.Equal(t, expected.AppHash, hash)
+			require.Equal(t, tc.storeHeight, blockStore.Height())
+
+			loadedState, err := stateStore.Load()
+			require.NoError(t, err)
+			require.Equal(t, expected.Version, loadedState.Version)
+			require.Equal(t, expected.LastBlockHeight, loadedState.LastBlockHeight)
+			require.Equal(t, expected.LastBlockID, loadedState.LastBlockID)
+			require.Equal(t, expected.AppHash, loadedState.AppHash)
+			require.Equal(t, expected.LastResultsHash, loadedState.LastResultsHash)
+			require.Equal(t, expected.ConsensusParams, loadedState.ConsensusParams)
+			require.Equal(t, expected.LastValidators.Hash(), loadedState.LastValidators.Hash())
+			require.Equal(t, expected.Validators.Hash(), loadedState.Validators.Hash())
+			require.Equal(t, expected.NextValidators.Hash(), loadedState.NextValidators.Hash())
+
+			// The validator sets and consensus params saved along with the
+			// state can be loaded.
+			for h := tc.height; h <= tc.height+2; h++ {
+				vals, err := stateStore.LoadValidators(h)
+				require.NoError(t, err)
+				require.Equal(t, states[h-1].Validators.Hash(), vals.Hash())
+			}
+			params, err := stateStore.LoadConsensusParams(tc.height + 1)
+			require.NoError(t, err)
+			require.Equal(t, expected.ConsensusParams, params)
+		})
+	}
+}
+
+func TestRollbackToHeightInvalid(t *testing.T) {
+	blockStore, stateStore, _ := makeRollbackChain(t, 5)
+
+	_, _, err := state.RollbackToHeight(blockStore, stateStore, 5, false, false)
+	require.ErrorContains(t, err, "not below the state height 5")
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 0, false, false)
+	require.ErrorContains(t, err, "below the initial height 1")
+
+	require.NoError(t, blockStore.DeleteBlocksAbove(3))
+	_, _, err = state.RollbackToHeight(blockStore, stateStore, 2, false, false)
+	require.ErrorContains(t, err, "statestore height (5) is not one below or equal to blockstore height (3)")
+}
+
 func TestRollbackNoState(t *testing.T) {
 	stateStore := state.NewStore(dbm.NewMemDB(),
 		state.StoreOptions{
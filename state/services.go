@@ -38,6 +38,7 @@ type BlockStore interface {
 	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 
 	DeleteLatestBlock() error
+	DeleteBlocksAbove(height int64) error
 
 	Close() error
 }
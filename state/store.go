@@ -10,6 +10,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/compress"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
@@ -50,6 +51,7 @@ var (
 	lastABCIResponseKey              = []byte("lastABCIResponseKey")
 	lastABCIResponsesRetainHeightKey = []byte("lastABCIResponsesRetainHeight")
 	offlineStateSyncHeight           = []byte("offlineStateSyncHeightKey")
+	dataCompanionCursorKey           = []byte("DCCursorKey")
 )
 
 //go:generate ../scripts/mockery_generate.sh Store
@@ -81,6 +83,8 @@ type Store interface {
 	SaveFinalizeBlockResponse(height int64, res *abci.ResponseFinalizeBlock) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(state State) error
+	// RestoreHeight saves the validators, consensus params and FinalizeBlock response of a past height
+	RestoreHeight(height int64, vals *types.ValidatorSet, params types.ConsensusParams, resp *abci.ResponseFinalizeBlock) error
 	// PruneStates takes the height from which to start pruning and which height stop at
 	PruneStates(fromHeight, toHeight, evidenceThresholdHeight int64) error
 	// PruneABCIResponses will prune all ABCI responses below the given height.
@@ -97,6 +101,10 @@ type Store interface {
 	SaveABCIResRetainHeight(height int64) error
 	// GetABCIResRetainHeight returns the last saved retain height for ABCI results set by the data companion
 	GetABCIResRetainHeight() (int64, error)
+	// SaveDataCompanionCursor persists the height of the last block acknowledged by the data companion
+	SaveDataCompanionCursor(height int64) error
+	// GetDataCompanionCursor returns the height of the last block acknowledged by the data companion, or 0 if none
+	GetDataCompanionCursor() (int64, error)
 	// Saves the height at which the store is bootstrapped after out of band statesync
 	SetOfflineStateSyncHeight(height int64) error
 	// Gets the height at which the store is bootstrapped after out of band statesync
@@ -116,12 +124,28 @@ type StoreOptions struct {
 	// DiscardABCIResponses determines whether or not the store
 	// retains all ABCIResponses. If DiscardABCIResponses is enabled,
 	// the store will maintain only the response object from the latest
-	// height.
+	// height, and those of the KeepRecentABCIResponses latest heights.
 	DiscardABCIResponses bool
+
+	// KeepRecentABCIResponses is the number of latest heights whose responses
+	// are still retained if DiscardABCIResponses is enabled. The responses of
+	// older heights are deleted by the pruner.
+	KeepRecentABCIResponses int64
+
+	// Compression is the codec that the FinalizeBlock responses of every
+	// height are compressed with when saved. They are decompressed on load
+	// whatever the codec they were saved with.
+	Compression compress.Codec
 }
 
 var _ Store = (*dbStore)(nil)
 
+// persistsABCIResponses returns whether the responses of every height are
+// saved, at least until the pruner deletes them.
+func (store dbStore) persistsABCIResponses() bool {
+	return !store.DiscardABCIResponses || store.KeepRecentABCIResponses > 0
+}
+
 func IsEmpty(store dbStore) (bool, error) {
 	state, err := store.Load()
 	if err != nil {
@@ -266,6 +290,32 @@ func (store dbStore) Bootstrap(state State) error {
 	return store.db.SetSync(stateKey, state.Bytes())
 }
 
+// RestoreHeight saves the validators, consensus params and FinalizeBlock
+// response of a past height, as when importing blocks. Unlike Save, it does not
+// change the latest state. The response is not saved if it is nil or if the
+// responses are discarded.
+func (store dbStore) RestoreHeight(
+	height int64,
+	vals *types.ValidatorSet,
+	params types.ConsensusParams,
+	resp *abci.ResponseFinalizeBlock,
+) error {
+	if err := store.saveValidatorsInfo(height, height, vals); err != nil {
+		return err
+	}
+	if err := store.saveConsensusParamsInfo(height, height, params); err != nil {
+		return err
+	}
+	if resp == nil || !store.persistsABCIResponses() {
+		return nil
+	}
+	bz, err := resp.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.db.Set(calcABCIResponsesKey(height), store.Compression.Compress(bz))
+}
+
 // PruneStates deletes states between the given heights (including from, excluding to). It is not
 // guaranteed to delete all states, since the last checkpointed state and states being pointed to by
 // e.g. `LastHeightChanged` must remain. The state at to must also exist.
@@ -406,7 +456,7 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 // including, the given height. On success, returns the number of heights
 // pruned and the new retain height.
 func (store dbStore) PruneABCIResponses(targetRetainHeight int64) (int64, int64, error) {
-	if store.DiscardABCIResponses {
+	if !store.persistsABCIResponses() {
 		return 0, 0, nil
 	}
 	lastRetainHeight, err := store.getLastABCIResponsesRetainHeight()
@@ -444,6 +494,11 @@ func (store dbStore) PruneABCIResponses(targetRetainHeight int64) (int64, int64,
 			defer batch.Close()
 		}
 	}
+	if targetRetainHeight > lastRetainHeight {
+		if err := batch.Set(lastABCIResponsesRetainHeightKey, int64ToBytes(targetRetainHeight)); err != nil {
+			return pruned, lastRetainHeight + pruned, fmt.Errorf("failed to set last ABCI responses retain height: %w", err)
+		}
+	}
 	return pruned + batchPruned, targetRetainHeight, batch.WriteSync()
 }
 
@@ -461,7 +516,7 @@ func TxResultsHash(txResults []*abci.ExecTxResult) []byte {
 // database. If the node has D set to true, ErrABCIResponsesNotPersisted
 // is persisted. If not found, ErrNoABCIResponsesForHeight is returned.
 func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.ResponseFinalizeBlock, error) {
-	if store.DiscardABCIResponses {
+	if !store.persistsABCIResponses() {
 		return nil, ErrFinalizeBlockResponsesNotPersisted
 	}
 
@@ -472,6 +527,9 @@ func (store dbStore) LoadFinalizeBlockResponse(height int64) (*abci.ResponseFina
 	if len(buf) == 0 {
 		return nil, ErrNoABCIResponsesForHeight{height}
 	}
+	if buf, err = compress.Decompress(buf); err != nil {
+		return nil, fmt.Errorf("decompressing FinalizeBlock response of height %d: %w", height, err)
+	}
 
 	resp := new(abci.ResponseFinalizeBlock)
 	err = resp.Unmarshal(buf)
@@ -554,14 +612,14 @@ func (store dbStore) SaveFinalizeBlockResponse(height int64, resp *abci.Response
 	}
 	resp.TxResults = dtxs
 
-	// If the flag is false then we save the ABCIResponse. This can be used for the /BlockResults
-	// query or to reindex an event using the command line.
-	if !store.DiscardABCIResponses {
+	// If the flag is false, or recent responses are kept, then we save the ABCIResponse. This can
+	// be used for the /BlockResults query or to reindex an event using the command line.
+	if store.persistsABCIResponses() {
 		bz, err := resp.Marshal()
 		if err != nil {
 			return err
 		}
-		if err := store.db.Set(calcABCIResponsesKey(height), bz); err != nil {
+		if err := store.db.Set(calcABCIResponsesKey(height), store.Compression.Compress(bz)); err != nil {
 			return err
 		}
 	}
@@ -649,6 +707,26 @@ func (store dbStore) GetABCIResRetainHeight() (int64, error) {
 	return height, nil
 }
 
+// DataCompanionCursor
+func (store dbStore) SaveDataCompanionCursor(height int64) error {
+	return store.db.SetSync(dataCompanionCursorKey, int64ToBytes(height))
+}
+
+func (store dbStore) GetDataCompanionCursor() (int64, error) {
+	bz, err := store.getValue(dataCompanionCursorKey)
+	if errors.Is(err, ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	height := int64FromBytes(bz)
+	if height < 0 {
+		return 0, ErrInvalidHeightValue
+	}
+	return height, nil
+}
+
 func (store dbStore) getLastABCIResponsesRetainHeight() (int64, error) {
 	bz, err := store.getValue(lastABCIResponsesRetainHeightKey)
 	if errors.Is(err, ErrKeyNotFound) {
@@ -3,6 +3,7 @@ package state_test
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/compress"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
@@ -332,6 +334,19 @@ func TestSaveRetainHeight(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDataCompanionCursor(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	height, err := stateStore.GetDataCompanionCursor()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+
+	require.NoError(t, stateStore.SaveDataCompanionCursor(10))
+	height, err = stateStore.GetDataCompanionCursor()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+}
+
 func TestMinRetainHeight(t *testing.T) {
 	_, bs, txIndexer, blockIndexer, callbackF, stateStore := makeStateAndBlockStoreAndIndexers()
 	defer callbackF()
@@ -566,6 +581,35 @@ func TestLastFinalizeBlockResponses(t *testing.T) {
 	})
 }
 
+func TestFinalizeBlockResponseCompression(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	response := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Code: 0, Data: []byte("Hello"), Log: strings.Repeat("verbose log ", 100)},
+		},
+		AppHash: []byte("app_hash"),
+	}
+
+	// The responses are saved alternatively with and without compression.
+	for height := int64(1); height <= 4; height++ {
+		codec := compress.None
+		if height%2 == 0 {
+			codec = compress.Snappy
+		}
+		stateStore := sm.NewStore(stateDB, sm.StoreOptions{Compression: codec})
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(height, response))
+	}
+
+	for _, codec := range []compress.Codec{compress.None, compress.Snappy} {
+		stateStore := sm.NewStore(stateDB, sm.StoreOptions{Compression: codec})
+		for height := int64(1); height <= 4; height++ {
+			loaded, err := stateStore.LoadFinalizeBlockResponse(height)
+			require.NoError(t, err)
+			assert.Equal(t, response, loaded)
+		}
+	}
+}
+
 func TestFinalizeBlockRecoveryUsingLegacyABCIResponses(t *testing.T) {
 	var (
 		height              int64 = 10
@@ -37,6 +37,33 @@ type TxIndexer interface {
 	GetRetainHeight() (int64, error)
 
 	SetRetainHeight(retainHeight int64) error
+
+	// DeleteHeightsAbove removes the transactions of the heights above the
+	// given one, e.g. after a rollback.
+	DeleteHeightsAbove(height int64) error
+}
+
+// Position is the position of a transaction in the blockchain, which orders
+// the results of paginated searches.
+type Position struct {
+	Height int64
+	Index  uint32
+}
+
+// Pager is implemented by the transaction indexers able to return the results
+// of a search page by page, without loading all the matching transactions.
+type Pager interface {
+	// SearchPage returns at most limit transactions matching the query, in
+	// ascending (or descending if desc is set) order of position, which come
+	// after the given position in that order. A nil position starts from the
+	// first transaction.
+	SearchPage(
+		ctx context.Context,
+		q *query.Query,
+		after *Position,
+		limit int,
+		desc bool,
+	) ([]*abci.TxResult, error)
 }
 
 // Batch groups together multiple Index operations to be performed at the same time.
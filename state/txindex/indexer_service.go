@@ -2,6 +2,8 @@ package txindex
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -23,6 +25,10 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+
+	// Serializes the writes to the indexers of the new blocks and of the
+	// reindexed ones.
+	mtx sync.Mutex
 }
 
 // NewIndexerService returns a new service instance.
@@ -95,7 +101,15 @@ func (is *IndexerService) OnStart() error {
 					}
 				}
 
-				if err := is.blockIdxr.Index(eventNewBlockEvents); err != nil {
+				is.mtx.Lock()
+				blockErr := is.blockIdxr.Index(eventNewBlockEvents)
+				var txErr error
+				if blockErr == nil || !is.terminateOnError {
+					txErr = is.txIdxr.AddBatch(batch)
+				}
+				is.mtx.Unlock()
+
+				if err := blockErr; err != nil {
 					is.Logger.Error("failed to index block", "height", height, "err", err)
 					if is.terminateOnError {
 						if err := is.Stop(); err != nil {
@@ -107,7 +121,7 @@ func (is *IndexerService) OnStart() error {
 					is.Logger.Info("indexed block events", "height", height)
 				}
 
-				if err = is.txIdxr.AddBatch(batch); err != nil {
+				if err := txErr; err != nil {
 					is.Logger.Error("failed to index block txs", "height", height, "err", err)
 					if is.terminateOnError {
 						if err := is.Stop(); err != nil {
@@ -124,6 +138,22 @@ func (is *IndexerService) OnStart() error {
 	return nil
 }
 
+// Reindex indexes the events of a committed block and the results of its
+// transactions again, e.g. to backfill the indexes after changing the indexer.
+// It is safe to call while the service indexes the new blocks.
+func (is *IndexerService) Reindex(events types.EventDataNewBlockEvents, batch *Batch) error {
+	is.mtx.Lock()
+	defer is.mtx.Unlock()
+
+	if err := is.blockIdxr.Index(events); err != nil {
+		return fmt.Errorf("failed to index block %d: %w", events.Height, err)
+	}
+	if err := is.txIdxr.AddBatch(batch); err != nil {
+		return fmt.Errorf("failed to index the txs of block %d: %w", events.Height, err)
+	}
+	return nil
+}
+
 // OnStop implements service.Service by unsubscribing from all transactions.
 func (is *IndexerService) OnStop() {
 	if is.eventBus.IsRunning() {
@@ -3,11 +3,13 @@ package kv
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,6 +18,7 @@ import (
 	"github.com/cometbft/cometbft/state"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/google/orderedcode"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -31,6 +34,8 @@ import (
 const (
 	tagKeySeparator   = "/"
 	eventSeqSeparator = "$es$"
+	// txPositionKey prefixes the keys indexing the transactions by position.
+	txPositionKey = "tx.position"
 )
 
 var (
@@ -43,6 +48,8 @@ type TxIndex struct {
 	store dbm.DB
 	// Number the events in the event list
 	eventSeq int64
+	// Selects the event attributes to index
+	eventFilter *indexer.EventFilter
 
 	log log.Logger
 }
@@ -138,6 +145,65 @@ func (txi *TxIndex) Prune(retainHeight int64) (int64, int64, error) {
 	return numHeightsPersistentlyPruned, currentPersistentlyRetainedHeight, nil
 }
 
+// DeleteHeightsAbove removes the transactions of the heights above the given
+// one, along with their events. The transactions are found through their
+// position keys, so the ones indexed before those keys existed must be
+// reindexed first.
+func (txi *TxIndex) DeleteHeightsAbove(height int64) error {
+	after := &txindex.Position{Height: height, Index: math.MaxUint32}
+	for {
+		// Delete the transactions in batches of at most 1000, to avoid
+		// batches becoming too large. The keys are collected before deleting,
+		// since the iterator must be released before writing.
+		itr, err := txi.positionIterator(after, false)
+		if err != nil {
+			return err
+		}
+		var keys, hashes [][]byte
+		for ; itr.Valid() && len(keys) < 1000; itr.Next() {
+			keys = append(keys, append([]byte{}, itr.Key()...))
+			hashes = append(hashes, append([]byte{}, itr.Value()...))
+		}
+		if err := itr.Error(); err != nil {
+			itr.Close()
+			return err
+		}
+		if err := itr.Close(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		if err := txi.deleteBatch(height, keys, hashes); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteBatch removes the given position keys, and the transactions they
+// point to if those are above the given height.
+func (txi *TxIndex) deleteBatch(height int64, keys, hashes [][]byte) error {
+	batch := txi.store.NewBatch()
+	defer batch.Close()
+	for i, key := range keys {
+		res, err := txi.Get(hashes[i])
+		if err != nil {
+			return err
+		}
+		// The transaction may have been re-indexed at a lower height.
+		if res != nil && res.Height > height {
+			if err := txi.deleteResult(res, batch); err != nil {
+				return err
+			}
+		}
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
 func (txi *TxIndex) SetRetainHeight(retainHeight int64) error {
 	return txi.store.SetSync(TxIndexerRetainHeightKey, int64ToBytes(retainHeight))
 }
@@ -175,11 +241,24 @@ func (txi *TxIndex) getIndexerRetainHeight() (int64, error) {
 	return height, nil
 }
 
+// Option sets an optional parameter of the TxIndex.
+type Option func(*TxIndex)
+
+// WithEventFilter restricts the indexed event attributes to those selected by
+// the given filter.
+func WithEventFilter(filter *indexer.EventFilter) Option {
+	return func(txi *TxIndex) { txi.eventFilter = filter }
+}
+
 // NewTxIndex creates new KV indexer.
-func NewTxIndex(store dbm.DB) *TxIndex {
-	return &TxIndex{
+func NewTxIndex(store dbm.DB, options ...Option) *TxIndex {
+	txi := &TxIndex{
 		store: store,
 	}
+	for _, option := range options {
+		option(txi)
+	}
+	return txi
 }
 
 func (txi *TxIndex) SetLogger(l log.Logger) {
@@ -233,6 +312,16 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			return err
 		}
 
+		// index by position (always)
+		positionKey, err := keyForPosition(result.Height, int64(result.Index))
+		if err != nil {
+			return err
+		}
+		err = storeBatch.Set(positionKey, hash)
+		if err != nil {
+			return err
+		}
+
 		rawBytes, err := proto.Marshal(result)
 		if err != nil {
 			return err
@@ -257,6 +346,14 @@ func (txi *TxIndex) deleteResult(result *abci.TxResult, batch dbm.Batch) error {
 	if err != nil {
 		return err
 	}
+	positionKey, err := keyForPosition(result.Height, int64(result.Index))
+	if err != nil {
+		return err
+	}
+	err = batch.Delete(positionKey)
+	if err != nil {
+		return err
+	}
 	err = batch.Delete(hash)
 	if err != nil {
 		return err
@@ -304,6 +401,16 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 		return err
 	}
 
+	// index by position (always)
+	positionKey, err := keyForPosition(result.Height, int64(result.Index))
+	if err != nil {
+		return err
+	}
+	err = b.Set(positionKey, hash)
+	if err != nil {
+		return err
+	}
+
 	rawBytes, err := proto.Marshal(result)
 	if err != nil {
 		return err
@@ -331,18 +438,24 @@ func (txi *TxIndex) deleteEvents(result *abci.TxResult, batch dbm.Batch) error {
 
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
 			if attr.GetIndex() {
+				// The event sequences are not zero-padded, so the keys of all
+				// the sequences are found by prefix rather than by range.
 				zeroKey := keyForEvent(compositeTag, attr.Value, result, 0)
-				endKey := keyForEvent(compositeTag, attr.Value, result, math.MaxInt64)
-				itr, err := txi.store.Iterator(zeroKey, endKey)
+				prefix := zeroKey[:len(zeroKey)-1]
+				itr, err := dbm.IteratePrefix(txi.store, prefix)
 				if err != nil {
 					return err
 				}
 				for ; itr.Valid(); itr.Next() {
 					err := batch.Delete(itr.Key())
 					if err != nil {
+						itr.Close()
 						return err
 					}
 				}
+				if err := itr.Close(); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -362,13 +475,13 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 				continue
 			}
 
-			// index if `index: true` is set
+			// index if `index: true` is set and the attribute is not filtered out
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
 			// ensure event does not conflict with a reserved prefix key
 			if compositeTag == types.TxHashKey || compositeTag == types.TxHeightKey {
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeTag)
 			}
-			if attr.GetIndex() {
+			if txi.eventFilter.IndexAttribute(event.Type, attr) {
 				err := store.Set(keyForEvent(compositeTag, attr.Value, result, txi.eventSeq), hash)
 				if err != nil {
 					return err
@@ -389,6 +502,11 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // performing a full scan. Results from querying indexes are then intersected
 // and returned to the caller, in no particular order.
 //
+// Queries using OR and NOT are evaluated by combining the results of their
+// subexpressions: disjunctions are merged, conjunctions are intersected and
+// negations are subtracted from the results of the other operands of their
+// conjunction, or from all the indexed transactions otherwise.
+//
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
@@ -399,26 +517,395 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
+	refs, err := txi.searchExpr(ctx, q.Syntax())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(refs))
+	resultMap := make(map[string]struct{})
+RESULTS_LOOP:
+	for _, ref := range refs {
+		h := hashFromRef(ref)
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		hashString := string(h)
+		if _, ok := resultMap[hashString]; !ok {
+			resultMap[hashString] = struct{}{}
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break RESULTS_LOOP
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// SearchPage implements txindex.Pager. The transactions are iterated in
+// position order from the given one, using the keys indexing them by position,
+// and matched one by one against the query until the page is full. The cost of
+// a page is therefore bounded by the number of transactions between its first
+// and last results, rather than by the number of matching transactions.
+//
+// The query is matched against the indexed attributes of the transactions as
+// in Search: the conditions of a conjunction must hold for a same event.
+// Transactions indexed before the position keys were added are not found, and
+// must be reindexed.
+func (txi *TxIndex) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	after *txindex.Position,
+	limit int,
+	desc bool,
+) ([]*abci.TxResult, error) {
+	match, err := txi.compileExpr(q.Syntax())
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := txi.positionIterator(after, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	results := make([]*abci.TxResult, 0, limit)
+	for ; it.Valid() && len(results) < limit; it.Next() {
+		hash := it.Value()
+		res, err := txi.Get(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		if res == nil {
+			continue
+		}
+		// The transaction may have been indexed again at another position.
+		key, err := keyForPosition(res.Height, int64(res.Index))
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(it.Key(), key) {
+			continue
+		}
+		if match(res, hash) {
+			results = append(results, res)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return results, it.Error()
+}
+
+// positionIterator returns an iterator over the keys indexing the transactions
+// by position, starting after the given position in ascending (or descending
+// if desc is set) order. A nil position starts from the first transaction.
+func (txi *TxIndex) positionIterator(after *txindex.Position, desc bool) (dbm.Iterator, error) {
+	prefix, err := orderedcode.Append(nil, txPositionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+	// The prefix ends with the string terminator, which can be incremented to
+	// get the end of the keys.
+	start, end := prefix, append([]byte{}, prefix...)
+	end[len(end)-1]++
+
+	if desc {
+		if after != nil {
+			if end, err = keyForPosition(after.Height, int64(after.Index)); err != nil {
+				return nil, err
+			}
+		}
+		return txi.store.ReverseIterator(start, end)
+	}
+	if after != nil {
+		if start, err = keyForPosition(after.Height, int64(after.Index)+1); err != nil {
+			return nil, err
+		}
+	}
+	return txi.store.Iterator(start, end)
+}
+
+// A txMatcher reports whether the transaction of the given result and hash
+// matches a compiled query expression.
+type txMatcher func(result *abci.TxResult, hash []byte) bool
+
+// compileExpr compiles the given expression into a matcher of transactions,
+// which combines the matches of the subexpressions of OR, AND and NOT
+// expressions as searchExpr does.
+func (txi *TxIndex) compileExpr(expr syntax.Expr) (txMatcher, error) {
+	if conditions, ok := syntax.Conjunction(expr); ok {
+		return txi.compileConditions(conditions)
+	}
+
+	switch expr := expr.(type) {
+	case syntax.Or:
+		subs, err := txi.compileExprs(expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(result *abci.TxResult, hash []byte) bool {
+			for _, sub := range subs {
+				if sub(result, hash) {
+					return true
+				}
+			}
+			return false
+		}, nil
+
+	case syntax.And:
+		subs, err := txi.compileExprs(expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(result *abci.TxResult, hash []byte) bool {
+			for _, sub := range subs {
+				if !sub(result, hash) {
+					return false
+				}
+			}
+			return true
+		}, nil
+
+	case syntax.Not:
+		sub, err := txi.compileExpr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(result *abci.TxResult, hash []byte) bool {
+			return !sub(result, hash)
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported query expression %s", expr)
+	}
+}
+
+func (txi *TxIndex) compileExprs(exprs []syntax.Expr) ([]txMatcher, error) {
+	subs := make([]txMatcher, len(exprs))
+	for i, expr := range exprs {
+		sub, err := txi.compileExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		subs[i] = sub
+	}
+	return subs, nil
+}
+
+// compileConditions compiles the conjunction of the given conditions into a
+// matcher of transactions. As in searchConditions, a "tx.hash" condition
+// supersedes the other ones, "tx.height" conditions are matched against the
+// height of the transaction, and the other conditions must hold for a same
+// event.
+func (txi *TxIndex) compileConditions(conditions []syntax.Condition) (txMatcher, error) {
+	hashes, ok, err := lookForHashes(conditions)
+	if err != nil {
+		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
+	} else if ok {
+		return func(_ *abci.TxResult, hash []byte) bool {
+			for _, h := range hashes {
+				if bytes.Equal(h, hash) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	var heightConditions, eventConditions syntax.And
+	for _, c := range conditions {
+		if c.Tag == types.TxHeightKey {
+			heightConditions = append(heightConditions, c)
+		} else {
+			eventConditions = append(eventConditions, c)
+		}
+	}
+	heightQuery, err := query.Compile(heightConditions)
+	if err != nil {
+		return nil, err
+	}
+	eventQuery, err := query.Compile(eventConditions)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(result *abci.TxResult, _ []byte) bool {
+		if len(heightConditions) > 0 {
+			height := map[string][]string{types.TxHeightKey: {strconv.FormatInt(result.Height, 10)}}
+			if ok, _ := heightQuery.Matches(height); !ok {
+				return false
+			}
+		}
+		if len(eventConditions) == 0 {
+			return true
+		}
+		for _, event := range result.Result.Events {
+			// only events with a non-empty type are indexed
+			if len(event.Type) == 0 {
+				continue
+			}
+			attrs := make(map[string][]string)
+			for _, attr := range event.Attributes {
+				if len(attr.Key) == 0 || !txi.eventFilter.IndexAttribute(event.Type, attr) {
+					continue
+				}
+				compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+				attrs[compositeTag] = append(attrs[compositeTag], attr.Value)
+			}
+			if ok, _ := eventQuery.Matches(attrs); ok {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// searchExpr returns references to the transactions matching the given
+// expression, keyed by reference.
+func (txi *TxIndex) searchExpr(ctx context.Context, expr syntax.Expr) (map[string][]byte, error) {
+	if conditions, ok := syntax.Conjunction(expr); ok {
+		hashes, err := txi.searchConditions(ctx, conditions)
+		if err != nil {
+			return nil, err
+		}
+		// The matches of the conditions are keyed by hash and event
+		// sequence, so that they can be intersected per event.
+		return idxutil.KeyByValue(hashes), nil
+	}
+
+	switch expr := expr.(type) {
+	case syntax.Or:
+		union := make(map[string][]byte)
+		for _, sub := range expr {
+			hashes, err := txi.searchExpr(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range hashes {
+				union[k] = v
+			}
+		}
+		return union, nil
+
+	case syntax.And:
+		// Conditions are searched together, so that they are matched against
+		// the same events, as in a plain conjunction of conditions.
+		var conditions syntax.And
+		var positive, negative []syntax.Expr
+		for _, sub := range expr {
+			switch sub := sub.(type) {
+			case syntax.Condition:
+				conditions = append(conditions, sub)
+			case syntax.Not:
+				negative = append(negative, sub.Expr)
+			default:
+				positive = append(positive, sub)
+			}
+		}
+		if len(conditions) > 0 {
+			positive = append(positive, conditions)
+		}
+		var hashes map[string][]byte
+		var err error
+		if len(positive) == 0 {
+			hashes, err = txi.allHashes(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, sub := range positive {
+			subHashes, err := txi.searchExpr(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			if hashes == nil {
+				hashes = subHashes
+			} else {
+				idxutil.Intersect(hashes, subHashes)
+			}
+			if len(hashes) == 0 {
+				return hashes, nil
+			}
+		}
+		for _, sub := range negative {
+			subHashes, err := txi.searchExpr(ctx, sub)
+			if err != nil {
+				return nil, err
+			}
+			idxutil.Subtract(hashes, subHashes)
+		}
+		return hashes, nil
+
+	case syntax.Not:
+		hashes, err := txi.allHashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		subHashes, err := txi.searchExpr(ctx, expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		idxutil.Subtract(hashes, subHashes)
+		return hashes, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported query expression %s", expr)
+	}
+}
+
+// allHashes returns references to all the indexed transactions, keyed by
+// reference.
+func (txi *TxIndex) allHashes(ctx context.Context) (map[string][]byte, error) {
+	hashes := make(map[string][]byte)
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+LOOP:
+	for ; it.Valid(); it.Next() {
+		ref := txRefFromKey(it.Key(), it.Value())
+		hashes[string(ref)] = ref
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break LOOP
+		default:
+		}
+	}
+	return hashes, it.Error()
+}
+
+// searchConditions returns references to the transactions matching the
+// conjunction of the given conditions, keyed by hash and event sequence.
+func (txi *TxIndex) searchConditions(ctx context.Context, conditions []syntax.Condition) (map[string][]byte, error) {
 	var hashesInitialized bool
 	filteredHashes := make(map[string][]byte)
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions := q.Syntax()
-
 	// if there is a hash condition, return the result immediately
-	hash, ok, err := lookForHash(conditions)
+	hashes, ok, err := lookForHashes(conditions)
 	if err != nil {
 		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
 	} else if ok {
-		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+		for _, hash := range hashes {
+			res, err := txi.Get(hash)
+			switch {
+			case err != nil:
+				return nil, fmt.Errorf("error while retrieving the result: %w", err)
+			case res != nil:
+				filteredHashes[string(hash)] = txRef(res.Height, res.Index, hash)
+			}
 		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -487,44 +974,35 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
-	for _, h := range filteredHashes {
-
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
-			results = append(results, res)
-		}
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break RESULTS_LOOP
-		default:
-		}
-	}
-
-	return results, nil
+	return filteredHashes, nil
 }
 
-func lookForHash(conditions []syntax.Condition) (hash []byte, ok bool, err error) {
+// lookForHashes returns the hashes looked up by the first "tx.hash"
+// condition, which is either an equality or an IN condition.
+func lookForHashes(conditions []syntax.Condition) (hashes [][]byte, ok bool, err error) {
 	for _, c := range conditions {
-		if c.Tag == types.TxHashKey {
-			decoded, err := hex.DecodeString(c.Arg.Value())
-			return decoded, true, err
+		if c.Tag != types.TxHashKey {
+			continue
+		}
+		args := []*syntax.Arg{c.Arg}
+		if c.Op == syntax.TIn {
+			args = c.Args
+		}
+		for _, arg := range args {
+			decoded, err := hex.DecodeString(arg.Value())
+			if err != nil {
+				return nil, true, err
+			}
+			hashes = append(hashes, decoded)
 		}
+		return hashes, true, nil
 	}
 	return
 }
 
 func (txi *TxIndex) setTmpHashes(tmpHeights map[string][]byte, it dbm.Iterator) {
 	eventSeq := extractEventSeqFromKey(it.Key())
-	tmpHeights[string(it.Value())+eventSeq] = it.Value()
+	tmpHeights[string(it.Value())+eventSeq] = txRefFromKey(it.Key(), it.Value())
 }
 
 // match returns all matching txs by hash that meet a given condition and start
@@ -666,6 +1144,27 @@ func (txi *TxIndex) match(
 		if err := it.Error(); err != nil {
 			panic(err)
 		}
+	case c.Op == syntax.TIn:
+		// Look up each of the values, as for an equality.
+		for _, arg := range c.Args {
+			eq := syntax.Condition{Tag: c.Tag, Op: syntax.TEq, Arg: arg}
+			txi.matchValues(ctx, startKeyForCondition(eq, heightInfo.height), nil, tmpHashes, heightInfo)
+		}
+
+	case c.Op == syntax.TStartsWith || c.Op == syntax.TMatches:
+		// Only scan the values starting with the prefix required by the
+		// condition, if any.
+		matchValue := func(value string) bool { return strings.HasPrefix(value, c.Arg.Value()) }
+		if c.Op == syntax.TMatches {
+			re, err := regexp.Compile(c.Arg.Value())
+			if err != nil {
+				panic(err)
+			}
+			matchValue = re.MatchString
+		}
+		prefix := append(startKey(c.Tag), indexer.ValuePrefix(c)...)
+		txi.matchValues(ctx, prefix, matchValue, tmpHashes, heightInfo)
+
 	default:
 		panic("other operators should be handled already")
 	}
@@ -701,6 +1200,56 @@ REMOVE_LOOP:
 	return filteredHashes
 }
 
+// matchValues adds to tmpHashes the txs indexed under keys with the given
+// prefix, whose value is accepted by matchValue, if set.
+func (txi *TxIndex) matchValues(
+	ctx context.Context,
+	prefix []byte,
+	matchValue func(value string) bool,
+	tmpHashes map[string][]byte,
+	heightInfo HeightInfo,
+) {
+	it, err := dbm.IteratePrefix(txi.store, prefix)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+LOOP:
+	for ; it.Valid(); it.Next() {
+		if !isTagKey(it.Key()) {
+			continue
+		}
+		if matchValue != nil && !matchValue(extractValueFromKey(it.Key())) {
+			continue
+		}
+		keyHeight, err := extractHeightFromKey(it.Key())
+		if err != nil {
+			txi.log.Error("failure to parse height from key:", err)
+			continue
+		}
+		withinBounds, err := checkHeightConditions(heightInfo, keyHeight)
+		if err != nil {
+			txi.log.Error("failure checking for height bounds:", err)
+			continue
+		}
+		if !withinBounds {
+			continue
+		}
+		txi.setTmpHashes(tmpHashes, it)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break LOOP
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+}
+
 // matchRange returns all matching txs by hash that meet a given queryRange and
 // start key. An already filtered result (filteredHashes) is provided such that
 // any non-intersecting matches are removed.
@@ -825,6 +1374,37 @@ REMOVE_LOOP:
 	return filteredHashes
 }
 
+// References
+//
+// The matches of a search are references to the indexed transactions, made of
+// their position followed by their hash. The position is encoded so that
+// references sort in height and index order.
+
+const positionLen = 12
+
+func txRef(height int64, index uint32, hash []byte) []byte {
+	ref := make([]byte, positionLen, positionLen+len(hash))
+	binary.BigEndian.PutUint64(ref, uint64(height))
+	binary.BigEndian.PutUint32(ref[8:], index)
+	return append(ref, hash...)
+}
+
+// txRefFromKey returns a reference to the transaction of the given hash,
+// indexed under the given key.
+func txRefFromKey(key, hash []byte) []byte {
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) < 2 {
+		return txRef(0, 0, hash)
+	}
+	height, _ := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	index, _ := strconv.ParseUint(strings.SplitN(parts[len(parts)-1], eventSeqSeparator, 2)[0], 10, 32)
+	return txRef(height, uint32(index), hash)
+}
+
+func hashFromRef(ref []byte) []byte {
+	return ref[positionLen:]
+}
+
 // Keys
 
 func isTagKey(key []byte) bool {
@@ -889,6 +1469,17 @@ func keyForHeight(result *abci.TxResult) []byte {
 	))
 }
 
+// keyForPosition returns the key indexing a transaction by position. Unlike
+// the other keys, it is encoded so that the keys sort in height and index
+// order.
+func keyForPosition(height, index int64) ([]byte, error) {
+	key, err := orderedcode.Append(nil, txPositionKey, height, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx position key: %w", err)
+	}
+	return key, nil
+}
+
 func startKeyForCondition(c syntax.Condition, height int64) []byte {
 	if height > 0 {
 		return startKey(c.Tag, c.Arg.Value(), height)
@@ -18,6 +18,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -143,6 +144,54 @@ func TestTxIndex_Prune(t *testing.T) {
 	assert.True(t, proto.Equal(txResult2, loadedTxResult2))
 }
 
+func TestTxIndexDeleteHeightsAbove(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+	events := []abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{{Key: "number", Value: "1", Index: true}}},
+	}
+
+	var txs []types.Tx
+	var keys [][][]byte
+	for height := int64(1); height <= 3; height++ {
+		tx := types.Tx(fmt.Sprintf("tx-%d", height))
+		txs = append(txs, tx)
+		require.NoError(t, indexer.Index(&abci.TxResult{
+			Height: height,
+			Tx:     tx,
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+		}))
+		keys = append(keys, GetKeys(indexer))
+	}
+	// Enough transactions at the last height to delete them in several batches.
+	batch := txindex.NewBatch(2500)
+	for i := 0; i < 2500; i++ {
+		tx := types.Tx(fmt.Sprintf("tx-4-%d", i))
+		txs = append(txs, tx)
+		require.NoError(t, batch.Add(&abci.TxResult{
+			Height: 4,
+			Index:  uint32(i),
+			Tx:     tx,
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+		}))
+	}
+	require.NoError(t, indexer.AddBatch(batch))
+
+	require.NoError(t, indexer.DeleteHeightsAbove(1))
+	assert.True(t, isEqualSets(keys[0], GetKeys(indexer)))
+
+	res, err := indexer.Get(txs[0].Hash())
+	require.NoError(t, err)
+	assert.NotNil(t, res)
+	for _, tx := range txs[1:] {
+		res, err := indexer.Get(tx.Hash())
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	}
+	results, err := indexer.Search(context.Background(), query.MustCompile("account.number = 1"))
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+}
+
 func TestTxSearch(t *testing.T) {
 	indexer := NewTxIndex(db.NewMemDB())
 
@@ -212,6 +261,17 @@ func TestTxSearch(t *testing.T) {
 		// search using EXISTS for non existing key
 		{"account.date EXISTS", 0},
 		{"not_allowed EXISTS", 0},
+		// search using IN
+		{"account.number IN (2, 1)", 1},
+		{"account.number IN (2, 3)", 0},
+		{fmt.Sprintf("tx.hash IN ('AB', '%X')", hash), 1},
+		// search using STARTS WITH
+		{"account.owner STARTS WITH '/Iv'", 1},
+		{"account.owner STARTS WITH 'Iv'", 0},
+		// search using MATCHES
+		{"account.owner MATCHES '^/I.*n/$'", 1},
+		{"account.owner MATCHES 'van'", 1},
+		{"account.owner MATCHES '^van'", 0},
 	}
 
 	ctx := context.Background()
@@ -657,6 +717,222 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchOrNot(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	txs := []struct {
+		tx        string
+		height    int64
+		sender    string
+		recipient string
+	}{
+		{"tx1", 1, "a", "b"},
+		{"tx2", 1, "b", "a"},
+		{"tx3", 2, "b", "c"},
+		{"tx4", 3, "c", "a"},
+	}
+	for i, tx := range txs {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: tx.sender, Index: true},
+				{Key: "recipient", Value: tx.recipient, Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(tx.tx)
+		txResult.Height = tx.height
+		txResult.Index = uint32(i)
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	testCases := []struct {
+		q    string
+		want []string
+	}{
+		{"transfer.sender = 'a' OR transfer.recipient = 'a'", []string{"tx1", "tx2", "tx4"}},
+		{"transfer.sender = 'z' OR transfer.recipient = 'z'", nil},
+		{"NOT transfer.sender = 'b'", []string{"tx1", "tx4"}},
+		{"NOT tx.height = 1", []string{"tx3", "tx4"}},
+		{"transfer.sender = 'b' AND NOT transfer.recipient = 'a'", []string{"tx3"}},
+		{"(transfer.sender = 'a' OR transfer.recipient = 'a') AND tx.height > 1", []string{"tx4"}},
+		{"transfer.sender = 'c' OR transfer.sender = 'b' AND tx.height = 1", []string{"tx2", "tx4"}},
+		{"NOT (transfer.sender = 'a' OR transfer.sender = 'b')", []string{"tx4"}},
+		{"NOT NOT transfer.recipient = 'c'", []string{"tx3"}},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustCompile(tc.q))
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(results))
+			for _, txr := range results {
+				got = append(got, string(txr.Tx))
+			}
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestTxSearchPage(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	// Heights above 9 check that results are ordered numerically.
+	positions := []txindex.Position{
+		{Height: 1, Index: 0},
+		{Height: 1, Index: 1},
+		{Height: 2, Index: 0},
+		{Height: 9, Index: 0},
+		{Height: 10, Index: 0},
+		{Height: 10, Index: 2},
+	}
+	for _, pos := range positions {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{{Key: "owner", Value: "Ivan", Index: true}}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d/%d", pos.Height, pos.Index))
+		txResult.Height = pos.Height
+		txResult.Index = pos.Index
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		q    string
+		desc bool
+		want []string
+	}{
+		{"account.owner = 'Ivan'", false, []string{"tx1/0", "tx1/1", "tx2/0", "tx9/0", "tx10/0", "tx10/2"}},
+		{"account.owner = 'Ivan'", true, []string{"tx10/2", "tx10/0", "tx9/0", "tx2/0", "tx1/1", "tx1/0"}},
+		{"tx.height >= 2", false, []string{"tx2/0", "tx9/0", "tx10/0", "tx10/2"}},
+		{"NOT tx.height = 10", true, []string{"tx9/0", "tx2/0", "tx1/1", "tx1/0"}},
+		{"account.owner = 'Igor'", false, []string{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		for _, limit := range []int{1, 4} {
+			limit := limit
+			t.Run(fmt.Sprintf("%s desc=%t limit=%d", tc.q, tc.desc, limit), func(t *testing.T) {
+				q := query.MustCompile(tc.q)
+				got := make([]string, 0, len(tc.want))
+				var after *txindex.Position
+				for {
+					results, err := indexer.SearchPage(ctx, q, after, limit, tc.desc)
+					require.NoError(t, err)
+					for _, txr := range results {
+						got = append(got, string(txr.Tx))
+					}
+					if len(results) < limit {
+						break
+					}
+					last := results[len(results)-1]
+					after = &txindex.Position{Height: last.Height, Index: last.Index}
+				}
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	}
+}
+
+func TestTxSearchPageMatchesSearch(t *testing.T) {
+	filter, err := indexer.NewEventFilter(nil, []string{"*.memo"})
+	require.NoError(t, err)
+	txIndexer := NewTxIndex(db.NewMemDB(), WithEventFilter(filter))
+
+	for height := int64(1); height <= 10; height++ {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: fmt.Sprintf("addr%d", height%3), Index: true},
+				{Key: "amount", Value: fmt.Sprint(height * 10), Index: true},
+				{Key: "memo", Value: "hello", Index: true},
+			}},
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: fmt.Sprintf("addr%d", height%2), Index: true},
+				{Key: "amount", Value: "5", Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d", height))
+		txResult.Height = height
+		require.NoError(t, txIndexer.Index(txResult))
+	}
+	ctx := context.Background()
+	queries := []string{
+		"transfer.sender = 'addr1'",
+		"transfer.sender = 'addr1' AND transfer.amount > 20",
+		"transfer.sender = 'addr0' AND transfer.amount = 5",
+		"transfer.sender = 'addr2' OR tx.height < 3",
+		"transfer.amount >= 50 AND NOT transfer.sender = 'addr1'",
+		"NOT transfer.sender EXISTS",
+		"transfer.memo = 'hello'",
+		"tx.height > 8 AND transfer.sender IN ('addr0', 'addr2')",
+		fmt.Sprintf("tx.hash = '%X'", types.Tx("tx4").Hash()),
+	}
+	for _, q := range queries {
+		q := q
+		t.Run(q, func(t *testing.T) {
+			results, err := txIndexer.Search(ctx, query.MustCompile(q))
+			require.NoError(t, err)
+			want := make([]string, 0, len(results))
+			for _, txr := range results {
+				want = append(want, fmt.Sprintf("%s@%d", txr.Tx, txr.Height))
+			}
+
+			page, err := txIndexer.SearchPage(ctx, query.MustCompile(q), nil, 100, false)
+			require.NoError(t, err)
+			got := make([]string, 0, len(page))
+			for _, txr := range page {
+				got = append(got, fmt.Sprintf("%s@%d", txr.Tx, txr.Height))
+			}
+			assert.ElementsMatch(t, want, got)
+		})
+	}
+
+	// A transaction indexed again at another position is only found there.
+	moved := txResultWithEvents(nil)
+	moved.Tx = types.Tx("tx1")
+	moved.Height = 11
+	require.NoError(t, txIndexer.Index(moved))
+	page, err := txIndexer.SearchPage(ctx, query.MustCompile("tx.height < 2 OR tx.height > 10"), nil, 100, false)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.EqualValues(t, 11, page[0].Height)
+}
+
+func TestTxIndexEventFilter(t *testing.T) {
+	filter, err := indexer.NewEventFilter([]string{"transfer"}, []string{"*.amount"})
+	require.NoError(t, err)
+	txIndexer := NewTxIndex(db.NewMemDB(), WithEventFilter(filter))
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "a", Index: true},
+			{Key: "amount", Value: "1", Index: true},
+		}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "send", Index: true}}},
+	})
+	require.NoError(t, txIndexer.Index(txResult))
+
+	loadedTxResult, err := txIndexer.Get(types.Tx(txResult.Tx).Hash())
+	require.NoError(t, err)
+	require.True(t, proto.Equal(txResult, loadedTxResult))
+
+	testCases := map[string]int{
+		"transfer.sender = 'a'":   1,
+		"tx.height = 1":           1,
+		"transfer.amount = '1'":   0,
+		"message.action = 'send'": 0,
+	}
+	for q, want := range testCases {
+		results, err := txIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		assert.Len(t, results, want, q)
+	}
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
@@ -28,6 +28,10 @@ func (txi *TxIndex) Prune(_ int64) (int64, int64, error) {
 	return 0, 0, nil
 }
 
+func (txi *TxIndex) DeleteHeightsAbove(_ int64) error {
+	return nil
+}
+
 // Get on a TxIndex is disabled and panics when invoked.
 func (txi *TxIndex) Get(_ []byte) (*abci.TxResult, error) {
 	return nil, errors.New(`indexing is disabled (set 'tx_index = "kv"' in config)`)
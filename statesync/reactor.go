@@ -112,7 +112,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 	err := validateMsg(e.Message)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", e.Src, "msg", e.Message, "err", err)
-		r.Switch.StopPeerForError(e.Src, err)
+		r.Switch.ReportPeerBehaviour(e.Src, p2p.BadMessage(err))
 		return
 	}
 
@@ -0,0 +1,561 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+A block archive holds the blocks of a range of heights, with the data of the
+state store needed to verify and restore them, in a format independent from
+the database backend.
+
+The archive starts with the ArchiveMagic bytes, followed by frames. Each frame
+is made of the big-endian uint32 length of its payload, the payload, and the
+SHA-256 checksum of the payload. The payload of the first frame is an
+ArchiveHeader; the payloads of the following ones are ArchiveChunks, holding
+the consecutive heights of the archive.
+*/
+
+const (
+	// ArchiveVersion is the version of the block archive format.
+	ArchiveVersion = 1
+
+	// DefaultArchiveChunkSize is the default size, in bytes, above which the
+	// chunks of an archive are written out.
+	DefaultArchiveChunkSize = 16 << 20
+
+	// maxArchiveFrameSize is the maximum size of the payload of a frame.
+	maxArchiveFrameSize = 1 << 30
+)
+
+// ArchiveMagic starts every block archive.
+var ArchiveMagic = []byte("CMTBLKAR")
+
+// ErrArchiveCorrupted is returned when reading an archive whose content does
+// not match its checksums.
+var ErrArchiveCorrupted = errors.New("block archive is corrupted")
+
+// ExportBlocks writes to w an archive of the blocks of bs between the heights
+// from and to (inclusive), along with their validators, consensus params and
+// FinalizeBlock responses found in ss. The chunks of the archive are written
+// out once they reach chunkSize bytes.
+func ExportBlocks(
+	w io.Writer,
+	bs *BlockStore,
+	ss sm.Store,
+	chainID string,
+	from, to int64,
+	chunkSize int,
+) error {
+	if from < bs.Base() || to > bs.Height() || from > to {
+		return fmt.Errorf("heights [%d, %d] are not within the stored blocks [%d, %d]",
+			from, to, bs.Base(), bs.Height())
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(ArchiveMagic); err != nil {
+		return err
+	}
+	nextVals, err := ss.LoadValidators(to + 1)
+	if err != nil {
+		return fmt.Errorf("loading validators of height %d: %w", to+1, err)
+	}
+	pbNextVals, err := nextVals.ToProto()
+	if err != nil {
+		return err
+	}
+	header := &cmtstore.ArchiveHeader{
+		Version:        ArchiveVersion,
+		ChainId:        chainID,
+		StartHeight:    from,
+		EndHeight:      to,
+		NextValidators: pbNextVals,
+	}
+	if err := writeArchiveFrame(bw, header); err != nil {
+		return err
+	}
+
+	chunk := &cmtstore.ArchiveChunk{}
+	size := 0
+	for height := from; height <= to; height++ {
+		block, err := loadArchiveBlock(bs, ss, height)
+		if err != nil {
+			return err
+		}
+		chunk.Blocks = append(chunk.Blocks, block)
+		size += block.Size()
+		if size >= chunkSize || height == to {
+			if err := writeArchiveFrame(bw, chunk); err != nil {
+				return err
+			}
+			chunk = &cmtstore.ArchiveChunk{}
+			size = 0
+		}
+	}
+	return bw.Flush()
+}
+
+// loadArchiveBlock loads from the stores the data to archive for a height.
+func loadArchiveBlock(bs *BlockStore, ss sm.Store, height int64) (*cmtstore.ArchiveBlock, error) {
+	meta := bs.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	block := &cmtstore.ArchiveBlock{Height: height}
+	for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+		part := bs.LoadBlockPart(height, i)
+		if part == nil {
+			return nil, fmt.Errorf("part %d of block %d not found", i, height)
+		}
+		pbp, err := part.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		block.Parts = append(block.Parts, pbp)
+	}
+
+	// The seen commit of a height is replaced by its extended commit if vote
+	// extensions were enabled, and may have been deleted once the commit was
+	// included in the next block.
+	if ec := bs.LoadBlockExtendedCommit(height); ec != nil {
+		block.ExtendedCommit = ec.ToProto()
+	} else if commit := bs.LoadSeenCommit(height); commit != nil {
+		block.SeenCommit = commit.ToProto()
+	} else if commit := bs.LoadBlockCommit(height); commit != nil {
+		block.SeenCommit = commit.ToProto()
+	} else {
+		return nil, fmt.Errorf("commit of block %d not found", height)
+	}
+
+	vals, err := ss.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("loading validators of height %d: %w", height, err)
+	}
+	if block.Validators, err = vals.ToProto(); err != nil {
+		return nil, err
+	}
+	params, err := ss.LoadConsensusParams(height)
+	if err != nil {
+		return nil, fmt.Errorf("loading consensus params of height %d: %w", height, err)
+	}
+	pbParams := params.ToProto()
+	block.ConsensusParams = &pbParams
+
+	resp, err := ss.LoadFinalizeBlockResponse(height)
+	switch {
+	case err == nil:
+		block.FinalizeBlockResponse = resp
+	case errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted),
+		errors.As(err, &sm.ErrNoABCIResponsesForHeight{}):
+	default:
+		return nil, fmt.Errorf("loading FinalizeBlock response of height %d: %w", height, err)
+	}
+	return block, nil
+}
+
+// ImportBlocks reads from r an archive written by ExportBlocks and saves its
+// blocks to bs, and their validators, consensus params and FinalizeBlock
+// responses to ss. state is the latest state of the node: the blocks of the
+// archive must follow the ones of bs, if any, or else the last height of
+// state, which is the genesis state of a new node. It returns the range of
+// imported heights.
+//
+// Each block is verified against its commit, signed by its validators, and
+// against the previous block. The validators of the first block must be the
+// next validators of the last stored block, or the validators of state if bs
+// is empty; the validators of each following block must be the next
+// validators of the previous one. Since the FinalizeBlock response of a
+// height is only committed by the next block, the response of the last height
+// of the archive is not imported.
+//
+// The state of the node is restored as blocks are imported, so that the block
+// store is at most one height ahead of it, as after a crash: the node replays
+// the last block on start. To that end, a block is only saved once the
+// validators of the next height are known, so if an error occurs, the heights
+// imported up to that point are kept, except the last verified one.
+func ImportBlocks(r io.Reader, bs *BlockStore, ss sm.Store, state sm.State) (int64, int64, error) {
+	chainID := state.ChainID
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(ArchiveMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, ArchiveMagic) {
+		return 0, 0, errors.New("not a block archive")
+	}
+	header := &cmtstore.ArchiveHeader{}
+	if err := readArchiveFrame(br, header); err != nil {
+		return 0, 0, err
+	}
+	if header.Version != ArchiveVersion {
+		return 0, 0, fmt.Errorf("unsupported block archive version %d", header.Version)
+	}
+	if header.ChainId != chainID {
+		return 0, 0, fmt.Errorf("block archive of chain %q, expected %q", header.ChainId, chainID)
+	}
+	if header.StartHeight <= 0 || header.StartHeight > header.EndHeight {
+		return 0, 0, fmt.Errorf("invalid block archive heights [%d, %d]", header.StartHeight, header.EndHeight)
+	}
+
+	imp := &archiveImporter{bs: bs, ss: ss, state: state, lastHeight: header.StartHeight - 1}
+	if height := bs.Height(); height > 0 {
+		if height < state.LastBlockHeight || height > state.LastBlockHeight+1 {
+			return 0, 0, fmt.Errorf("block store height %d does not match state height %d",
+				height, state.LastBlockHeight)
+		}
+		if header.StartHeight != height+1 {
+			return 0, 0, fmt.Errorf("block archive starts at height %d, expected %d", header.StartHeight, height+1)
+		}
+		meta := bs.LoadBlockMeta(height)
+		imp.lastBlockID = &meta.BlockID
+		imp.nextValidatorsHash = meta.Header.NextValidatorsHash
+		// The response of the last stored height, if any, is verified
+		// against the first block of the archive.
+		if resp, err := ss.LoadFinalizeBlockResponse(height); err == nil {
+			imp.lastResponse = resp
+		}
+	} else {
+		if header.StartHeight != state.LastBlockHeight+1 {
+			return 0, 0, fmt.Errorf("block archive starts at height %d, expected %d",
+				header.StartHeight, state.LastBlockHeight+1)
+		}
+		if state.Validators.IsNilOrEmpty() {
+			return 0, 0, errors.New("no validators to verify the first block of the archive against")
+		}
+		imp.nextValidatorsHash = state.Validators.Hash()
+	}
+
+	next := header.StartHeight
+	for next <= header.EndHeight {
+		chunk := &cmtstore.ArchiveChunk{}
+		if err := readArchiveFrame(br, chunk); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = fmt.Errorf("block archive ends at height %d, expected %d", next-1, header.EndHeight)
+			}
+			return header.StartHeight, imp.lastHeight, err
+		}
+		for _, block := range chunk.Blocks {
+			if block.Height != next {
+				return header.StartHeight, imp.lastHeight, fmt.Errorf(
+					"unexpected height %d in block archive, expected %d", block.Height, next)
+			}
+			if err := imp.importBlock(block); err != nil {
+				return header.StartHeight, imp.lastHeight, fmt.Errorf("importing block %d: %w", next, err)
+			}
+			next++
+		}
+	}
+
+	var nextVals *types.ValidatorSet
+	if header.NextValidators != nil {
+		vals, err := types.ValidatorSetFromProto(header.NextValidators)
+		if err != nil {
+			return header.StartHeight, imp.lastHeight, err
+		}
+		if !bytes.Equal(vals.Hash(), imp.nextValidatorsHash) {
+			return header.StartHeight, imp.lastHeight, fmt.Errorf(
+				"validators %X of height %d are not the expected ones %X",
+				vals.Hash(), header.EndHeight+1, imp.nextValidatorsHash)
+		}
+		nextVals = vals
+	}
+	if err := imp.flush(nextVals, false); err != nil {
+		return header.StartHeight, imp.lastHeight, err
+	}
+	return header.StartHeight, header.EndHeight, nil
+}
+
+// archiveImporter verifies and saves the blocks of an archive, one by one.
+type archiveImporter struct {
+	bs *BlockStore
+	ss sm.Store
+	// state is the latest saved state.
+	state sm.State
+	// lastHeight is the height of the last saved block.
+	lastHeight int64
+
+	// lastBlockID is the ID of the last verified block, if any, and
+	// lastResponse its FinalizeBlock response, if known.
+	lastBlockID  *types.BlockID
+	lastResponse *abci.ResponseFinalizeBlock
+	// nextValidatorsHash is the hash of the validators of the next block.
+	nextValidatorsHash []byte
+	// pending is the last verified block, which is saved once the validators
+	// of the next height are known, along with the state of the height before
+	// it.
+	pending *verifiedBlock
+}
+
+// verifiedBlock is a block of an archive, verified but not saved yet.
+type verifiedBlock struct {
+	archived *cmtstore.ArchiveBlock
+	block    *types.Block
+	parts    *types.PartSet
+	commit   *types.Commit
+	ec       *types.ExtendedCommit // nil if vote extensions were disabled
+	vals     *types.ValidatorSet
+	params   types.ConsensusParams
+}
+
+func (imp *archiveImporter) importBlock(ab *cmtstore.ArchiveBlock) error {
+	var (
+		commit *types.Commit
+		ec     *types.ExtendedCommit
+		err    error
+	)
+	switch {
+	case ab.ExtendedCommit != nil:
+		if ec, err = types.ExtendedCommitFromProto(ab.ExtendedCommit); err != nil {
+			return err
+		}
+		if err := ec.EnsureExtensions(true); err != nil {
+			return err
+		}
+		commit = ec.ToCommit()
+	case ab.SeenCommit != nil:
+		if commit, err = types.CommitFromProto(ab.SeenCommit); err != nil {
+			return err
+		}
+	default:
+		return errors.New("missing commit")
+	}
+	if ab.Validators == nil || ab.ConsensusParams == nil {
+		return errors.New("missing validators or consensus params")
+	}
+	vals, err := types.ValidatorSetFromProto(ab.Validators)
+	if err != nil {
+		return err
+	}
+	params := types.ConsensusParamsFromProto(*ab.ConsensusParams)
+
+	// Rebuild the block from its parts, which are verified against the part
+	// set header of the commit.
+	parts := types.NewPartSetFromHeader(commit.BlockID.PartSetHeader)
+	for _, pbp := range ab.Parts {
+		part, err := types.PartFromProto(pbp)
+		if err != nil {
+			return err
+		}
+		if _, err := parts.AddPart(part); err != nil {
+			return err
+		}
+	}
+	if !parts.IsComplete() {
+		return errors.New("incomplete block parts")
+	}
+	bz, err := io.ReadAll(parts.GetReader())
+	if err != nil {
+		return err
+	}
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return err
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		return err
+	}
+
+	if err := imp.verify(ab.Height, block, commit, vals, params); err != nil {
+		return err
+	}
+	// The validators of the block are the next ones of the pending block.
+	if err := imp.flush(vals, true); err != nil {
+		return err
+	}
+
+	imp.lastBlockID = &commit.BlockID
+	imp.lastResponse = ab.FinalizeBlockResponse
+	imp.nextValidatorsHash = block.NextValidatorsHash
+	imp.pending = &verifiedBlock{
+		archived: ab,
+		block:    block,
+		parts:    parts,
+		commit:   commit,
+		ec:       ec,
+		vals:     vals,
+		params:   params,
+	}
+	return nil
+}
+
+// verify checks that the block is the one committed at the given height by
+// its validators, and that it follows the last verified block, whose next
+// validators they must be.
+func (imp *archiveImporter) verify(
+	height int64,
+	block *types.Block,
+	commit *types.Commit,
+	vals *types.ValidatorSet,
+	params types.ConsensusParams,
+) error {
+	if err := block.ValidateBasic(); err != nil {
+		return err
+	}
+	switch {
+	case block.Height != height:
+		return fmt.Errorf("block height %d does not match archive height", block.Height)
+	case block.ChainID != imp.state.ChainID:
+		return fmt.Errorf("block of chain %q", block.ChainID)
+	case !bytes.Equal(block.Hash(), commit.BlockID.Hash):
+		return errors.New("block hash does not match the commit")
+	case !bytes.Equal(block.ValidatorsHash, vals.Hash()):
+		return errors.New("validators do not match the block header")
+	case !bytes.Equal(block.ConsensusHash, params.Hash()):
+		return errors.New("consensus params do not match the block header")
+	case imp.lastBlockID != nil && !block.LastBlockID.Equals(*imp.lastBlockID):
+		return errors.New("block does not follow the previous block")
+	case !bytes.Equal(vals.Hash(), imp.nextValidatorsHash):
+		return fmt.Errorf("validators %X are not the expected ones %X", vals.Hash(), imp.nextValidatorsHash)
+	}
+	if err := vals.VerifyCommit(imp.state.ChainID, commit.BlockID, height, commit); err != nil {
+		return fmt.Errorf("verifying commit: %w", err)
+	}
+
+	if resp := imp.lastResponse; resp != nil {
+		if !bytes.Equal(block.AppHash, resp.AppHash) ||
+			!bytes.Equal(block.LastResultsHash, sm.TxResultsHash(resp.TxResults)) {
+			return fmt.Errorf("FinalizeBlock response of height %d does not match the block header",
+				height-1)
+		}
+	}
+	return nil
+}
+
+// flush saves the pending block with its state data, given the validators of
+// the next height. Its FinalizeBlock response is only saved if verified
+// against the next block. The state of the height before the block is saved
+// first, so that the block store is at most one height ahead of the state.
+func (imp *archiveImporter) flush(nextVals *types.ValidatorSet, responseVerified bool) error {
+	p := imp.pending
+	if p == nil {
+		return nil
+	}
+	imp.pending = nil
+
+	height := p.block.Height
+	var resp *abci.ResponseFinalizeBlock
+	if responseVerified {
+		resp = p.archived.FinalizeBlockResponse
+	}
+	if err := imp.ss.RestoreHeight(height, p.vals, p.params, resp); err != nil {
+		return err
+	}
+	if height-1 > imp.state.LastBlockHeight {
+		if nextVals == nil {
+			return fmt.Errorf("missing validators of height %d", height+1)
+		}
+		state, err := imp.stateBefore(p, nextVals)
+		if err != nil {
+			return err
+		}
+		if err := imp.ss.Save(state); err != nil {
+			return err
+		}
+		imp.state = state
+	}
+
+	if p.ec != nil {
+		imp.bs.SaveBlockWithExtendedCommit(p.block, p.parts, p.ec)
+	} else {
+		imp.bs.SaveBlock(p.block, p.parts, p.commit)
+	}
+	imp.lastHeight = height
+	return nil
+}
+
+// stateBefore returns the state of the height before the block, as saved
+// once the previous block is committed, given the validators of the height
+// after the block.
+func (imp *archiveImporter) stateBefore(p *verifiedBlock, nextVals *types.ValidatorSet) (sm.State, error) {
+	height := p.block.Height - 1
+	meta := imp.bs.LoadBlockMeta(height)
+	if meta == nil {
+		return sm.State{}, fmt.Errorf("block %d not found", height)
+	}
+	lastVals, err := imp.ss.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, fmt.Errorf("loading validators of height %d: %w", height, err)
+	}
+
+	version := imp.state.Version
+	version.Consensus = p.block.Version
+	return sm.State{
+		Version:       version,
+		ChainID:       imp.state.ChainID,
+		InitialHeight: imp.state.InitialHeight,
+
+		LastBlockHeight: height,
+		LastBlockID:     p.block.LastBlockID,
+		LastBlockTime:   meta.Header.Time,
+
+		NextValidators: nextVals,
+		Validators:     p.vals,
+		LastValidators: lastVals,
+		// The validators and consensus params are saved in full at these
+		// heights, whether they changed or not.
+		LastHeightValidatorsChanged: height + 2,
+
+		ConsensusParams:                  p.params,
+		LastHeightConsensusParamsChanged: height + 1,
+
+		LastResultsHash: p.block.LastResultsHash,
+		AppHash:         p.block.AppHash,
+	}, nil
+}
+
+func writeArchiveFrame(w io.Writer, msg proto.Message) error {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if len(bz) > maxArchiveFrameSize {
+		return fmt.Errorf("block archive frame of %d bytes exceeds the maximum of %d bytes",
+			len(bz), maxArchiveFrameSize)
+	}
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(bz)))
+	checksum := sha256.Sum256(bz)
+	for _, b := range [][]byte{length[:], bz, checksum[:]} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readArchiveFrame reads the next frame of an archive into msg. It returns
+// io.EOF if there are no more frames.
+func readArchiveFrame(r io.Reader, msg proto.Message) error {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrArchiveCorrupted
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > maxArchiveFrameSize {
+		return ErrArchiveCorrupted
+	}
+	bz := make([]byte, size+sha256.Size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return ErrArchiveCorrupted
+	}
+	checksum := sha256.Sum256(bz[:size])
+	if !bytes.Equal(checksum[:], bz[size:]) {
+		return ErrArchiveCorrupted
+	}
+	return proto.Unmarshal(bz[:size], msg)
+}
@@ -0,0 +1,258 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const archiveChainID = "archive-chain"
+
+// makeArchiveChain returns stores holding a chain of the given number of
+// blocks, with their validators, consensus params, FinalizeBlock responses
+// and latest state, along with the genesis state of the chain.
+func makeArchiveChain(t *testing.T, numBlocks int64) (*BlockStore, sm.Store, sm.State) {
+	t.Helper()
+	vals, privVals := test.ValidatorSet(context.Background(), t, 2, 10)
+	genDoc := test.GenesisDoc(time.Now(), vals.Validators, test.ConsensusParams(), archiveChainID)
+	genState, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	bs := NewBlockStore(dbm.NewMemDB())
+	ss := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	extendArchiveChain(t, bs, ss, genState.Copy(), &types.Commit{}, privVals, numBlocks)
+	return bs, ss, genState
+}
+
+// extendArchiveChain saves to the stores the given number of blocks following
+// the state and its last commit, signed by privVals.
+func extendArchiveChain(
+	t *testing.T,
+	bs *BlockStore,
+	ss sm.Store,
+	state sm.State,
+	lastCommit *types.Commit,
+	privVals []types.PrivValidator,
+	numBlocks int64,
+) {
+	t.Helper()
+	first := state.LastBlockHeight + 1
+	for height := first; height < first+numBlocks; height++ {
+		txs := test.MakeNTxs(height, 3)
+		block := state.MakeBlock(height, txs, lastCommit, nil, state.Validators.Proposer.Address)
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		commit, err := test.MakeCommit(blockID, height, 0, state.Validators, privVals, archiveChainID,
+			block.Time.Add(time.Second))
+		require.NoError(t, err)
+		bs.SaveBlock(block, parts, commit)
+
+		resp := &abci.ResponseFinalizeBlock{AppHash: []byte(fmt.Sprintf("app-%d", height))}
+		for range txs {
+			resp.TxResults = append(resp.TxResults, &abci.ExecTxResult{Code: abci.CodeTypeOK})
+		}
+		require.NoError(t, ss.RestoreHeight(height, state.Validators, state.ConsensusParams, resp))
+
+		lastCommit = commit
+		state.LastBlockHeight = height
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.AppHash = resp.AppHash
+		state.LastResultsHash = sm.TxResultsHash(resp.TxResults)
+		require.NoError(t, ss.Save(state))
+	}
+}
+
+func TestExportImportBlocks(t *testing.T) {
+	bs, ss, genState := makeArchiveChain(t, 10)
+
+	// Small chunks, so that the archive is made of several of them.
+	var archive bytes.Buffer
+	require.NoError(t, ExportBlocks(&archive, bs, ss, archiveChainID, 1, 6, 1000))
+	var next bytes.Buffer
+	require.NoError(t, ExportBlocks(&next, bs, ss, archiveChainID, 7, 10, DefaultArchiveChunkSize))
+
+	toBS := NewBlockStore(dbm.NewMemDB())
+	toSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	from, to, err := ImportBlocks(&archive, toBS, toSS, genState)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, from)
+	assert.EqualValues(t, 6, to)
+
+	// The response of the last height is only imported with the next blocks.
+	_, err = toSS.LoadFinalizeBlockResponse(6)
+	require.Error(t, err)
+
+	// The state is restored up to the height before the last one, which the
+	// node replays on start.
+	state, err := toSS.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, state.LastBlockHeight)
+	from, to, err = ImportBlocks(&next, toBS, toSS, state)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, from)
+	assert.EqualValues(t, 10, to)
+
+	assert.EqualValues(t, 1, toBS.Base())
+	assert.EqualValues(t, 10, toBS.Height())
+	for height := int64(1); height <= 10; height++ {
+		assert.Equal(t, bs.LoadBlock(height).Hash(), toBS.LoadBlock(height).Hash())
+		assert.Equal(t, bs.LoadSeenCommit(height), toBS.LoadSeenCommit(height))
+
+		vals, err := toSS.LoadValidators(height)
+		require.NoError(t, err)
+		expVals, err := ss.LoadValidators(height)
+		require.NoError(t, err)
+		assert.Equal(t, expVals.Hash(), vals.Hash())
+
+		if height != 6 && height != 10 {
+			resp, err := toSS.LoadFinalizeBlockResponse(height)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("app-%d", height)), resp.AppHash)
+		}
+	}
+}
+
+func TestImportBlocksInvalid(t *testing.T) {
+	bs, ss, genState := makeArchiveChain(t, 4)
+
+	var archive bytes.Buffer
+	require.NoError(t, ExportBlocks(&archive, bs, ss, archiveChainID, 1, 4, DefaultArchiveChunkSize))
+	valid := archive.Bytes()
+
+	testCases := map[string]struct {
+		archive []byte
+		chainID string
+		setup   func(bs *BlockStore)
+		err     string
+	}{
+		"wrong chain": {
+			archive: valid,
+			chainID: "other-chain",
+			err:     "block archive of chain",
+		},
+		"not an archive": {
+			archive: []byte("not an archive"),
+			chainID: archiveChainID,
+			err:     "not a block archive",
+		},
+		"corrupted": {
+			archive: func() []byte {
+				bz := bytes.Clone(valid)
+				bz[len(bz)-100] ^= 0xff
+				return bz
+			}(),
+			chainID: archiveChainID,
+			err:     ErrArchiveCorrupted.Error(),
+		},
+		"truncated": {
+			archive: valid[:len(valid)-10],
+			chainID: archiveChainID,
+			err:     ErrArchiveCorrupted.Error(),
+		},
+		"not following the store": {
+			archive: valid,
+			chainID: archiveChainID,
+			setup: func(toBS *BlockStore) {
+				block := bs.LoadBlock(1)
+				parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+				require.NoError(t, err)
+				toBS.SaveBlock(block, parts, bs.LoadSeenCommit(1))
+			},
+			err: "block archive starts at height 1, expected 2",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			toBS := NewBlockStore(dbm.NewMemDB())
+			toSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+			if tc.setup != nil {
+				tc.setup(toBS)
+			}
+			state := genState.Copy()
+			state.ChainID = tc.chainID
+			_, _, err := ImportBlocks(bytes.NewReader(tc.archive), toBS, toSS, state)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestImportBlocksForged(t *testing.T) {
+	bs, ss, genState := makeArchiveChain(t, 3)
+	forgedBS, forgedSS, _ := makeArchiveChain(t, 3)
+
+	// Blocks of another chain with the same ID, signed by other validators,
+	// do not follow the imported ones.
+	var archive, forged bytes.Buffer
+	require.NoError(t, ExportBlocks(&archive, bs, ss, archiveChainID, 1, 2, DefaultArchiveChunkSize))
+	require.NoError(t, ExportBlocks(&forged, forgedBS, forgedSS, archiveChainID, 3, 3, DefaultArchiveChunkSize))
+
+	toBS := NewBlockStore(dbm.NewMemDB())
+	toSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	_, _, err := ImportBlocks(&archive, toBS, toSS, genState)
+	require.NoError(t, err)
+	state, err := toSS.Load()
+	require.NoError(t, err)
+	_, _, err = ImportBlocks(&forged, toBS, toSS, state)
+	require.ErrorContains(t, err, "does not follow the previous block")
+	assert.EqualValues(t, 2, toBS.Height())
+}
+
+func TestImportBlocksForgedValidators(t *testing.T) {
+	bs, ss, genState := makeArchiveChain(t, 2)
+
+	// A block following the chain, committed by other validators than the
+	// next validators of the previous block.
+	state, err := ss.Load()
+	require.NoError(t, err)
+	forgedVals, forgedPrivVals := test.ValidatorSet(context.Background(), t, 2, 10)
+	state.Validators, state.NextValidators = forgedVals, forgedVals.Copy()
+	state.LastHeightValidatorsChanged = state.LastBlockHeight + 2
+	forgedBS := NewBlockStore(dbm.NewMemDB())
+	forgedSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, forgedSS.Save(state))
+	extendArchiveChain(t, forgedBS, forgedSS, state, bs.LoadSeenCommit(2), forgedPrivVals, 1)
+
+	var archive, forged bytes.Buffer
+	require.NoError(t, ExportBlocks(&archive, bs, ss, archiveChainID, 1, 2, DefaultArchiveChunkSize))
+	require.NoError(t, ExportBlocks(&forged, forgedBS, forgedSS, archiveChainID, 3, 3, DefaultArchiveChunkSize))
+
+	toBS := NewBlockStore(dbm.NewMemDB())
+	toSS := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	_, _, err = ImportBlocks(&archive, toBS, toSS, genState)
+	require.NoError(t, err)
+	state, err = toSS.Load()
+	require.NoError(t, err)
+	_, _, err = ImportBlocks(&forged, toBS, toSS, state)
+	require.ErrorContains(t, err, "are not the expected ones")
+	assert.EqualValues(t, 2, toBS.Height())
+
+	// A chain with the same ID, committed by other validators than the ones
+	// of the genesis state.
+	forgedBS, forgedSS, _ = makeArchiveChain(t, 2)
+	forged.Reset()
+	require.NoError(t, ExportBlocks(&forged, forgedBS, forgedSS, archiveChainID, 1, 2, DefaultArchiveChunkSize))
+
+	toBS = NewBlockStore(dbm.NewMemDB())
+	toSS = sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	_, _, err = ImportBlocks(&forged, toBS, toSS, genState)
+	require.ErrorContains(t, err, "are not the expected ones")
+	assert.EqualValues(t, 0, toBS.Height())
+}
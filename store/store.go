@@ -11,6 +11,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/evidence"
+	"github.com/cometbft/cometbft/libs/compress"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -46,17 +47,34 @@ type BlockStore struct {
 	mtx    cmtsync.RWMutex
 	base   int64
 	height int64
+
+	// compression is the codec that new block parts are compressed with.
+	compression compress.Codec
+}
+
+// BlockStoreOption sets an optional parameter on the BlockStore.
+type BlockStoreOption func(*BlockStore)
+
+// WithCompression sets the codec that the block parts are compressed with when
+// saved. Block parts are decompressed on load whatever the codec they were
+// saved with, so the codec can change over the lifetime of the store.
+func WithCompression(codec compress.Codec) BlockStoreOption {
+	return func(bs *BlockStore) { bs.compression = codec }
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
 // initialized to the last height that was committed to the DB.
-func NewBlockStore(db dbm.DB) *BlockStore {
-	bs := LoadBlockStoreState(db)
-	return &BlockStore{
-		base:   bs.Base,
-		height: bs.Height,
+func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
+	bss := LoadBlockStoreState(db)
+	bs := &BlockStore{
+		base:   bss.Base,
+		height: bss.Height,
 		db:     db,
 	}
+	for _, option := range options {
+		option(bs)
+	}
+	return bs
 }
 
 func (bs *BlockStore) IsEmpty() bool {
@@ -166,6 +184,10 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	if len(bz) == 0 {
 		return nil
 	}
+	bz, err = compress.Decompress(bz)
+	if err != nil {
+		panic(fmt.Errorf("decompressing block part failed: %w", err))
+	}
 
 	err = proto.Unmarshal(bz, pbpart)
 	if err != nil {
@@ -509,7 +531,7 @@ func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
 	if err != nil {
 		panic(cmterrors.ErrMsgToProto{MessageName: "Part", Err: err})
 	}
-	partBytes := mustEncode(pbp)
+	partBytes := bs.compression.Compress(mustEncode(pbp))
 	if err := bs.db.Set(calcBlockPartKey(height, index), partBytes); err != nil {
 		panic(err)
 	}
@@ -625,40 +647,71 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 	targetHeight := bs.height
 	bs.mtx.RUnlock()
 
+	return bs.DeleteBlocksAbove(targetHeight - 1)
+}
+
+// DeleteBlocksAbove removes the blocks above the given height, which becomes
+// the height of the store.
+func (bs *BlockStore) DeleteBlocksAbove(height int64) error {
+	bs.mtx.RLock()
+	base, targetHeight := bs.base, bs.height
+	bs.mtx.RUnlock()
+	if height < base-1 {
+		return fmt.Errorf("cannot delete blocks above height %v, it is lower than base height %v", height, base)
+	}
+
 	batch := bs.db.NewBatch()
 	defer batch.Close()
+	flush := func(batch dbm.Batch, height int64) error {
+		// We can't trust batches to be atomic, so update height first to make sure noone
+		// tries to access missing blocks.
+		bs.mtx.Lock()
+		bs.height = height
+		bs.mtx.Unlock()
+		bs.saveState()
 
-	// delete what we can, skipping what's already missing, to ensure partial
-	// blocks get deleted fully.
-	if meta := bs.LoadBlockMeta(targetHeight); meta != nil {
-		if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+		if err := batch.WriteSync(); err != nil {
+			return fmt.Errorf("failed to delete blocks above height %v: %w", height, err)
+		}
+		return nil
+	}
+
+	for h := targetHeight; h > height; h-- {
+		// delete what we can, skipping what's already missing, to ensure partial
+		// blocks get deleted fully.
+		if meta := bs.LoadBlockMeta(h); meta != nil {
+			if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+				return err
+			}
+			for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+				if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := batch.Delete(calcBlockCommitKey(h)); err != nil {
 			return err
 		}
-		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
-			if err := batch.Delete(calcBlockPartKey(targetHeight, p)); err != nil {
+		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
+			return err
+		}
+		if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			return err
+		}
+		// delete last, so as to not leave keys built on meta.BlockID dangling
+		if err := batch.Delete(calcBlockMetaKey(h)); err != nil {
+			return err
+		}
+
+		// flush every 1000 blocks to avoid batches becoming too large
+		if deleted := targetHeight - h + 1; deleted%1000 == 0 && h-1 > height {
+			if err := flush(batch, h-1); err != nil {
 				return err
 			}
+			batch = bs.db.NewBatch()
+			defer batch.Close()
 		}
 	}
-	if err := batch.Delete(calcBlockCommitKey(targetHeight)); err != nil {
-		return err
-	}
-	if err := batch.Delete(calcSeenCommitKey(targetHeight)); err != nil {
-		return err
-	}
-	// delete last, so as to not leave keys built on meta.BlockID dangling
-	if err := batch.Delete(calcBlockMetaKey(targetHeight)); err != nil {
-		return err
-	}
-
-	bs.mtx.Lock()
-	bs.height = targetHeight - 1
-	bs.mtx.Unlock()
-	bs.saveState()
 
-	err := batch.WriteSync()
-	if err != nil {
-		return fmt.Errorf("failed to delete height %v: %w", targetHeight, err)
-	}
-	return nil
+	return flush(batch, height)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/compress"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
@@ -486,6 +487,92 @@ func TestLoadBaseMeta(t *testing.T) {
 	require.EqualValues(t, 9, bs.Height())
 }
 
+func TestDeleteBlocksAbove(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db)
+
+	for h := int64(1); h <= 10; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(2)
+		require.NoError(t, err)
+		seenCommit := makeTestExtCommit(h, cmttime.Now())
+		bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+	}
+	_, _, err = bs.PruneBlocks(4, state)
+	require.NoError(t, err)
+
+	require.Error(t, bs.DeleteBlocksAbove(2))
+
+	deleted := bs.LoadBlockMeta(7)
+	require.NoError(t, bs.DeleteBlocksAbove(6))
+	assert.EqualValues(t, 4, bs.Base())
+	assert.EqualValues(t, 6, bs.Height())
+	for h := int64(7); h <= 10; h++ {
+		assert.Nil(t, bs.LoadBlockMeta(h))
+		assert.Nil(t, bs.LoadBlock(h))
+		assert.Nil(t, bs.LoadSeenCommit(h))
+		assert.Nil(t, bs.LoadBlockExtendedCommit(h))
+		assert.Nil(t, bs.LoadBlockPart(h, 0))
+	}
+	assert.Nil(t, bs.LoadBlockByHash(deleted.BlockID.Hash))
+	assert.NotNil(t, bs.LoadBlock(6))
+	assert.NotNil(t, bs.LoadSeenCommit(6))
+
+	// The height of the store is persisted.
+	bs = NewBlockStore(db)
+	assert.EqualValues(t, 6, bs.Height())
+}
+
+func TestBlockStoreCompression(t *testing.T) {
+	config := test.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+
+	// The blocks are saved alternatively with and without compression.
+	blocks := make(map[int64]*types.Block)
+	for h := int64(1); h <= 4; h++ {
+		codec := compress.None
+		if h%2 == 0 {
+			codec = compress.Snappy
+		}
+		bs := NewBlockStore(db, WithCompression(codec))
+		block := state.MakeBlock(h, test.MakeNTxs(h, 10), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		bs.SaveBlockWithExtendedCommit(block, partSet, makeTestExtCommit(h, cmttime.Now()))
+		blocks[h] = block
+
+		bz, err := db.Get(calcBlockPartKey(h, 0))
+		require.NoError(t, err)
+		pb, err := partSet.GetPart(0).ToProto()
+		require.NoError(t, err)
+		if codec == compress.None {
+			assert.Equal(t, mustEncode(pb), bz)
+		} else {
+			assert.Less(t, len(bz), len(mustEncode(pb)))
+		}
+	}
+
+	for _, codec := range []compress.Codec{compress.None, compress.Snappy} {
+		bs := NewBlockStore(db, WithCompression(codec))
+		for h, block := range blocks {
+			assert.Equal(t, block.Hash(), bs.LoadBlock(h).Hash(), "height %d", h)
+		}
+	}
+}
+
 func TestLoadBlockPart(t *testing.T) {
 	config := test.ResetTestRoot("blockchain_reactor_test")
 
@@ -0,0 +1,424 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// InconsistencyKind identifies the check of VerifyData that found an
+// inconsistency.
+type InconsistencyKind string
+
+const (
+	// InconsistencyStoreHeights is found when the heights of the block store
+	// and of the state store do not match.
+	InconsistencyStoreHeights InconsistencyKind = "store_heights"
+	// InconsistencyMissingBlock is found when a block within the base and the
+	// height of the block store cannot be loaded.
+	InconsistencyMissingBlock InconsistencyKind = "missing_block"
+	// InconsistencyInvalidBlock is found when a block is malformed, or does not
+	// match its meta.
+	InconsistencyInvalidBlock InconsistencyKind = "invalid_block"
+	// InconsistencyChainLink is found when a block does not point to the
+	// previous one.
+	InconsistencyChainLink InconsistencyKind = "chain_link"
+	// InconsistencyCommit is found when the commit of a block is missing, or
+	// not signed by its validators.
+	InconsistencyCommit InconsistencyKind = "commit"
+	// InconsistencyValidators is found when the validator sets of the state
+	// store do not match the blocks, or do not follow each other.
+	InconsistencyValidators InconsistencyKind = "validators"
+	// InconsistencyConsensusParams is found when the consensus params of the
+	// state store do not match the blocks.
+	InconsistencyConsensusParams InconsistencyKind = "consensus_params"
+	// InconsistencyResults is found when a FinalizeBlock response is missing,
+	// or does not match the app hash and results hash of the next block.
+	InconsistencyResults InconsistencyKind = "results"
+	// InconsistencyState is found when the latest state does not match the
+	// block store.
+	InconsistencyState InconsistencyKind = "state"
+	// InconsistencyTxIndex is found when a transaction is not indexed, or
+	// indexed at another position.
+	InconsistencyTxIndex InconsistencyKind = "tx_index"
+	// InconsistencyBlockIndex is found when a block is not indexed.
+	InconsistencyBlockIndex InconsistencyKind = "block_index"
+)
+
+// Inconsistency is an inconsistency found by VerifyData.
+type Inconsistency struct {
+	Height      int64             `json:"height"`
+	Kind        InconsistencyKind `json:"kind"`
+	Description string            `json:"description"`
+}
+
+// DataReport is the result of VerifyData.
+type DataReport struct {
+	// Base and Height are the heights of the first and last blocks of the
+	// block store.
+	Base   int64 `json:"base"`
+	Height int64 `json:"height"`
+	// StateHeight is the height of the latest state of the state store.
+	StateHeight int64 `json:"state_height"`
+	// StartHeight and EndHeight are the heights of the blocks verified.
+	StartHeight     int64           `json:"start_height"`
+	EndHeight       int64           `json:"end_height"`
+	Inconsistencies []Inconsistency `json:"inconsistencies"`
+}
+
+// VerifyData checks that the blocks of bs between the heights from and to
+// (inclusive, 0 meaning the base and the height of the block store) are
+// consistent with each other, with the validators, consensus params and
+// FinalizeBlock responses of ss, and with the latest state. If not nil, the
+// indexers are checked to cover these blocks.
+//
+// The inconsistencies found are listed in the returned report; an error is
+// only returned if the verification cannot be carried out.
+func VerifyData(
+	ctx context.Context,
+	bs sm.BlockStore,
+	ss sm.Store,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	from, to int64,
+) (*DataReport, error) {
+	state, err := ss.Load()
+	if err != nil {
+		return nil, err
+	}
+	v := &dataVerifier{
+		bs:           bs,
+		ss:           ss,
+		state:        state,
+		txIndexer:    txIndexer,
+		blockIndexer: blockIndexer,
+		report: &DataReport{
+			Base:            bs.Base(),
+			Height:          bs.Height(),
+			StateHeight:     state.LastBlockHeight,
+			Inconsistencies: []Inconsistency{},
+		},
+	}
+	v.verifyStoreHeights()
+	if v.report.Height == 0 {
+		return v.report, nil
+	}
+
+	if from == 0 {
+		from = v.report.Base
+	}
+	if to == 0 {
+		to = v.report.Height
+	}
+	if from < v.report.Base || to > v.report.Height || from > to {
+		return nil, fmt.Errorf("heights [%d, %d] are not within the stored blocks [%d, %d]",
+			from, to, v.report.Base, v.report.Height)
+	}
+	v.report.StartHeight, v.report.EndHeight = from, to
+
+	if err := v.loadRetainHeights(); err != nil {
+		return nil, err
+	}
+	if from > v.report.Base {
+		v.prev = bs.LoadBlockMeta(from - 1)
+	}
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		v.verifyHeight(height)
+	}
+	v.verifyState()
+	return v.report, nil
+}
+
+// dataVerifier holds the stores checked by VerifyData, and the report of the
+// inconsistencies found so far.
+type dataVerifier struct {
+	bs           sm.BlockStore
+	ss           sm.Store
+	state        sm.State
+	txIndexer    txindex.TxIndexer
+	blockIndexer indexer.BlockIndexer
+
+	// The heights below which the data may have been pruned.
+	resultsRetainHeight  int64
+	txIndexRetainHeight  int64
+	blkIndexRetainHeight int64
+	// resultsFound is set once a FinalizeBlock response is loaded.
+	resultsFound bool
+
+	// prev is the meta of the previous block, or nil if it is not verified.
+	prev *types.BlockMeta
+
+	report *DataReport
+}
+
+func (v *dataVerifier) add(height int64, kind InconsistencyKind, format string, args ...interface{}) {
+	v.report.Inconsistencies = append(v.report.Inconsistencies, Inconsistency{
+		Height:      height,
+		Kind:        kind,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *dataVerifier) loadRetainHeights() error {
+	// The retain heights are not stored until pruning is requested.
+	var err error
+	if v.resultsRetainHeight, err = v.ss.GetABCIResRetainHeight(); err != nil && !errors.Is(err, sm.ErrKeyNotFound) {
+		return err
+	}
+	if v.txIndexer != nil {
+		if v.txIndexRetainHeight, err = v.txIndexer.GetRetainHeight(); err != nil && !errors.Is(err, sm.ErrKeyNotFound) {
+			return err
+		}
+	}
+	if v.blockIndexer != nil {
+		if v.blkIndexRetainHeight, err = v.blockIndexer.GetRetainHeight(); err != nil &&
+			!errors.Is(err, sm.ErrKeyNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyStoreHeights checks that the block store holds the block of the
+// latest state, and at most the next one, which is replayed on startup.
+func (v *dataVerifier) verifyStoreHeights() {
+	height, stateHeight := v.report.Height, v.report.StateHeight
+	switch {
+	case v.state.IsEmpty() && height > 0:
+		v.add(height, InconsistencyStoreHeights,
+			"the state store is empty, while the block store holds blocks up to height %d", height)
+	case height < stateHeight:
+		v.add(height, InconsistencyStoreHeights,
+			"the block store height %d is below the state height %d", height, stateHeight)
+	case height > stateHeight+1:
+		v.add(height, InconsistencyStoreHeights,
+			"the block store height %d is more than one block ahead of the state height %d", height, stateHeight)
+	}
+}
+
+// verifyHeight checks the block of the given height against its commit, the
+// previous block and the state store, and checks that it is indexed.
+func (v *dataVerifier) verifyHeight(height int64) {
+	meta := v.bs.LoadBlockMeta(height)
+	block := v.bs.LoadBlock(height)
+	if meta == nil || block == nil {
+		v.add(height, InconsistencyMissingBlock, "block %d cannot be loaded", height)
+		v.prev = nil
+		return
+	}
+	prev := v.prev
+	v.prev = meta
+
+	if err := block.ValidateBasic(); err != nil {
+		v.add(height, InconsistencyInvalidBlock, "invalid block: %v", err)
+	}
+	if block.Height != height {
+		v.add(height, InconsistencyInvalidBlock, "block is stored at height %d, but has height %d", height, block.Height)
+	}
+	if hash := block.Hash(); !bytes.Equal(hash, meta.BlockID.Hash) {
+		v.add(height, InconsistencyInvalidBlock, "block hash %X differs from the hash %X of its meta",
+			hash, meta.BlockID.Hash)
+	}
+	if !v.state.IsEmpty() && block.ChainID != v.state.ChainID {
+		v.add(height, InconsistencyInvalidBlock, "block is from chain %q, expected %q", block.ChainID, v.state.ChainID)
+	}
+	if prev != nil {
+		if !block.LastBlockID.Equals(prev.BlockID) {
+			v.add(height, InconsistencyChainLink, "last block ID %v differs from the ID %v of block %d",
+				block.LastBlockID, prev.BlockID, height-1)
+		}
+		if !bytes.Equal(block.ValidatorsHash, prev.Header.NextValidatorsHash) {
+			v.add(height, InconsistencyValidators, "validators hash %X differs from the next validators hash %X of block %d",
+				block.ValidatorsHash, prev.Header.NextValidatorsHash, height-1)
+		}
+	}
+
+	// The commit of the last block is only stored as the seen commit.
+	commit := v.bs.LoadBlockCommit(height)
+	if height == v.report.Height {
+		commit = v.bs.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		v.add(height, InconsistencyCommit, "no commit found for block %d", height)
+	}
+
+	// The state store holds the data of the heights up to the state height,
+	// and of the next one.
+	if v.state.IsEmpty() || height > v.state.LastBlockHeight+1 {
+		return
+	}
+	v.verifyValidators(block, meta.BlockID, commit)
+	if params, err := v.ss.LoadConsensusParams(height); err != nil {
+		v.add(height, InconsistencyConsensusParams, "loading consensus params: %v", err)
+	} else if hash := params.Hash(); !bytes.Equal(hash, block.ConsensusHash) {
+		v.add(height, InconsistencyConsensusParams, "consensus params hash %X differs from the consensus hash %X of the block",
+			hash, block.ConsensusHash)
+	}
+	if prev != nil {
+		v.verifyResults(block)
+	}
+
+	// The indexers are only guaranteed to cover the heights of the state.
+	if height <= v.state.LastBlockHeight {
+		v.verifyIndexes(block)
+	}
+}
+
+// verifyValidators checks the validators of the block, and of the next one,
+// against the block, and verifies the commit of the block.
+func (v *dataVerifier) verifyValidators(block *types.Block, blockID types.BlockID, commit *types.Commit) {
+	height := block.Height
+	vals, err := v.ss.LoadValidators(height)
+	switch {
+	case err != nil:
+		v.add(height, InconsistencyValidators, "loading validators: %v", err)
+	case !bytes.Equal(vals.Hash(), block.ValidatorsHash):
+		v.add(height, InconsistencyValidators, "validators hash %X differs from the validators hash %X of the block",
+			vals.Hash(), block.ValidatorsHash)
+	case commit != nil:
+		if err := vals.VerifyCommit(block.ChainID, blockID, height, commit); err != nil {
+			v.add(height, InconsistencyCommit, "verifying commit: %v", err)
+		}
+	}
+
+	nextVals, err := v.ss.LoadValidators(height + 1)
+	switch {
+	case err != nil:
+		v.add(height, InconsistencyValidators, "loading next validators: %v", err)
+	case !bytes.Equal(nextVals.Hash(), block.NextValidatorsHash):
+		v.add(height, InconsistencyValidators, "next validators hash %X differs from the next validators hash %X of the block",
+			nextVals.Hash(), block.NextValidatorsHash)
+	}
+}
+
+// verifyResults checks the FinalizeBlock response of the previous height
+// against the app hash and results hash of the block.
+func (v *dataVerifier) verifyResults(block *types.Block) {
+	height := block.Height - 1
+	resp, err := v.loadResponse(height)
+	if err != nil {
+		v.add(height, InconsistencyResults, "loading FinalizeBlock response: %v", err)
+		return
+	}
+	if resp == nil {
+		return
+	}
+	if !bytes.Equal(resp.AppHash, block.AppHash) {
+		v.add(height, InconsistencyResults, "app hash %X differs from the app hash %X of block %d",
+			resp.AppHash, block.AppHash, block.Height)
+	}
+	if hash := sm.TxResultsHash(resp.TxResults); !bytes.Equal(hash, block.LastResultsHash) {
+		v.add(height, InconsistencyResults, "results hash %X differs from the last results hash %X of block %d",
+			hash, block.LastResultsHash, block.Height)
+	}
+}
+
+// loadResponse loads the FinalizeBlock response of the given height, or
+// returns nil if it may not be stored.
+func (v *dataVerifier) loadResponse(height int64) (*abci.ResponseFinalizeBlock, error) {
+	if height < v.resultsRetainHeight {
+		return nil, nil
+	}
+	resp, err := v.ss.LoadFinalizeBlockResponse(height)
+	if errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted) {
+		// Only the response of the state height is stored.
+		if height != v.state.LastBlockHeight {
+			return nil, nil
+		}
+		return v.ss.LoadLastFinalizeBlockResponse(height)
+	}
+	// The retention policy of the pruner may have pruned the responses below
+	// the first one found.
+	if errors.As(err, new(sm.ErrNoABCIResponsesForHeight)) && !v.resultsFound {
+		return nil, nil
+	}
+	if err == nil {
+		v.resultsFound = true
+	}
+	return resp, err
+}
+
+// verifyIndexes checks that the block and its transactions are indexed.
+func (v *dataVerifier) verifyIndexes(block *types.Block) {
+	height := block.Height
+	if v.blockIndexer != nil && height >= v.blkIndexRetainHeight {
+		ok, err := v.blockIndexer.Has(height)
+		switch {
+		case err != nil:
+			v.add(height, InconsistencyBlockIndex, "looking up block: %v", err)
+		case !ok:
+			v.add(height, InconsistencyBlockIndex, "block %d is not indexed", height)
+		}
+	}
+	if v.txIndexer == nil || height < v.txIndexRetainHeight {
+		return
+	}
+	for i, tx := range block.Txs {
+		res, err := v.txIndexer.Get(tx.Hash())
+		switch {
+		case err != nil:
+			v.add(height, InconsistencyTxIndex, "looking up tx %X: %v", tx.Hash(), err)
+		case res == nil:
+			v.add(height, InconsistencyTxIndex, "tx %X (index %d) is not indexed", tx.Hash(), i)
+		case res.Height != height || res.Index != uint32(i):
+			v.add(height, InconsistencyTxIndex, "tx %X (index %d) is indexed at height %d, index %d",
+				tx.Hash(), i, res.Height, res.Index)
+		}
+	}
+}
+
+// verifyState checks the latest state against the block of its height, its
+// FinalizeBlock response and its validators.
+func (v *dataVerifier) verifyState() {
+	height := v.state.LastBlockHeight
+	if v.state.IsEmpty() || height < v.report.Base || height > v.report.Height {
+		return
+	}
+
+	if meta := v.bs.LoadBlockMeta(height); meta != nil {
+		if !meta.BlockID.Equals(v.state.LastBlockID) {
+			v.add(height, InconsistencyState, "last block ID %v differs from the ID %v of the block",
+				v.state.LastBlockID, meta.BlockID)
+		}
+		if !bytes.Equal(v.state.Validators.Hash(), meta.Header.NextValidatorsHash) {
+			v.add(height, InconsistencyState, "validators hash %X differs from the next validators hash %X of the block",
+				v.state.Validators.Hash(), meta.Header.NextValidatorsHash)
+		}
+	}
+
+	resp, err := v.loadResponse(height)
+	switch {
+	case err != nil:
+		v.add(height, InconsistencyState, "loading FinalizeBlock response: %v", err)
+	case resp != nil:
+		if !bytes.Equal(v.state.AppHash, resp.AppHash) {
+			v.add(height, InconsistencyState, "app hash %X differs from the app hash %X of the FinalizeBlock response",
+				v.state.AppHash, resp.AppHash)
+		}
+		if hash := sm.TxResultsHash(resp.TxResults); !bytes.Equal(v.state.LastResultsHash, hash) {
+			v.add(height, InconsistencyState, "last results hash %X differs from the results hash %X of the FinalizeBlock response",
+				v.state.LastResultsHash, hash)
+		}
+	}
+
+	for i, vals := range []*types.ValidatorSet{v.state.LastValidators, v.state.Validators, v.state.NextValidators} {
+		stored, err := v.ss.LoadValidators(height + int64(i))
+		switch {
+		case err != nil:
+			v.add(height, InconsistencyState, "loading validators of height %d: %v", height+int64(i), err)
+		case !bytes.Equal(stored.Hash(), vals.Hash()):
+			v.add(height, InconsistencyState, "validators hash %X of height %d differs from the state's %X",
+				stored.Hash(), height+int64(i), vals.Hash())
+		}
+	}
+}
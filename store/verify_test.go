@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+type verifyChain struct {
+	bs     *BlockStore
+	ss     sm.Store
+	txIdx  *kv.TxIndex
+	blkIdx *blockidxkv.BlockerIndexer
+}
+
+// makeVerifyChain returns the stores of a chain of the given number of
+// blocks, whose blocks and transactions are indexed, except for the heights
+// in skipIndex.
+func makeVerifyChain(t *testing.T, numBlocks int64, skipIndex ...int64) *verifyChain {
+	t.Helper()
+	bs, ss, _ := makeArchiveChain(t, numBlocks)
+	c := &verifyChain{
+		bs:     bs,
+		ss:     ss,
+		txIdx:  kv.NewTxIndex(dbm.NewMemDB()),
+		blkIdx: blockidxkv.New(dbm.NewMemDB()),
+	}
+heights:
+	for height := int64(1); height <= numBlocks; height++ {
+		for _, skip := range skipIndex {
+			if height == skip {
+				continue heights
+			}
+		}
+		require.NoError(t, c.blkIdx.Index(types.EventDataNewBlockEvents{Height: height}))
+		for i, tx := range bs.LoadBlock(height).Txs {
+			require.NoError(t, c.txIdx.Index(&abci.TxResult{Height: height, Index: uint32(i), Tx: tx}))
+		}
+	}
+	return c
+}
+
+func (c *verifyChain) verify(t *testing.T, from, to int64) *DataReport {
+	t.Helper()
+	report, err := VerifyData(context.Background(), c.bs, c.ss, c.txIdx, c.blkIdx, from, to)
+	require.NoError(t, err)
+	return report
+}
+
+func TestVerifyData(t *testing.T) {
+	c := makeVerifyChain(t, 5)
+	report := c.verify(t, 0, 0)
+	assert.Empty(t, report.Inconsistencies)
+	assert.EqualValues(t, 1, report.Base)
+	assert.EqualValues(t, 5, report.Height)
+	assert.EqualValues(t, 5, report.StateHeight)
+	assert.EqualValues(t, 1, report.StartHeight)
+	assert.EqualValues(t, 5, report.EndHeight)
+
+	report = c.verify(t, 2, 3)
+	assert.Empty(t, report.Inconsistencies)
+	assert.EqualValues(t, 2, report.StartHeight)
+	assert.EqualValues(t, 3, report.EndHeight)
+
+	_, err := VerifyData(context.Background(), c.bs, c.ss, nil, nil, 3, 6)
+	require.Error(t, err)
+}
+
+func TestVerifyDataInconsistencies(t *testing.T) {
+	type found struct {
+		height int64
+		kind   InconsistencyKind
+	}
+	testCases := map[string]struct {
+		skipIndex []int64
+		setup     func(t *testing.T, c *verifyChain)
+		found     []found
+	}{
+		"unindexed height": {
+			skipIndex: []int64{3},
+			found:     []found{{3, InconsistencyBlockIndex}, {3, InconsistencyTxIndex}},
+		},
+		"other FinalizeBlock response": {
+			setup: func(t *testing.T, c *verifyChain) {
+				require.NoError(t, c.ss.SaveFinalizeBlockResponse(2, &abci.ResponseFinalizeBlock{AppHash: []byte("other")}))
+			},
+			found: []found{{2, InconsistencyResults}},
+		},
+		"other validators": {
+			setup: func(t *testing.T, c *verifyChain) {
+				vals, err := c.ss.LoadValidators(3)
+				require.NoError(t, err)
+				vals.Validators[0].VotingPower++
+				params, err := c.ss.LoadConsensusParams(3)
+				require.NoError(t, err)
+				require.NoError(t, c.ss.RestoreHeight(3, vals, params, nil))
+			},
+			found: []found{{2, InconsistencyValidators}, {3, InconsistencyValidators}},
+		},
+		"state behind the block store": {
+			setup: func(t *testing.T, c *verifyChain) {
+				state, err := c.ss.Load()
+				require.NoError(t, err)
+				state.LastBlockHeight = 3
+				require.NoError(t, c.ss.Save(state))
+			},
+			found: []found{{5, InconsistencyStoreHeights}, {3, InconsistencyState}},
+		},
+		"block store behind the state": {
+			setup: func(t *testing.T, c *verifyChain) {
+				require.NoError(t, c.bs.DeleteLatestBlock())
+			},
+			found: []found{{4, InconsistencyStoreHeights}},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			c := makeVerifyChain(t, 5, tc.skipIndex...)
+			if tc.setup != nil {
+				tc.setup(t, c)
+			}
+			report := c.verify(t, 0, 0)
+			var got []found
+			for _, inconsistency := range report.Inconsistencies {
+				assert.NotEmpty(t, inconsistency.Description)
+				got = append(got, found{inconsistency.Height, inconsistency.Kind})
+			}
+			for _, f := range tc.found {
+				assert.Contains(t, got, f)
+			}
+		})
+	}
+}
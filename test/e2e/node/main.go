@@ -261,7 +261,9 @@ func setupNode() (*config.Config, log.Logger, *p2p.NodeKey, error) {
 		logger = log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
 	}
 
-	nodeLogger, err := cmtflags.ParseLogLevel(cmtcfg.LogLevel, logger, config.DefaultLogLevel)
+	nodeLogger, err := log.NewDynamicLevelLogger(cmtcfg.LogLevel, func(level string) (log.Logger, error) {
+		return cmtflags.ParseLogLevel(level, logger, config.DefaultLogLevel)
+	})
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -174,6 +174,9 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 	cfg.GRPC.VersionService.Enabled = true
 	cfg.GRPC.BlockService.Enabled = true
 	cfg.GRPC.BlockResultsService.Enabled = true
+	cfg.GRPC.MempoolService.Enabled = true
+	cfg.GRPC.EventService.Enabled = true
+	cfg.GRPC.TxService.Enabled = true
 
 	cfg.P2P.ExternalAddress = fmt.Sprintf("tcp://%v", node.AddressP2P(false))
 	cfg.P2P.AddrBookStrict = false
@@ -191,6 +194,10 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 		cfg.Storage.Pruning.DataCompanion.InitialBlockResultsRetainHeight = 0
 		cfg.GRPC.Privileged.ListenAddress = "tcp://0.0.0.0:26671"
 		cfg.GRPC.Privileged.PruningService.Enabled = true
+		cfg.GRPC.Privileged.ConsensusControlService.Enabled = true
+		cfg.GRPC.Privileged.DataCompanionService.Enabled = true
+		cfg.GRPC.Privileged.ReindexService.Enabled = true
+		cfg.GRPC.Privileged.PeerFilterService.Enabled = true
 	}
 
 	switch node.ABCIProtocol {
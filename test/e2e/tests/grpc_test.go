@@ -1,14 +1,19 @@
 package e2e_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
 	"github.com/cometbft/cometbft/rpc/grpc/client/privileged"
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 	"github.com/stretchr/testify/require"
 )
@@ -173,6 +178,127 @@ func TestGRPC_GetBlockResults(t *testing.T) {
 	})
 }
 
+func TestGRPC_Mempool(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		// Generate a random value, to prevent duplicate tx errors when
+		// manually running the test multiple times for a testnet.
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		bz := make([]byte, 32)
+		_, err = r.Read(bz)
+		require.NoError(t, err)
+		tx := types.Tx(fmt.Sprintf("testapp-grpc-tx-%v=%x", node.Name, bz))
+
+		checkTxRes, err := gRPCClient.CheckTx(ctx, tx)
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, checkTxRes.Code)
+
+		_, err = gRPCClient.GetNumUnconfirmedTxs(ctx)
+		require.NoError(t, err)
+		_, err = gRPCClient.GetUnconfirmedTxs(ctx, 0)
+		require.NoError(t, err)
+
+		// Subscribe before submitting, so that the tx cannot be added and
+		// committed before we start listening.
+		eventsCh, err := gRPCClient.GetTxEvents(ctx)
+		require.NoError(t, err)
+
+		submitRes, err := gRPCClient.SubmitTx(ctx, tx)
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, submitRes.CheckTx.Code)
+		require.EqualValues(t, tx.Hash(), submitRes.Hash)
+
+		for {
+			select {
+			case <-ctx.Done():
+				require.Fail(t, "did not receive an event for the submitted tx")
+			case event := <-eventsCh:
+				require.NoError(t, event.Error)
+				if event.Type == grpcclient.TxEventAdded && bytes.Equal(event.Tx, tx) {
+					return
+				}
+			}
+		}
+	})
+}
+
+func TestGRPC_Event_Subscribe(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		eventsCh, err := gRPCClient.Subscribe(ctx, types.EventQueryNewBlock.String())
+		require.NoError(t, err)
+
+		select {
+		case <-ctx.Done():
+			require.Fail(t, "did not receive a new block event")
+		case event := <-eventsCh:
+			require.NoError(t, event.Error)
+			require.Equal(t, types.EventQueryNewBlock.String(), event.Query)
+			data, ok := event.Data.(types.EventDataNewBlock)
+			require.True(t, ok)
+			require.Equal(t, data.Block.Hash(), data.BlockID.Hash)
+		}
+	})
+}
+
+func TestGRPC_Tx_Search(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		// Search in descending order, so that the most recent transactions are
+		// returned and their blocks are unlikely to have been pruned.
+		search := func(opts ...grpcclient.SearchTxsOption) []*grpcclient.IndexedTx {
+			opts = append(opts, grpcclient.SearchTxsDescending(), grpcclient.SearchTxsWithProofs())
+			resultCh, err := gRPCClient.SearchTxs(ctx, "tx.height > 0", opts...)
+			require.NoError(t, err)
+			txs := []*grpcclient.IndexedTx{}
+			for res := range resultCh {
+				require.NoError(t, res.Error)
+				txs = append(txs, res.Tx)
+			}
+			return txs
+		}
+
+		txs := search(grpcclient.SearchTxsLimit(2))
+		require.LessOrEqual(t, len(txs), 2)
+		if len(txs) < 2 {
+			return
+		}
+		require.True(t, txs[0].Height > txs[1].Height ||
+			(txs[0].Height == txs[1].Height && txs[0].Index > txs[1].Index))
+		for _, tx := range txs {
+			require.NotNil(t, tx.Proof)
+			require.NoError(t, tx.Proof.Validate(tx.Proof.RootHash))
+		}
+
+		// Resuming the search after the first transaction must return the
+		// second one.
+		resumed := search(grpcclient.SearchTxsAfter(txs[0].Height, txs[0].Index), grpcclient.SearchTxsLimit(1))
+		require.Len(t, resumed, 1)
+		require.Equal(t, txs[1].Hash, resumed[0].Hash)
+
+		tx, err := gRPCClient.GetTxByHash(ctx, txs[0].Hash, false)
+		require.NoError(t, err)
+		require.Equal(t, txs[0].Height, tx.Height)
+		require.Equal(t, txs[0].Index, tx.Index)
+		require.Nil(t, tx.Proof)
+	})
+}
+
 func TestGRPC_BlockRetainHeight(t *testing.T) {
 	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
 		if !node.EnableCompanionPruning {
@@ -192,6 +318,65 @@ func TestGRPC_BlockRetainHeight(t *testing.T) {
 	})
 }
 
+func TestGRPC_ConsensusControl(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		if !node.EnableCompanionPruning {
+			return
+		}
+
+		grpcClient, _, cleanup := getGRPCPrivilegedClientForTesting(t, node)
+		defer cleanup()
+
+		consensusState, err := grpcClient.DumpConsensusState(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, consensusState.RoundState)
+
+		// Set the current value back, so as not to affect the testnet.
+		createEmptyBlocks, err := grpcClient.GetCreateEmptyBlocks(ctx)
+		require.NoError(t, err)
+		err = grpcClient.SetCreateEmptyBlocks(ctx, createEmptyBlocks)
+		require.NoError(t, err)
+
+		err = grpcClient.SetLogLevel(ctx, "invalid:level:")
+		require.Error(t, err)
+	})
+}
+
+func TestGRPC_DataCompanion_StreamBlocks(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		if !node.EnableCompanionPruning {
+			return
+		}
+
+		grpcClient, status, cleanup := getGRPCPrivilegedClientForTesting(t, node)
+		defer cleanup()
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		height := status.SyncInfo.LatestBlockHeight
+		stream, err := grpcClient.StreamBlocks(ctx, privileged.StreamBlocksFromHeight(height))
+		require.NoError(t, err)
+
+		select {
+		case <-ctx.Done():
+			require.Fail(t, "did not receive a committed block")
+		case block := <-stream.Blocks():
+			require.NoError(t, block.Error)
+			require.Equal(t, height, block.Block.Height)
+			require.Equal(t, block.Block.Hash(), block.BlockID.Hash)
+			require.NotNil(t, block.FinalizeBlockResponse)
+		}
+
+		require.NoError(t, stream.Ack(height))
+		require.Eventually(t, func() bool {
+			cursor, err := grpcClient.GetDataCompanionCursor(ctx)
+			require.NoError(t, err)
+			return cursor >= height
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+}
+
 func TestGRPC_BlockResultsRetainHeight(t *testing.T) {
 	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
 		if !node.EnableCompanionPruning {